@@ -0,0 +1,33 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// StakingBankKeeper extends the bank keeper with the removal of denom metadata, which the bank
+// module does not expose, so that the staking module can remove the metadata of the share tokens
+// of deleted tokenize share records
+type StakingBankKeeper struct {
+	bankkeeper.Keeper
+
+	storeKey storetypes.StoreKey
+}
+
+// NewStakingBankKeeper returns the bank keeper given to the staking keeper, where storeKey is the
+// store key of the bank module
+func NewStakingBankKeeper(keeper bankkeeper.Keeper, storeKey storetypes.StoreKey) StakingBankKeeper {
+	return StakingBankKeeper{
+		Keeper:   keeper,
+		storeKey: storeKey,
+	}
+}
+
+// DeleteDenomMetaData removes the denomination metadata
+func (k StakingBankKeeper) DeleteDenomMetaData(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), banktypes.DenomMetadataPrefix)
+	store.Delete([]byte(denom))
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	sdkbanktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/bank/types"
+)
+
+var _ bankkeeper.Keeper = &SendHooksKeeper{}
+
+// SendHooksKeeper extends the bank keeper with hooks called around every transfer of coins, which
+// the bank module does not expose, so that the distribution module can settle the split rewards of the
+// share token holders whenever share tokens are transferred. It must be the only bank keeper given to
+// the other modules of the app, including the IBC transfer keeper, so that no transfer bypasses the hooks.
+type SendHooksKeeper struct {
+	bankkeeper.BaseKeeper
+
+	hooks types.SendHooks
+}
+
+// NewSendHooksKeeper returns the bank keeper given to the modules of the app, whose hooks are set
+// once the distribution keeper is created
+func NewSendHooksKeeper(keeper bankkeeper.BaseKeeper) *SendHooksKeeper {
+	return &SendHooksKeeper{
+		BaseKeeper: keeper,
+	}
+}
+
+// SetHooks sets the send hooks
+func (k *SendHooksKeeper) SetHooks(hooks types.SendHooks) *SendHooksKeeper {
+	if k.hooks != nil {
+		panic("cannot set bank send hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+// send calls the hooks around a transfer of coins between the given addresses
+func (k *SendHooksKeeper) send(ctx sdk.Context, addrs []sdk.AccAddress, amt sdk.Coins, send func() error) error {
+	if k.hooks == nil {
+		return send()
+	}
+
+	if err := k.hooks.BeforeShareTokenSend(ctx, addrs, amt); err != nil {
+		return err
+	}
+	if err := send(); err != nil {
+		return err
+	}
+	return k.hooks.AfterShareTokenSend(ctx, addrs, amt)
+}
+
+func (k *SendHooksKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.send(ctx, []sdk.AccAddress{fromAddr, toAddr}, amt, func() error {
+		return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+func (k *SendHooksKeeper) InputOutputCoins(ctx sdk.Context, inputs []sdkbanktypes.Input, outputs []sdkbanktypes.Output) error {
+	addrs := []sdk.AccAddress{}
+	amt := sdk.Coins{}
+	for _, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+		amt = amt.Add(in.Coins...)
+	}
+	for _, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return k.send(ctx, addrs, amt, func() error {
+		return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+	})
+}
+
+func (k *SendHooksKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.send(ctx, []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), recipientAddr}, amt, func() error {
+		return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
+
+func (k *SendHooksKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return k.send(ctx, []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule)}, amt, func() error {
+		return k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	})
+}
+
+func (k *SendHooksKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return k.send(ctx, []sdk.AccAddress{senderAddr, authtypes.NewModuleAddress(recipientModule)}, amt, func() error {
+		return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+func (k *SendHooksKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.send(ctx, []sdk.AccAddress{delegatorAddr, moduleAccAddr}, amt, func() error {
+		return k.BaseKeeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+	})
+}
+
+func (k *SendHooksKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.send(ctx, []sdk.AccAddress{moduleAccAddr, delegatorAddr}, amt, func() error {
+		return k.BaseKeeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+	})
+}
+
+func (k *SendHooksKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return k.send(ctx, []sdk.AccAddress{senderAddr, authtypes.NewModuleAddress(recipientModule)}, amt, func() error {
+		return k.BaseKeeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+func (k *SendHooksKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.send(ctx, []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), recipientAddr}, amt, func() error {
+		return k.BaseKeeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
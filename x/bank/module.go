@@ -0,0 +1,45 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/bank/keeper"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements the bank module of the SDK for the bank keeper with send hooks
+type AppModule struct {
+	bank.AppModule
+
+	keeper *keeper.SendHooksKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(cdc codec.Codec, keeper *keeper.SendHooksKeeper, accountKeeper banktypes.AccountKeeper) AppModule {
+	return AppModule{
+		AppModule: bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers the bank services, which the bank module of the SDK only supports for its base keeper
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+}
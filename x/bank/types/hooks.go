@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendHooks are called around every transfer of coins between accounts, so that the distribution
+// module can settle the split rewards of the share token holders of tokenize share records
+type SendHooks interface {
+	BeforeShareTokenSend(ctx sdk.Context, addrs []sdk.AccAddress, amt sdk.Coins) error // Must be called before coins are sent between the addresses
+	AfterShareTokenSend(ctx sdk.Context, addrs []sdk.AccAddress, amt sdk.Coins) error  // Must be called after coins are sent between the addresses
+}
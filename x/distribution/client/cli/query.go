@@ -33,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
 		GetCmdQueryTokenizeShareRecordReward(),
+		GetCmdQueryDelegatorAutoRestakes(),
+		GetCmdQueryCommissionSplit(),
 	)
 
 	return distQueryCmd
@@ -366,3 +368,93 @@ $ %s query distribution tokenize-share-record-rewards %s1gghjut3ccd8ay0zduzj64hw
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDelegatorAutoRestakes implements the query delegator auto-restakes command.
+func GetCmdQueryDelegatorAutoRestakes() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-restakes [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators a delegator has auto-restake enabled with",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose delegation rewards are automatically restaked for a delegator.
+
+Example:
+$ %s query distribution auto-restakes %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoRestakes(
+				cmd.Context(),
+				&types.QueryDelegatorAutoRestakesRequest{DelegatorAddress: delAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCommissionSplit implements the query validator commission split command.
+func GetCmdQueryCommissionSplit() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "commission-split [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the commission split of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query how the commission of a validator is split when it is withdrawn.
+
+Example:
+$ %s query distribution commission-split %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CommissionSplit(
+				cmd.Context(),
+				&types.QueryCommissionSplitRequest{ValidatorAddress: valAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
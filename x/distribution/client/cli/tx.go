@@ -19,8 +19,10 @@ import (
 
 // Transaction flags for the x/distribution module
 var (
-	FlagCommission       = "commission"
-	FlagMaxMessagesPerTx = "max-msgs"
+	FlagCommission           = "commission"
+	FlagMaxMessagesPerTx     = "max-msgs"
+	FlagCommunityPoolShare   = "community-pool-share"
+	FlagAutoWithdrawInterval = "auto-withdraw-interval"
 )
 
 const (
@@ -44,6 +46,10 @@ func NewTxCmd() *cobra.Command {
 		NewFundCommunityPoolCmd(),
 		NewWithdrawTokenizeShareRecordRewardCmd(),
 		NewWithdrawAllTokenizeShareRecordRewardCmd(),
+		NewClaimTokenizeShareRecordRewardCmd(),
+		NewSetAutoRestakeCmd(),
+		NewSetTokenizeShareRecordAutoRestakeCmd(),
+		NewSetCommissionSplitCmd(),
 	)
 
 	return distTxCmd
@@ -400,3 +406,196 @@ $ %s tx distribution withdraw-tokenize-share-rewards 1 --from mykey
 
 	return cmd
 }
+
+// ClaimTokenizeShareRecordReward defines a method for a share token holder to claim its part of the rewards of a TokenizeShareRecord
+func NewClaimTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-tokenize-share-rewards",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim the share token holder part of the rewards of a TokenizeShareRecord",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the share token holder part of the rewards of a TokenizeShareRecord whose rewards are split.
+A holder starts accruing rewards from its first claim.
+
+Example:
+$ %s tx distribution claim-tokenize-share-rewards 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimTokenizeShareRecordReward(clientCtx.GetFromAddress(), uint64(recordId))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoRestakeCmd returns a CLI command handler for creating a MsgSetAutoRestake transaction.
+func NewSetAutoRestakeCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake [validator-addr] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Enable or disable the automatic restaking of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the automatic restaking of the rewards of a delegation.
+The rewards are only restaked while the withdraw address of the delegator is the delegator itself.
+
+Example:
+$ %s tx distribution set-auto-restake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(clientCtx.GetFromAddress(), valAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetTokenizeShareRecordAutoRestakeCmd returns a CLI command handler for creating a
+// MsgSetTokenizeShareRecordAutoRestake transaction.
+func NewSetTokenizeShareRecordAutoRestakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-tokenize-share-record-auto-restake [record-id] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Enable or disable the automatic restaking of the rewards of a TokenizeShareRecord",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the automatic restaking of the rewards of a TokenizeShareRecord,
+which makes its share tokens grow in value. The rewards of a record whose rewards are split cannot be restaked.
+
+Example:
+$ %s tx distribution set-tokenize-share-record-auto-restake 1 true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTokenizeShareRecordAutoRestake(clientCtx.GetFromAddress(), uint64(recordId), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetCommissionSplitCmd returns a CLI command handler for creating a MsgSetCommissionSplit transaction.
+func NewSetCommissionSplitCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-commission-split [recipients]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Set how the commission of a validator is split when it is withdrawn",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set how the commission of the validator of the sender is split when it is withdrawn.
+The recipients are a comma separated list of addresses and weights, each weight being the fraction
+of the commission sent to the address. The --%s flag sets the fraction sent to the community pool,
+and the rest of the commission is sent to the withdraw address of the operator. The --%s flag sets
+the number of blocks between the automatic withdrawals of the commission, zero disables them.
+A split without recipients, community pool share and auto-withdraw interval removes the commission split.
+
+Example:
+$ %s tx distribution set-commission-split %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p:0.3,%s1y6q6xq6kh5ynqzvzqnzx7hrd3sqvuwstnajvt8:0.2 --%s=0.1 --%s=1000 --from mykey
+`,
+				FlagCommunityPoolShare, FlagAutoWithdrawInterval,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixAccAddr, FlagCommunityPoolShare, FlagAutoWithdrawInterval,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipients := []types.CommissionSplitRecipient{}
+			if len(args) > 0 && args[0] != "" {
+				for _, entry := range strings.Split(args[0], ",") {
+					parts := strings.Split(entry, ":")
+					if len(parts) != 2 {
+						return fmt.Errorf("invalid recipient %s, expected address:weight", entry)
+					}
+					weight, err := sdk.NewDecFromStr(parts[1])
+					if err != nil {
+						return err
+					}
+					recipients = append(recipients, types.CommissionSplitRecipient{Address: parts[0], Weight: weight})
+				}
+			}
+
+			communityPoolShareStr, _ := cmd.Flags().GetString(FlagCommunityPoolShare)
+			communityPoolShare, err := sdk.NewDecFromStr(communityPoolShareStr)
+			if err != nil {
+				return err
+			}
+
+			autoWithdrawInterval, _ := cmd.Flags().GetInt64(FlagAutoWithdrawInterval)
+
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			msg := types.NewMsgSetCommissionSplit(valAddr, recipients, communityPoolShare, autoWithdrawInterval)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagCommunityPoolShare, "0", "The fraction of the commission sent to the community pool")
+	cmd.Flags().Int64(FlagAutoWithdrawInterval, 0, "The number of blocks between the automatic withdrawals of the commission")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/iqlusioninc/liquidity-staking-module/testutil/network"
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
 type GRPCQueryTestSuite struct {
@@ -56,9 +57,9 @@ func (s *GRPCQueryTestSuite) TestQueryParamsGRPC() {
 		{
 			"gRPC request params",
 			fmt.Sprintf("%s/cosmos/distribution/v1beta1/params", baseURL),
-			&types.QueryParamsResponse{},
-			&types.QueryParamsResponse{
-				Params: types.DefaultParams(),
+			&distrtypes.QueryParamsResponse{},
+			&distrtypes.QueryParamsResponse{
+				Params: distrtypes.DefaultParams(),
 			},
 		},
 	}
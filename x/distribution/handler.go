@@ -37,6 +37,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgWithdrawAllTokenizeShareRecordReward:
 			res, err := msgServer.WithdrawAllTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimTokenizeShareRecordReward:
+			res, err := msgServer.ClaimTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetAutoRestake:
+			res, err := msgServer.SetAutoRestake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetTokenizeShareRecordAutoRestake:
+			res, err := msgServer.SetTokenizeShareRecordAutoRestake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetCommissionSplit:
+			res, err := msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateParams:
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distribution message type: %T", msg)
 		}
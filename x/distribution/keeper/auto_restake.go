@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// SetDelegationAutoRestake enables or disables the automatic restaking of the rewards of a delegation.
+// Enabling requires the delegation to exist, while disabling a delegation without auto-restake is a no-op.
+func (k Keeper) SetDelegationAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	if !enabled {
+		k.DeleteAutoRestake(ctx, delAddr, valAddr)
+	} else {
+		if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+			return sdkdistr.ErrNoValidatorExists
+		}
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return sdkdistr.ErrEmptyDelegationDistInfo
+		}
+		k.SetAutoRestake(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+	return nil
+}
+
+// SetTokenizeShareRecordAutoRestake enables or disables the automatic restaking of the rewards of the
+// delegation of a tokenize share record, which makes the share tokens of the record grow in value.
+// The rewards of a record whose rewards are split belong to the share token holders and cannot be restaked.
+func (k Keeper) SetTokenizeShareRecordAutoRestake(ctx sdk.Context, ownerAddr sdk.AccAddress, recordId uint64, enabled bool) error {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return err
+	}
+
+	if record.Owner != ownerAddr.String() {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	if enabled && record.SplitRewards {
+		return types.ErrTokenizeShareRecordRewardsSplit
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetDelegationAutoRestake(ctx, record.GetModuleAddress(), valAddr, enabled); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyRecordId, strconv.FormatUint(recordId, 10)),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+	return nil
+}
+
+// ProcessAutoRestakes restakes the bond denom rewards of the delegations with auto-restake enabled.
+// A pass over all the entries starts at most every AutoRestakeInterval blocks and is spread over as
+// many blocks as needed, spending at most AutoRestakeGasBudget gas per block. The entry that runs out
+// of gas is retried in the next block, unless it alone exceeds the budget, in which case it is skipped.
+func (k Keeper) ProcessAutoRestakes(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoRestakeGasBudget == 0 {
+		return
+	}
+
+	cursor := k.GetAutoRestakeCursor(ctx)
+	if len(cursor.NextKey) == 0 {
+		if cursor.PassStartHeight != 0 && ctx.BlockHeight() < cursor.PassStartHeight+params.AutoRestakeInterval {
+			return
+		}
+		cursor.NextKey = types.AutoRestakePrefix
+		cursor.PassStartHeight = ctx.BlockHeight()
+	}
+
+	restakeCtx := ctx.WithGasMeter(sdk.NewGasMeter(params.AutoRestakeGasBudget))
+	for first := true; ; first = false {
+		key, autoRestake, found := k.nextAutoRestake(ctx, cursor.NextKey)
+		if !found {
+			cursor.NextKey = nil
+			break
+		}
+
+		outOfGas := k.processAutoRestake(restakeCtx, autoRestake)
+		if outOfGas && !first {
+			cursor.NextKey = key
+			break
+		}
+
+		// resume right after the processed key
+		cursor.NextKey = append(append([]byte{}, key...), 0x00)
+		if outOfGas {
+			k.Logger(ctx).Error("auto-restake exceeds the gas budget, skipping",
+				"delegator", autoRestake.DelegatorAddress, "validator", autoRestake.ValidatorAddress)
+			break
+		}
+	}
+
+	k.SetAutoRestakeCursor(ctx, cursor)
+}
+
+// nextAutoRestake returns the first auto-restake entry whose key is not lower than the start key.
+func (k Keeper) nextAutoRestake(ctx sdk.Context, start []byte) (key []byte, autoRestake types.AutoRestake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoRestakePrefix))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, autoRestake, false
+	}
+
+	k.cdc.MustUnmarshal(iter.Value(), &autoRestake)
+	return iter.Key(), autoRestake, true
+}
+
+// processAutoRestake restakes the rewards of a single entry in a cached context, so a failed entry
+// leaves no partial changes. It reports whether the gas budget ran out while processing the entry.
+func (k Keeper) processAutoRestake(ctx sdk.Context, autoRestake types.AutoRestake) (outOfGas bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			outOfGas = true
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.restakeRewards(cacheCtx, autoRestake); err != nil {
+		k.Logger(ctx).Error("failed to auto-restake rewards", "delegator", autoRestake.DelegatorAddress,
+			"validator", autoRestake.ValidatorAddress, "err", err)
+		return false
+	}
+
+	write()
+	return false
+}
+
+// restakeRewards withdraws the rewards of a delegation and delegates the bond denom part back to the
+// validator. Delegations whose rewards are paid to another address, or belong to the share token holders
+// of a tokenize share record, are left untouched.
+func (k Keeper) restakeRewards(ctx sdk.Context, autoRestake types.AutoRestake) error {
+	delAddr, err := sdk.AccAddressFromBech32(autoRestake.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(autoRestake.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil || k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+		return nil
+	}
+
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return nil
+	}
+
+	if record, err := k.stakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, delAddr); err == nil && record.SplitRewards {
+		return nil
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	if _, err := k.stakingKeeper.Restake(ctx, delAddr, valAddr, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestSetAutoRestake(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delegator := addr[1]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	queryAutoRestakes := func() []string {
+		res, err := app.DistrKeeper.DelegatorAutoRestakes(sdk.WrapSDKContext(ctx),
+			&types.QueryDelegatorAutoRestakesRequest{DelegatorAddress: delegator.String()})
+		require.NoError(t, err)
+		return res.Validators
+	}
+
+	// auto-restake cannot be enabled without a delegation
+	_, err := msgServer.SetAutoRestake(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoRestake(delegator, valAddrs[0], true))
+	require.ErrorIs(t, err, sdkdistr.ErrEmptyDelegationDistInfo)
+
+	// disabling is a no-op when auto-restake is not enabled
+	_, err = msgServer.SetAutoRestake(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoRestake(delegator, valAddrs[0], false))
+	require.NoError(t, err)
+	require.Empty(t, queryAutoRestakes())
+
+	delTokens := sdk.NewInt(1000000)
+	tstaking.Delegate(delegator, valAddrs[0], delTokens)
+	_, err = msgServer.SetAutoRestake(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoRestake(delegator, valAddrs[0], true))
+	require.NoError(t, err)
+	require.True(t, app.DistrKeeper.HasAutoRestake(ctx, delegator, valAddrs[0]))
+	require.Equal(t, []string{valAddrs[0].String()}, queryAutoRestakes())
+
+	_, err = msgServer.SetAutoRestake(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoRestake(delegator, valAddrs[0], false))
+	require.NoError(t, err)
+	require.Empty(t, queryAutoRestakes())
+
+	// the entry is removed with the delegation
+	_, err = msgServer.SetAutoRestake(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoRestake(delegator, valAddrs[0], true))
+	require.NoError(t, err)
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delegator, valAddrs[0])
+	require.True(t, found)
+	_, err = app.StakingKeeper.Undelegate(ctx, delegator, valAddrs[0], delegation.Shares)
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.HasAutoRestake(ctx, delegator, valAddrs[0]))
+}
+
+func TestProcessAutoRestakes(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delegator, holder, owner := addr[1], addr[2], addr[3]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// fund the distribution module for the rewards
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(10))}
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins))
+
+	// a delegator and a tokenize share record both enable auto-restake
+	delTokens := sdk.NewInt(1000000)
+	tstaking.Delegate(delegator, valAddrs[0], delTokens)
+	tstaking.Delegate(holder, valAddrs[0], delTokens)
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := stakingMsgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    holder.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	_, err = msgServer.SetAutoRestake(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoRestake(delegator, valAddrs[0], true))
+	require.NoError(t, err)
+	_, err = msgServer.SetTokenizeShareRecordAutoRestake(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeShareRecordAutoRestake(holder, record.Id, true))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+	_, err = msgServer.SetTokenizeShareRecordAutoRestake(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeShareRecordAutoRestake(owner, record.Id, true))
+	require.NoError(t, err)
+	require.True(t, app.DistrKeeper.HasAutoRestake(ctx, record.GetModuleAddress(), valAddrs[0]))
+
+	tokensOf := func(delAddr sdk.AccAddress) sdk.Dec {
+		delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddrs[0])
+		require.True(t, found)
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddrs[0])
+		require.True(t, found)
+		return validator.TokensFromShares(delegation.Shares)
+	}
+	allocateRewards := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	}
+	pendingRewards := func(delAddr sdk.AccAddress) sdk.Int {
+		cacheCtx, _ := ctx.CacheContext()
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, delAddr, valAddrs[0])
+		require.NoError(t, err)
+		return rewards.AmountOf(sdk.DefaultBondDenom)
+	}
+
+	// the rewards are added to the delegations instead of being paid out
+	params := app.DistrKeeper.GetParams(ctx)
+	ctx = ctx.WithBlockHeight(app.DistrKeeper.GetAutoRestakeCursor(ctx).PassStartHeight + params.AutoRestakeInterval - 1)
+	allocateRewards()
+	delegatorRewards, recordRewards := pendingRewards(delegator), pendingRewards(record.GetModuleAddress())
+	require.True(t, delegatorRewards.IsPositive())
+	delegatorTokens, recordTokens := tokensOf(delegator), tokensOf(record.GetModuleAddress())
+	delegatorBalance := app.BankKeeper.GetBalance(ctx, delegator, sdk.DefaultBondDenom)
+	totalLiquidStaked := app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)
+
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, delegatorTokens.Add(sdk.NewDecFromInt(delegatorRewards)), tokensOf(delegator))
+	require.Equal(t, recordTokens.Add(sdk.NewDecFromInt(recordRewards)), tokensOf(record.GetModuleAddress()))
+	require.Equal(t, delegatorBalance, app.BankKeeper.GetBalance(ctx, delegator, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+	require.Equal(t, totalLiquidStaked.Add(sdk.NewDecFromInt(recordRewards)), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Empty(t, app.DistrKeeper.GetAutoRestakeCursor(ctx).NextKey)
+
+	// no new pass starts before the interval has elapsed
+	allocateRewards()
+	delegatorTokens = tokensOf(delegator)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, delegatorTokens, tokensOf(delegator))
+
+	ctx = ctx.WithBlockHeight(app.DistrKeeper.GetAutoRestakeCursor(ctx).PassStartHeight + params.AutoRestakeInterval)
+	delegatorRewards = pendingRewards(delegator)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, delegatorTokens.Add(sdk.NewDecFromInt(delegatorRewards)), tokensOf(delegator))
+
+	// the rewards of a delegation paid to another address are not restaked
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, delegator, owner))
+	allocateRewards()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + params.AutoRestakeInterval)
+	delegatorTokens = tokensOf(delegator)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, delegatorTokens, tokensOf(delegator))
+
+	// the rewards of a record whose rewards are split cannot be restaked
+	_, err = stakingMsgServer.EnableTokenizeShareRecordSplitRewards(sdk.WrapSDKContext(ctx),
+		stakingtypes.NewMsgEnableTokenizeShareRecordSplitRewards(owner, record.Id))
+	require.NoError(t, err)
+	_, err = msgServer.SetTokenizeShareRecordAutoRestake(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeShareRecordAutoRestake(owner, record.Id, true))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordRewardsSplit)
+	allocateRewards()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + params.AutoRestakeInterval)
+	recordTokens = tokensOf(record.GetModuleAddress())
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, recordTokens, tokensOf(record.GetModuleAddress()))
+}
+
+func TestProcessAutoRestakesGasBudget(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	delegators := addr[1:]
+	for _, delegator := range delegators {
+		tstaking.Delegate(delegator, valAddrs[0], sdk.NewInt(1000000))
+		app.DistrKeeper.SetAutoRestake(ctx, delegator, valAddrs[0])
+	}
+
+	// an entry that alone exceeds the budget is skipped so the pass keeps progressing
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoRestakeGasBudget = 1
+	require.NoError(t, app.DistrKeeper.SetParams(ctx, params))
+	passStartHeight := app.DistrKeeper.GetAutoRestakeCursor(ctx).PassStartHeight + params.AutoRestakeInterval
+	ctx = ctx.WithBlockHeight(passStartHeight)
+
+	for range delegators {
+		app.DistrKeeper.ProcessAutoRestakes(ctx)
+		require.NotEmpty(t, app.DistrKeeper.GetAutoRestakeCursor(ctx).NextKey)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	cursor := app.DistrKeeper.GetAutoRestakeCursor(ctx)
+	require.Empty(t, cursor.NextKey)
+	require.Equal(t, passStartHeight, cursor.PassStartHeight)
+
+	// a zero budget disables auto-restaking
+	params.AutoRestakeGasBudget = 0
+	require.NoError(t, app.DistrKeeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + params.AutoRestakeInterval)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, cursor, app.DistrKeeper.GetAutoRestakeCursor(ctx))
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// SetValidatorCommissionSplit sets how the commission of a validator is split when it is withdrawn.
+// An empty split removes the commission split of the validator, so its whole commission is sent to
+// the operator again and is no longer withdrawn automatically.
+func (k Keeper) SetValidatorCommissionSplit(ctx sdk.Context, split types.CommissionSplit) error {
+	valAddr, err := sdk.ValAddressFromBech32(split.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if split.IsEmpty() {
+		k.DeleteCommissionSplit(ctx, valAddr)
+	} else {
+		if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+			return sdkdistr.ErrNoValidatorExists
+		}
+		for _, recipient := range split.Recipients {
+			recipientAddr, err := sdk.AccAddressFromBech32(recipient.Address)
+			if err != nil {
+				return err
+			}
+			if k.bankKeeper.BlockedAddr(recipientAddr) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient.Address)
+			}
+		}
+		k.SetCommissionSplit(ctx, valAddr, split)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionSplit,
+			sdk.NewAttribute(types.AttributeKeyValidator, split.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(!split.IsEmpty())),
+		),
+	)
+	return nil
+}
+
+// splitValidatorCommission sends the shares of the recipients and of the community pool of the
+// commission split of a validator out of the withdrawn commission, and returns the commission left
+// for the operator. The shares are rounded down, so the rounding dust goes to the operator.
+func (k Keeper) splitValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress, commission sdk.Coins) (sdk.Coins, error) {
+	split, found := k.GetCommissionSplit(ctx, valAddr)
+	if !found || commission.IsZero() {
+		return commission, nil
+	}
+
+	remaining := commission
+	for _, recipient := range split.Recipients {
+		amount, _ := sdk.NewDecCoinsFromCoins(commission...).MulDecTruncate(recipient.Weight).TruncateDecimal()
+		if amount.IsZero() {
+			continue
+		}
+
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, amount); err != nil {
+			return nil, err
+		}
+		remaining = remaining.Sub(amount...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionSplit,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+
+	// the share of the community pool stays in the module account
+	poolAmount, _ := sdk.NewDecCoinsFromCoins(commission...).MulDecTruncate(split.CommunityPoolShare).TruncateDecimal()
+	if !poolAmount.IsZero() {
+		feePool := k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(poolAmount...)...)
+		k.SetFeePool(ctx, feePool)
+		remaining = remaining.Sub(poolAmount...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionSplit,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, types.AttributeValueCommunityPool),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, poolAmount.String()),
+			),
+		)
+	}
+
+	return remaining, nil
+}
+
+// ProcessCommissionAutoWithdrawals withdraws the commission of the validators whose commission split
+// has an auto-withdraw interval the current height is a multiple of. Only the splits queued for the
+// current height are read, and they are queued again for their next auto-withdraw. A failed withdrawal
+// is logged and the commission is left to be withdrawn later.
+func (k Keeper) ProcessCommissionAutoWithdrawals(ctx sdk.Context) {
+	for _, valAddr := range k.DequeueDueCommissionAutoWithdrawals(ctx, ctx.BlockHeight()) {
+		split, found := k.GetCommissionSplit(ctx, valAddr)
+		if !found || split.AutoWithdrawInterval <= 0 {
+			continue
+		}
+		k.queueCommissionAutoWithdraw(ctx, valAddr, split.AutoWithdrawInterval)
+		if ctx.BlockHeight()%split.AutoWithdrawInterval != 0 {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.WithdrawValidatorCommission(cacheCtx, valAddr); err != nil {
+			if !errors.Is(err, sdkdistr.ErrNoValidatorCommission) {
+				k.Logger(ctx).Error("failed to auto-withdraw validator commission", "validator", valAddr.String(), "err", err)
+			}
+			continue
+		}
+		write()
+	}
+}
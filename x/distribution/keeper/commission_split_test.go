@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestSetCommissionSplit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	queryCommissionSplit := func(valAddr sdk.ValAddress) types.CommissionSplit {
+		res, err := app.DistrKeeper.CommissionSplit(sdk.WrapSDKContext(ctx),
+			&types.QueryCommissionSplitRequest{ValidatorAddress: valAddr.String()})
+		require.NoError(t, err)
+		return res.CommissionSplit
+	}
+	recipients := []types.CommissionSplitRecipient{
+		{Address: addr[1].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: addr[2].String(), Weight: sdk.NewDecWithPrec(2, 1)},
+	}
+
+	// a commission split cannot be set without a validator
+	msg := types.NewMsgSetCommissionSplit(valAddrs[1], recipients, sdk.NewDecWithPrec(1, 1), 10)
+	_, err := msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, sdkdistr.ErrNoValidatorExists)
+
+	// the commission cannot be sent to a blocked address
+	blocked := []types.CommissionSplitRecipient{
+		{Address: app.DistrKeeper.GetDistributionAccount(ctx).GetAddress().String(), Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	msg = types.NewMsgSetCommissionSplit(valAddrs[0], blocked, sdk.ZeroDec(), 0)
+	_, err = msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// a validator without a commission split sends its whole commission to the operator
+	split := queryCommissionSplit(valAddrs[0])
+	require.Empty(t, split.Recipients)
+	require.True(t, split.CommunityPoolShare.IsZero())
+
+	msg = types.NewMsgSetCommissionSplit(valAddrs[0], recipients, sdk.NewDecWithPrec(1, 1), 10)
+	_, err = msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, msg.CommissionSplit(), queryCommissionSplit(valAddrs[0]))
+
+	// an empty commission split removes it
+	msg = types.NewMsgSetCommissionSplit(valAddrs[0], nil, sdk.ZeroDec(), 0)
+	_, err = msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	_, found := app.DistrKeeper.GetCommissionSplit(ctx, valAddrs[0])
+	require.False(t, found)
+
+	// the commission split is removed with the validator
+	msg = types.NewMsgSetCommissionSplit(valAddrs[0], recipients, sdk.ZeroDec(), 0)
+	_, err = msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.NoError(t, app.DistrKeeper.Hooks().AfterValidatorRemoved(ctx, valConsAddr1, valAddrs[0]))
+	_, found = app.DistrKeeper.GetCommissionSplit(ctx, valAddrs[0])
+	require.False(t, found)
+}
+
+func TestWithdrawValidatorCommissionSplit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	operator, recipient1, recipient2 := addr[0], addr[1], addr[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	msg := types.NewMsgSetCommissionSplit(valAddrs[0], []types.CommissionSplitRecipient{
+		{Address: recipient1.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: recipient2.String(), Weight: sdk.NewDecWithPrec(2, 1)},
+	}, sdk.NewDecWithPrec(1, 1), 0)
+	_, err := msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// allocate rewards funded by the distribution module
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000001))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, rewards))
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(rewards...))
+
+	commission, _ := app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.TruncateDecimal()
+	amount := commission.AmountOf(sdk.DefaultBondDenom)
+	require.Equal(t, sdk.NewInt(500000), amount)
+
+	balanceOf := func(addr sdk.AccAddress) sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+	operatorBalance, balance1, balance2 := balanceOf(operator), balanceOf(recipient1), balanceOf(recipient2)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom)
+
+	// the recipients and the community pool receive their shares and the operator the rest
+	withdrawn, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, commission, withdrawn)
+	require.Equal(t, balance1.Add(sdk.NewInt(250000)), balanceOf(recipient1))
+	require.Equal(t, balance2.Add(sdk.NewInt(100000)), balanceOf(recipient2))
+	require.Equal(t, communityPool.Add(sdk.NewDec(50000)),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, operatorBalance.Add(sdk.NewInt(100000)), balanceOf(operator))
+
+	// the delegators can still withdraw their rewards
+	for _, invariant := range []sdk.Invariant{
+		keeper.CanWithdrawInvariant(app.DistrKeeper),
+		keeper.ModuleAccountInvariant(app.DistrKeeper),
+		keeper.NonNegativeOutstandingInvariant(app.DistrKeeper),
+	} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+}
+
+func TestProcessCommissionAutoWithdrawals(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	operator, recipient := addr[0], addr[1]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	msg := types.NewMsgSetCommissionSplit(valAddrs[0], []types.CommissionSplitRecipient{
+		{Address: recipient.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+	}, sdk.ZeroDec(), 10)
+	_, err := msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, rewards))
+	ctx = ctx.WithBlockHeight(9)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(rewards...))
+	commission := app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission
+
+	balanceOf := func(addr sdk.AccAddress) sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+	operatorBalance, recipientBalance := balanceOf(operator), balanceOf(recipient)
+
+	// the commission is not withdrawn before the interval
+	app.DistrKeeper.ProcessCommissionAutoWithdrawals(ctx)
+	require.Equal(t, commission, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission)
+	require.Equal(t, operatorBalance, balanceOf(operator))
+
+	// the commission is withdrawn and split at the interval
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.ProcessCommissionAutoWithdrawals(ctx)
+	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
+	require.Equal(t, operatorBalance.Add(sdk.NewInt(250000)), balanceOf(operator))
+	require.Equal(t, recipientBalance.Add(sdk.NewInt(250000)), balanceOf(recipient))
+
+	// a validator without commission is skipped
+	ctx = ctx.WithBlockHeight(20)
+	app.DistrKeeper.ProcessCommissionAutoWithdrawals(ctx)
+	require.Equal(t, operatorBalance.Add(sdk.NewInt(250000)), balanceOf(operator))
+
+	// only the next auto-withdraw of the validator is queued
+	queued := func(height int64) []sdk.ValAddress {
+		cacheCtx, _ := ctx.CacheContext()
+		return app.DistrKeeper.DequeueDueCommissionAutoWithdrawals(cacheCtx, height)
+	}
+	require.Empty(t, queued(29))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, queued(1000))
+
+	// changing the interval moves the queued auto-withdraw
+	msg.AutoWithdrawInterval = 7
+	_, err = msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Empty(t, queued(20))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, queued(21))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, queued(1000))
+
+	// removing the commission split removes the queued auto-withdraw
+	_, err = msgServer.SetCommissionSplit(sdk.WrapSDKContext(ctx), types.NewMsgSetCommissionSplit(valAddrs[0], nil, sdk.ZeroDec(), 0))
+	require.NoError(t, err)
+	require.Empty(t, queued(1000))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	bankkeeper "github.com/iqlusioninc/liquidity-staking-module/x/bank/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
 	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
@@ -190,6 +191,591 @@ func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
 	require.Equal(t, midBalance.Amount.Add(coins.AmountOf(sdk.DefaultBondDenom)), finalBalance.Amount)
 }
 
+func TestTokenizeShareRecordRewardSettlement(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	holder, owner, newOwner := addr[1], addr[2], addr[3]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// fund the distribution module for the rewards
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(2))}
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins))
+
+	// tokenize the whole delegation of the holder
+	delTokens := sdk.NewInt(1000000)
+	tstaking.Delegate(holder, valAddrs[0], delTokens)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    holder.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+
+	allocateRewards := func() sdk.Coins {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+		cacheCtx, _ := ctx.CacheContext()
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddrs[0])
+		require.NoError(t, err)
+		require.False(t, rewards.IsZero())
+		return rewards
+	}
+	balanceOf := func(addr sdk.AccAddress) sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+
+	// the rewards accrued before a transfer are paid to the previous owner
+	rewards := allocateRewards()
+	ownerBalance, newOwnerBalance := balanceOf(owner), balanceOf(newOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                owner.String(),
+		NewOwner:              newOwner.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, ownerBalance.Add(rewards.AmountOf(sdk.DefaultBondDenom)), balanceOf(owner))
+	require.Equal(t, newOwnerBalance, balanceOf(newOwner))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// a partial redemption credits the redeemed half of the pending rewards to the redeemer and the
+	// rest to the owner, and the full redemption of the remaining share tokens pays the owner
+	for _, redemption := range []struct {
+		amount   sdk.Int
+		fraction sdk.Dec
+	}{
+		{delTokens.QuoRaw(2), sdk.NewDecWithPrec(5, 1)},
+		{delTokens.QuoRaw(2), sdk.ZeroDec()},
+	} {
+		rewards = allocateRewards()
+		holderBalance, newOwnerBalance := balanceOf(holder), balanceOf(newOwner)
+		redeemerRewards := redemption.fraction.MulInt(rewards.AmountOf(sdk.DefaultBondDenom)).TruncateInt()
+
+		// the redeemer is also paid the rewards of the delegation of its earlier redemption
+		if app.StakingKeeper.Delegation(ctx, holder, valAddrs[0]) != nil {
+			cacheCtx, _ := ctx.CacheContext()
+			holderRewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, holder, valAddrs[0])
+			require.NoError(t, err)
+			holderBalance = holderBalance.Add(holderRewards.AmountOf(sdk.DefaultBondDenom))
+		}
+
+		_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+			DelegatorAddress: holder.String(),
+			Amount:           sdk.NewCoin(record.GetShareTokenDenom(), redemption.amount),
+		})
+		require.NoError(t, err)
+		require.Equal(t, holderBalance.Add(redeemerRewards), balanceOf(holder))
+		require.Equal(t, newOwnerBalance.Add(rewards.AmountOf(sdk.DefaultBondDenom).Sub(redeemerRewards)), balanceOf(newOwner))
+		require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+	}
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.Error(t, err)
+}
+
+func TestTokenizeShareRecordSplitRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	holder, owner, holder2 := addr[1], addr[2], addr[3]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// fund the distribution module for the rewards
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(3))}
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins))
+
+	// tokenize the whole delegation of the holder without splitting the rewards
+	delTokens := sdk.NewInt(1000000)
+	tstaking.Delegate(holder, valAddrs[0], delTokens)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    holder.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, record.SplitRewards)
+
+	allocateRewards := func() sdk.Int {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+		cacheCtx, _ := ctx.CacheContext()
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddrs[0])
+		require.NoError(t, err)
+		require.False(t, rewards.IsZero())
+		return rewards.AmountOf(sdk.DefaultBondDenom)
+	}
+	balanceOf := func(addr sdk.AccAddress) sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+
+	// holders cannot claim the rewards of a record that is not split
+	_, err = app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, holder, record.Id)
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordRewardsNotSplit)
+
+	// only the owner can split the rewards
+	enableMsg := stakingtypes.NewMsgEnableTokenizeShareRecordSplitRewards(holder, record.Id)
+	_, err = msgServer.EnableTokenizeShareRecordSplitRewards(sdk.WrapSDKContext(ctx), enableMsg)
+	require.ErrorIs(t, err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	// the rewards accrued before the split are paid to the owner
+	rewards := allocateRewards()
+	ownerBalance := balanceOf(owner)
+	enableMsg = stakingtypes.NewMsgEnableTokenizeShareRecordSplitRewards(owner, record.Id)
+	_, err = msgServer.EnableTokenizeShareRecordSplitRewards(sdk.WrapSDKContext(ctx), enableMsg)
+	require.NoError(t, err)
+	require.Equal(t, ownerBalance.Add(rewards), balanceOf(owner))
+	record, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.NoError(t, err)
+	require.True(t, record.SplitRewards)
+
+	_, err = msgServer.EnableTokenizeShareRecordSplitRewards(sdk.WrapSDKContext(ctx), enableMsg)
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordSplitRewardsEnabled)
+
+	// the first claim of a holder checkpoints its balance
+	claimed, err := app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, holder, record.Id)
+	require.NoError(t, err)
+	require.True(t, claimed.IsZero())
+	shareTokens := sdk.NewCoins(sdk.NewCoin(record.GetShareTokenDenom(), delTokens.QuoRaw(2)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder, holder2, shareTokens))
+	claimed, err = app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, holder2, record.Id)
+	require.NoError(t, err)
+	require.True(t, claimed.IsZero())
+
+	// the owner withdrawal moves the rewards to the pool of the holders
+	rewards = allocateRewards()
+	ownerBalance = balanceOf(owner)
+	withdrawn, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, record.Id)
+	require.NoError(t, err)
+	require.True(t, withdrawn.IsZero())
+	require.Equal(t, ownerBalance, balanceOf(owner))
+	require.Equal(t, rewards, app.BankKeeper.GetBalance(ctx, record.GetModuleAddress(), sdk.DefaultBondDenom).Amount)
+
+	// each holder claims its half of the rewards
+	expected := sdk.NewDecFromInt(rewards).QuoTruncate(sdk.NewDecFromInt(delTokens)).MulInt(delTokens.QuoRaw(2)).TruncateInt()
+	for _, h := range []sdk.AccAddress{holder, holder2} {
+		holderBalance := balanceOf(h)
+		claimed, err = app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, h, record.Id)
+		require.NoError(t, err)
+		require.Equal(t, expected, claimed.AmountOf(sdk.DefaultBondDenom))
+		require.Equal(t, holderBalance.Add(expected), balanceOf(h))
+	}
+
+	// a redemption claims the rewards of the redeemer
+	rewards = allocateRewards()
+	holderBalance := balanceOf(holder)
+	expected = sdk.NewDecFromInt(rewards).QuoTruncate(sdk.NewDecFromInt(delTokens)).MulInt(delTokens.QuoRaw(2)).TruncateInt()
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+		DelegatorAddress: holder.String(),
+		Amount:           shareTokens[0],
+	})
+	require.NoError(t, err)
+	require.Equal(t, holderBalance.Add(expected), balanceOf(holder))
+
+	// the last redemption removes the record, pays the last holder and sweeps the rest to the owner
+	holderBalance, ownerBalance = balanceOf(holder2), balanceOf(owner)
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+		DelegatorAddress: holder2.String(),
+		Amount:           shareTokens[0],
+	})
+	require.NoError(t, err)
+	require.Equal(t, holderBalance.Add(expected), balanceOf(holder2))
+	require.Equal(t, ownerBalance.Add(rewards.Sub(expected.MulRaw(2))), balanceOf(owner))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+	require.True(t, app.DistrKeeper.GetTokenizeShareRecordRewardsPerShare(ctx, record.Id).IsZero())
+	_, found := app.DistrKeeper.GetTokenizeShareHolderRewardInfo(ctx, record.Id, holder2)
+	require.False(t, found)
+}
+
+func TestTokenizeShareRecordSplitRewardsTransfer(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 5, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	holder, owner, sender, receiver := addr[1], addr[2], addr[3], addr[4]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// fund the distribution module for the rewards
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(3))}
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, coins))
+
+	// tokenize the whole delegation of the holder and split the rewards
+	delTokens := sdk.NewInt(1000000)
+	tstaking.Delegate(holder, valAddrs[0], delTokens)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    holder.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+		SplitRewards:        true,
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, record.SplitRewards)
+
+	allocateRewards := func() sdk.Int {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+		cacheCtx, _ := ctx.CacheContext()
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddrs[0])
+		require.NoError(t, err)
+		require.False(t, rewards.IsZero())
+		return rewards.AmountOf(sdk.DefaultBondDenom)
+	}
+	balanceOf := func(addr sdk.AccAddress) sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+	halfOf := func(rewards sdk.Int) sdk.Int {
+		return sdk.NewDecFromInt(rewards).QuoTruncate(sdk.NewDecFromInt(delTokens)).MulInt(delTokens.QuoRaw(2)).TruncateInt()
+	}
+	shareTokens := sdk.NewCoins(sdk.NewCoin(record.GetShareTokenDenom(), delTokens.QuoRaw(2)))
+
+	// the holder sends half of its share tokens without claiming first
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder, sender, shareTokens))
+
+	// a receiver earns rewards from the transfer without claiming first
+	rewards1 := allocateRewards()
+	senderBalance := balanceOf(sender)
+	claimed, err := app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, sender, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, halfOf(rewards1), claimed.AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, senderBalance.Add(halfOf(rewards1)), balanceOf(sender))
+
+	// the same share tokens cannot be claimed again once transferred
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, sender, receiver, shareTokens))
+	claimed, err = app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, receiver, record.Id)
+	require.NoError(t, err)
+	require.True(t, claimed.IsZero())
+
+	// a transfer pays the sender the rewards earned before it
+	rewards2 := allocateRewards()
+	receiverBalance := balanceOf(receiver)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, receiver, sender, shareTokens))
+	require.Equal(t, receiverBalance.Add(halfOf(rewards2)), balanceOf(receiver))
+	senderBalance = balanceOf(sender)
+	claimed, err = app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, sender, record.Id)
+	require.NoError(t, err)
+	require.True(t, claimed.IsZero())
+	require.Equal(t, senderBalance, balanceOf(sender))
+
+	// passing the share tokens back and forth leaves the rewards of the other holder untouched
+	holderBalance := balanceOf(holder)
+	claimed, err = app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, holder, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, halfOf(rewards1).Add(halfOf(rewards2)), claimed.AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, holderBalance.Add(claimed.AmountOf(sdk.DefaultBondDenom)), balanceOf(holder))
+
+	// the last redemption receives the share tokens of the other holder, which pays it first, and
+	// only the rounding dust is swept to the owner
+	rewards3 := allocateRewards()
+	senderBalance, holderBalance = balanceOf(sender), balanceOf(holder)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, sender, holder, shareTokens))
+	require.Equal(t, senderBalance.Add(halfOf(rewards3)), balanceOf(sender))
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+		DelegatorAddress: holder.String(),
+		Amount:           sdk.NewCoin(record.GetShareTokenDenom(), delTokens),
+	})
+	require.NoError(t, err)
+	require.Equal(t, holderBalance.Add(halfOf(rewards3)), balanceOf(holder))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+	_, found := app.DistrKeeper.GetTokenizeShareHolderRewardInfo(ctx, record.Id, sender)
+	require.False(t, found)
+}
+
+func TestTokenizeShareRecordSplitRewardsWithoutCheckpoint(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	holder, owner, receiver := addr[1], addr[2], addr[3]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// fund the distribution module for the rewards
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(2))}
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, coins))
+
+	// tokenize the whole delegation of the holder and split the rewards
+	delTokens := sdk.NewInt(1000000)
+	tstaking.Delegate(holder, valAddrs[0], delTokens)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    holder.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+		SplitRewards:        true,
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+
+	allocateRewards := func() sdk.Int {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromInt(initial)}}
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+		cacheCtx, _ := ctx.CacheContext()
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddrs[0])
+		require.NoError(t, err)
+		require.False(t, rewards.IsZero())
+		return rewards.AmountOf(sdk.DefaultBondDenom)
+	}
+	halfOf := func(rewards sdk.Int) sdk.Int {
+		return sdk.NewDecFromInt(rewards).QuoTruncate(sdk.NewDecFromInt(delTokens)).MulInt(delTokens.QuoRaw(2)).TruncateInt()
+	}
+
+	// half of the share tokens are sent through the base keeper, which bypasses the send hooks
+	baseKeeper := app.BankKeeper.(*bankkeeper.SendHooksKeeper).BaseKeeper
+	shareTokens := sdk.NewCoins(sdk.NewCoin(record.GetShareTokenDenom(), delTokens.QuoRaw(2)))
+	require.NoError(t, baseKeeper.SendCoins(ctx, holder, receiver, shareTokens))
+
+	// the receiver has no checkpoint, so its first claim only checkpoints its balance instead of
+	// paying it the whole accumulator, and the holder is only paid on the share tokens it kept
+	rewards1 := allocateRewards()
+	claimed, err := app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, receiver, record.Id)
+	require.NoError(t, err)
+	require.True(t, claimed.IsZero())
+	claimed, err = app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, holder, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, halfOf(rewards1), claimed.AmountOf(sdk.DefaultBondDenom))
+
+	// once checkpointed, the receiver earns rewards on its balance
+	rewards2 := allocateRewards()
+	claimed, err = app.DistrKeeper.ClaimTokenizeShareRecordReward(ctx, receiver, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, halfOf(rewards2), claimed.AmountOf(sdk.DefaultBondDenom))
+}
+
+func TestTokenizeShareRecordCompoundRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	holder, owner := addr[1], addr[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// fund the distribution module for the rewards in the bond denom and another denom
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(3)), sdk.NewCoin("atom", initial.MulRaw(3)))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, coins))
+
+	// tokenize the whole delegation of the holder
+	delTokens := sdk.NewInt(1000000)
+	tstaking.Delegate(holder, valAddrs[0], delTokens)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    holder.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, record.CompoundRewards)
+
+	allocateRewards := func() sdk.Coins {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		tokens := sdk.NewDecCoinsFromCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial), sdk.NewCoin("atom", initial))
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+		cacheCtx, _ := ctx.CacheContext()
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddrs[0])
+		require.NoError(t, err)
+		require.False(t, rewards.AmountOf(sdk.DefaultBondDenom).IsZero())
+		require.False(t, rewards.AmountOf("atom").IsZero())
+		return rewards
+	}
+	recordTokens := func() sdk.Int {
+		del, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+		require.True(t, found)
+		val, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddrs[0])
+		require.True(t, found)
+		return val.TokensFromShares(del.Shares).TruncateInt()
+	}
+
+	// only the owner can compound the rewards
+	setMsg := stakingtypes.NewMsgSetTokenizeShareRecordCompoundRewards(holder, record.Id, true)
+	_, err = msgServer.SetTokenizeShareRecordCompoundRewards(sdk.WrapSDKContext(ctx), setMsg)
+	require.ErrorIs(t, err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	// the rewards accrued before compounding is enabled are paid to the owner
+	rewards := allocateRewards()
+	ownerBalances := app.BankKeeper.GetAllBalances(ctx, owner)
+	setMsg = stakingtypes.NewMsgSetTokenizeShareRecordCompoundRewards(owner, record.Id, true)
+	_, err = msgServer.SetTokenizeShareRecordCompoundRewards(sdk.WrapSDKContext(ctx), setMsg)
+	require.NoError(t, err)
+	require.Equal(t, ownerBalances.Add(rewards...), app.BankKeeper.GetAllBalances(ctx, owner))
+	record, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.NoError(t, err)
+	require.True(t, record.CompoundRewards)
+	require.Equal(t, delTokens, recordTokens())
+
+	// the rewards of a compounding record cannot be split
+	enableMsg := stakingtypes.NewMsgEnableTokenizeShareRecordSplitRewards(owner, record.Id)
+	_, err = msgServer.EnableTokenizeShareRecordSplitRewards(sdk.WrapSDKContext(ctx), enableMsg)
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordCompoundRewards)
+
+	// the bond denom rewards are restaked and the rest is paid to the owner
+	rewards = allocateRewards()
+	ownerBalances = app.BankKeeper.GetAllBalances(ctx, owner)
+	withdrawn, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("atom", rewards.AmountOf("atom"))), withdrawn)
+	require.Equal(t, ownerBalances.Add(withdrawn...), app.BankKeeper.GetAllBalances(ctx, owner))
+	require.Equal(t, delTokens.Add(rewards.AmountOf(sdk.DefaultBondDenom)), recordTokens())
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// the rewards accrued before compounding is disabled are restaked
+	compounded := recordTokens()
+	rewards = allocateRewards()
+	setMsg = stakingtypes.NewMsgSetTokenizeShareRecordCompoundRewards(owner, record.Id, false)
+	_, err = msgServer.SetTokenizeShareRecordCompoundRewards(sdk.WrapSDKContext(ctx), setMsg)
+	require.NoError(t, err)
+	require.Equal(t, compounded.Add(rewards.AmountOf(sdk.DefaultBondDenom)), recordTokens())
+	record, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.NoError(t, err)
+	require.False(t, record.CompoundRewards)
+
+	// the redemption of all share tokens returns the compounded delegation
+	compounded = recordTokens()
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+		DelegatorAddress: holder.String(),
+		Amount:           sdk.NewCoin(record.GetShareTokenDenom(), delTokens),
+	})
+	require.NoError(t, err)
+	del, found := app.StakingKeeper.GetLiquidDelegation(ctx, holder, valAddrs[0])
+	require.True(t, found)
+	val, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.True(t, val.TokensFromShares(del.Shares).TruncateInt().GTE(compounded.SubRaw(1)))
+}
+
+func TestRedeemCompoundingTokenizeShareRecord(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	holder, owner := addr[1], addr[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, coins))
+
+	// tokenize the whole delegation of the holder and compound the rewards of the record
+	delTokens := sdk.NewInt(1000000)
+	tstaking.Delegate(holder, valAddrs[0], delTokens)
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    holder.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		TokenizedShareOwner: owner.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, delTokens),
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	setMsg := stakingtypes.NewMsgSetTokenizeShareRecordCompoundRewards(owner, record.Id, true)
+	_, err = msgServer.SetTokenizeShareRecordCompoundRewards(sdk.WrapSDKContext(ctx), setMsg)
+	require.NoError(t, err)
+
+	// allocate rewards that are pending when the share tokens are redeemed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(coins...))
+	cacheCtx, _ := ctx.CacheContext()
+	rewards, err := app.DistrKeeper.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddrs[0])
+	require.NoError(t, err)
+	require.False(t, rewards.AmountOf(sdk.DefaultBondDenom).IsZero())
+
+	// the redemption of all share tokens restakes the pending rewards first and returns them
+	// with the whole delegation of the record
+	res, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &stakingtypes.MsgRedeemTokensforShares{
+		DelegatorAddress: holder.String(),
+		Amount:           sdk.NewCoin(record.GetShareTokenDenom(), delTokens),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Amount.Amount.GTE(delTokens.Add(rewards.AmountOf(sdk.DefaultBondDenom)).SubRaw(1)))
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordNotExists)
+	_, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+}
+
 func TestCalculateRewardsAfterSlash(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -12,7 +12,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	var moduleHoldings sdk.DecCoins
 
 	k.SetFeePool(ctx, data.FeePool)
-	k.SetParams(ctx, data.Params)
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		delegatorAddress := sdk.MustAccAddressFromBech32(dwi.DelegatorAddress)
@@ -76,6 +78,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, rps := range data.TokenizeShareRecordRewardsPerShare {
+		k.SetTokenizeShareRecordRewardsPerShare(ctx, rps.RecordId, rps.RewardsPerShare)
+	}
+	for _, info := range data.TokenizeShareHolderRewardInfos {
+		holderAddress := sdk.MustAccAddressFromBech32(info.HolderAddress)
+		k.SetTokenizeShareHolderRewardInfo(ctx, info.RecordId, holderAddress, info.Info)
+	}
+	for _, autoRestake := range data.AutoRestakes {
+		delegatorAddress := sdk.MustAccAddressFromBech32(autoRestake.DelegatorAddress)
+		validatorAddress, err := sdk.ValAddressFromBech32(autoRestake.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAutoRestake(ctx, delegatorAddress, validatorAddress)
+	}
+	for _, split := range data.CommissionSplits {
+		validatorAddress, err := sdk.ValAddressFromBech32(split.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetCommissionSplit(ctx, validatorAddress, split)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -181,5 +205,44 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	rewardsPerShare := make([]types.TokenizeShareRecordRewardsPerShareRecord, 0)
+	k.IterateTokenizeShareRecordRewardsPerShare(ctx,
+		func(recordId uint64, rps sdk.DecCoins) (stop bool) {
+			rewardsPerShare = append(rewardsPerShare, types.TokenizeShareRecordRewardsPerShareRecord{
+				RecordId:        recordId,
+				RewardsPerShare: rps,
+			})
+			return false
+		},
+	)
+
+	holderInfos := make([]types.TokenizeShareHolderRewardInfoRecord, 0)
+	k.IterateTokenizeShareHolderRewardInfos(ctx,
+		func(recordId uint64, holder sdk.AccAddress, info types.TokenizeShareHolderRewardInfo) (stop bool) {
+			holderInfos = append(holderInfos, types.TokenizeShareHolderRewardInfoRecord{
+				RecordId:      recordId,
+				HolderAddress: holder.String(),
+				Info:          info,
+			})
+			return false
+		},
+	)
+
+	autoRestakes := make([]types.AutoRestake, 0)
+	k.IterateAutoRestakes(ctx,
+		func(autoRestake types.AutoRestake) (stop bool) {
+			autoRestakes = append(autoRestakes, autoRestake)
+			return false
+		},
+	)
+
+	commissionSplits := make([]types.CommissionSplit, 0)
+	k.IterateCommissionSplits(ctx,
+		func(split types.CommissionSplit) (stop bool) {
+			commissionSplits = append(commissionSplits, split)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, rewardsPerShare, holderInfos, autoRestakes, commissionSplits)
 }
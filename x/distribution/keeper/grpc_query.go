@@ -20,8 +20,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -240,6 +239,53 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegatorAutoRestakes queries the validators a delegator has auto-restake enabled with
+func (k Keeper) DelegatorAutoRestakes(c context.Context, req *types.QueryDelegatorAutoRestakesRequest) (*types.QueryDelegatorAutoRestakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := []string{}
+	k.IterateDelegatorAutoRestakes(ctx, delAdr, func(autoRestake types.AutoRestake) (stop bool) {
+		validators = append(validators, autoRestake.ValidatorAddress)
+		return false
+	})
+
+	return &types.QueryDelegatorAutoRestakesResponse{Validators: validators}, nil
+}
+
+// CommissionSplit queries the commission split of a validator
+func (k Keeper) CommissionSplit(c context.Context, req *types.QueryCommissionSplitRequest) (*types.QueryCommissionSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	split, found := k.GetCommissionSplit(ctx, valAdr)
+	if !found {
+		split = types.NewCommissionSplit(valAdr, []types.CommissionSplitRecipient{}, sdk.ZeroDec(), 0)
+	}
+
+	return &types.QueryCommissionSplitResponse{CommissionSplit: split}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -250,7 +296,12 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 // TokenizeShareRecordReward returns estimated amount of reward from tokenize share record ownership
 func (k Keeper) TokenizeShareRecordReward(c context.Context, req *types.QueryTokenizeShareRecordRewardRequest) (*types.QueryTokenizeShareRecordRewardResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// the pending rewards are computed by incrementing the validator periods, which must not be persisted
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
 
 	totalRewards := sdk.DecCoins{}
 	rewards := []types.TokenizeShareRecordReward{}
@@ -268,29 +319,38 @@ func (k Keeper) TokenizeShareRecordReward(c context.Context, req *types.QueryTok
 
 		moduleAddr := record.GetModuleAddress()
 		moduleBalance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
-		moduleBalanceDecCoins := sdk.NewDecCoinsFromCoins(moduleBalance...)
+
+		recordReward := types.TokenizeShareRecordReward{
+			RecordId:         record.Id,
+			ValidatorAddress: record.Validator,
+			ShareDenom:       record.GetShareTokenDenom(),
+			PendingReward:    sdk.DecCoins{},
+			Reward:           sdk.DecCoins{},
+		}
 
 		val := k.stakingKeeper.Validator(ctx, valAddr)
 		del := k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr)
-		if val != nil && del != nil {
-			// withdraw rewards
+		switch {
+		case val == nil:
+			recordReward.Error = errorsmod.Wrap(sdkdistr.ErrNoValidatorExists, record.Validator).Error()
+		case del == nil:
+			recordReward.Error = sdkdistr.ErrNoDelegationExists.Error()
+		default:
 			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-			recordReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
-
-			rewards = append(rewards, types.TokenizeShareRecordReward{
-				RecordId: record.Id,
-				Reward:   recordReward.Add(moduleBalanceDecCoins...),
-			})
-			totalRewards = totalRewards.Add(recordReward...)
+			recordReward.PendingReward = k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 		}
 
-		if !moduleBalance.IsZero() {
-			rewards = append(rewards, types.TokenizeShareRecordReward{
-				RecordId: record.Id,
-				Reward:   moduleBalanceDecCoins,
-			})
-			totalRewards = totalRewards.Add(moduleBalanceDecCoins...)
+		// the rewards of a record whose rewards are split, including the module account balance
+		// that is not claimed yet, belong to the share token holders and not to the owner
+		if record.SplitRewards {
+			recordReward.HoldersBalance = moduleBalance
+		} else {
+			recordReward.IdleBalance = moduleBalance
+			recordReward.Reward = recordReward.PendingReward.Add(sdk.NewDecCoinsFromCoins(moduleBalance...)...)
 		}
+
+		rewards = append(rewards, recordReward)
+		totalRewards = totalRewards.Add(recordReward.Reward...)
 	}
 
 	return &types.QueryTokenizeShareRecordRewardResponse{
@@ -78,6 +78,7 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 					WithdrawAddrEnabled: true,
+					AutoRestakeInterval: types.DefaultAutoRestakeInterval,
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -725,12 +726,67 @@ func (suite *KeeperTestSuite) TestGRPCTokenizeShareRecordReward() {
 	suite.Require().Equal(&types.QueryTokenizeShareRecordRewardResponse{
 		Rewards: []types.TokenizeShareRecordReward{
 			{
-				RecordId: 1,
-				Reward:   sdk.DecCoins{sdk.NewInt64DecCoin("stake", 50000)},
+				RecordId:         1,
+				Reward:           sdk.DecCoins{sdk.NewInt64DecCoin("stake", 50000)},
+				ValidatorAddress: valAddrs[0].String(),
+				ShareDenom:       valAddrs[0].String() + "/1",
+				PendingReward:    sdk.DecCoins{sdk.NewInt64DecCoin("stake", 50000)},
 			},
 		},
 		Total: sdk.DecCoins{sdk.NewInt64DecCoin("stake", 50000)},
 	}, rewards)
+
+	// a record whose validator no longer exists reports its idle balance and an error
+	goneRecord := stakingtypes.TokenizeShareRecord{
+		Id:            2,
+		Owner:         sdk.AccAddress(valAddrs[0]).String(),
+		ModuleAccount: "tokenizeshare_2",
+		Validator:     valAddrs[1].String(),
+	}
+	suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, goneRecord))
+	idleBalance := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr[1], goneRecord.GetModuleAddress(), idleBalance))
+
+	rewards, err = queryClient.TokenizeShareRecordReward(gocontext.Background(), &types.QueryTokenizeShareRecordRewardRequest{
+		OwnerAddress: sdk.AccAddress(valAddrs[0]).String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(rewards.Rewards, 2)
+
+	goneReward := rewards.Rewards[1]
+	suite.Require().Equal(goneRecord.Id, goneReward.RecordId)
+	suite.Require().Equal(goneRecord.GetShareTokenDenom(), goneReward.ShareDenom)
+	suite.Require().True(goneReward.PendingReward.IsZero())
+	suite.Require().Equal(idleBalance, goneReward.IdleBalance)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(idleBalance...), goneReward.Reward)
+	suite.Require().Contains(goneReward.Error, "validator does not exist")
+	suite.Require().Equal(sdk.DecCoins{sdk.NewInt64DecCoin("stake", 51000)}, rewards.Total)
+
+	// the module account balance of a record whose rewards are split is the holders' unclaimed
+	// rewards, which is not reported as reward of the owner
+	splitRecord := stakingtypes.TokenizeShareRecord{
+		Id:            3,
+		Owner:         sdk.AccAddress(valAddrs[0]).String(),
+		ModuleAccount: "tokenizeshare_3",
+		Validator:     valAddrs[1].String(),
+		SplitRewards:  true,
+	}
+	suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, splitRecord))
+	holdersBalance := sdk.NewCoins(sdk.NewInt64Coin("stake", 2000))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr[1], splitRecord.GetModuleAddress(), holdersBalance))
+
+	rewards, err = queryClient.TokenizeShareRecordReward(gocontext.Background(), &types.QueryTokenizeShareRecordRewardRequest{
+		OwnerAddress: sdk.AccAddress(valAddrs[0]).String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(rewards.Rewards, 3)
+
+	splitReward := rewards.Rewards[2]
+	suite.Require().Equal(splitRecord.Id, splitReward.RecordId)
+	suite.Require().True(splitReward.IdleBalance.IsZero())
+	suite.Require().Equal(holdersBalance, splitReward.HoldersBalance)
+	suite.Require().True(splitReward.Reward.IsZero())
+	suite.Require().Equal(sdk.DecCoins{sdk.NewInt64DecCoin("stake", 51000)}, rewards.Total)
 }
 
 func TestDistributionTestSuite(t *testing.T) {
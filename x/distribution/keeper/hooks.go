@@ -42,6 +42,12 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 		h.k.SetFeePool(ctx, feePool)
 
+		// send the shares of the commission split, if any
+		coins, err := h.k.splitValidatorCommission(ctx, valAddr, coins)
+		if err != nil {
+			return err
+		}
+
 		// add to validator account
 		if !coins.IsZero() {
 			accAddr := sdk.AccAddress(valAddr)
@@ -75,17 +81,30 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 	// clear current rewards
 	h.k.DeleteValidatorCurrentRewards(ctx, valAddr)
 
+	// clear commission split
+	h.k.DeleteCommissionSplit(ctx, valAddr)
+
 	return nil
 }
 
 func (h Hooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordId uint64) error {
-	err := h.k.WithdrawSingleShareRecordReward(ctx, recordId)
+	err := h.k.WithdrawRemovedShareRecordReward(ctx, recordId)
 	if err != nil {
 		h.k.Logger(ctx).Error(err.Error())
 	}
 	return err
 }
 
+// settle the rewards accrued so far under the current owner and reward mode
+func (h Hooks) BeforeTokenizeShareRecordModified(ctx sdk.Context, recordId uint64) error {
+	return h.k.WithdrawSingleShareRecordReward(ctx, recordId)
+}
+
+// credit the redeemed fraction of the pending rewards to the redeemer
+func (h Hooks) BeforeTokenizeShareRecordRedeemed(ctx sdk.Context, recordId uint64, redeemer sdk.AccAddress, fraction sdk.Dec) error {
+	return h.k.WithdrawRedeemedShareRecordReward(ctx, recordId, redeemer, fraction)
+}
+
 // increment period
 func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	val := h.k.stakingKeeper.Validator(ctx, valAddr)
@@ -126,6 +145,8 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+// remove the auto-restake entry of the delegation
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.DeleteAutoRestake(ctx, delAddr, valAddr)
 	return nil
 }
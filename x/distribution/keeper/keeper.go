@@ -19,25 +19,32 @@ import (
 type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
-	paramSpace    paramtypes.Subspace
+	paramSpace    paramtypes.Subspace // legacy params subspace, only read by the store migrations
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address capable of executing a MsgUpdateParams message, typically the gov module account
+	authority string
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -51,9 +58,15 @@ func NewKeeper(
 		bankKeeper:       bk,
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the address capable of updating the x/distribution module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -134,10 +147,16 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr).Rewards
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
-	if !commission.IsZero() {
+	// send the shares of the commission split, if any, and the rest to the operator
+	operatorCommission, err := k.splitValidatorCommission(ctx, valAddr, commission)
+	if err != nil {
+		return nil, err
+	}
+
+	if !operatorCommission.IsZero() {
 		accAddr := sdk.AccAddress(valAddr)
 		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, operatorCommission)
 		if err != nil {
 			return nil, err
 		}
@@ -187,6 +206,11 @@ func (k Keeper) WithdrawSingleShareRecordReward(ctx sdk.Context, recordId uint64
 		return err
 	}
 
+	// rewards of a split record go to the share token holders
+	if record.SplitRewards {
+		return k.accumulateSplitShareRecordReward(ctx, record)
+	}
+
 	owner, err := sdk.AccAddressFromBech32(record.Owner)
 	if err != nil {
 		return err
@@ -207,6 +231,10 @@ func (k Keeper) WithdrawSingleShareRecordReward(ctx sdk.Context, recordId uint64
 			return err
 		}
 		write()
+
+		if record.CompoundRewards {
+			k.compoundShareRecordReward(ctx, record, valAddr)
+		}
 	}
 
 	// apply changes when the module account has positive balance
@@ -228,6 +256,60 @@ func (k Keeper) WithdrawSingleShareRecordReward(ctx sdk.Context, recordId uint64
 	return nil
 }
 
+// WithdrawRedeemedShareRecordReward settles the rewards of a tokenize share record before its share
+// tokens are redeemed. If the rewards of the record are split, the redeemer claims its part as a share
+// token holder. Otherwise, on a partial redemption the redeemer is credited the redeemed fraction of the
+// pending rewards, and the rest of the module account balance is paid to the record owner.
+func (k Keeper) WithdrawRedeemedShareRecordReward(ctx sdk.Context, recordId uint64, redeemer sdk.AccAddress, fraction sdk.Dec) error {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return err
+	}
+
+	if record.SplitRewards {
+		if err := k.accumulateSplitShareRecordReward(ctx, record); err != nil {
+			return err
+		}
+		_, err = k.claimSplitShareRecordReward(ctx, record, redeemer)
+		return err
+	}
+
+	// the owner is paid all the rewards on a full redemption
+	if fraction.LT(sdk.OneDec()) {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return err
+		}
+
+		val := k.stakingKeeper.Validator(ctx, valAddr)
+		del := k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr)
+		if val != nil && del != nil {
+			rewards, err := k.WithdrawDelegationRewards(ctx, record.GetModuleAddress(), valAddr)
+			if err != nil {
+				return err
+			}
+
+			redeemerRewards, _ := sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(fraction).TruncateDecimal()
+			if !redeemerRewards.IsZero() {
+				err = k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), redeemer, redeemerRewards)
+				if err != nil {
+					return err
+				}
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeWithdrawTokenizeShareReward,
+						sdk.NewAttribute(types.AttributeKeyWithdrawAddress, redeemer.String()),
+						sdk.NewAttribute(sdk.AttributeKeyAmount, redeemerRewards.String()),
+					),
+				)
+			}
+		}
+	}
+
+	return k.WithdrawSingleShareRecordReward(ctx, recordId)
+}
+
 // withdraw reward for owning TokenizeShareRecord
 func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress, recordId uint64) (sdk.Coins, error) {
 	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordId)
@@ -239,6 +321,11 @@ func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk
 		return nil, types.ErrNotTokenizeShareRecordOwner
 	}
 
+	// rewards of a split record are moved to the pool claimed by the share token holders
+	if record.SplitRewards {
+		return sdk.Coins{}, k.accumulateSplitShareRecordReward(ctx, record)
+	}
+
 	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
 	if err != nil {
 		return nil, err
@@ -260,6 +347,10 @@ func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk
 		return nil, err
 	}
 
+	if record.CompoundRewards {
+		k.compoundShareRecordReward(ctx, record, valAddr)
+	}
+
 	// apply changes when the module account has positive balance
 	rewards := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
 	if !rewards.Empty() {
@@ -302,14 +393,29 @@ func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx sdk.Context, ownerAddr
 			continue
 		}
 
-		// withdraw rewards into reward module account and send it to reward owner
 		cacheCtx, write := ctx.CacheContext()
+
+		// rewards of a split record are moved to the pool claimed by the share token holders
+		if record.SplitRewards {
+			if err := k.accumulateSplitShareRecordReward(cacheCtx, record); err != nil {
+				k.Logger(ctx).Error(err.Error())
+				continue
+			}
+			write()
+			continue
+		}
+
+		// withdraw rewards into reward module account and send it to reward owner
 		_, err = k.WithdrawDelegationRewards(cacheCtx, record.GetModuleAddress(), valAddr)
 		if err != nil {
 			k.Logger(ctx).Error(err.Error())
 			continue
 		}
 
+		if record.CompoundRewards {
+			k.compoundShareRecordReward(cacheCtx, record, valAddr)
+		}
+
 		// apply changes when the module account has positive balance
 		balances := k.bankKeeper.GetAllBalances(cacheCtx, record.GetModuleAddress())
 		if !balances.Empty() {
@@ -318,9 +424,9 @@ func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx sdk.Context, ownerAddr
 				k.Logger(ctx).Error(err.Error())
 				continue
 			}
-			write()
 			totalRewards = totalRewards.Add(balances...)
 		}
+		write()
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
@@ -129,3 +130,38 @@ func TestFundCommunityPool(t *testing.T) {
 	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
 	assert.Empty(t, app.BankKeeper.GetAllBalances(ctx, addr[0]))
 }
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.CommunityTax = sdk.NewDecWithPrec(10, 2)
+	params.WithdrawAddrEnabled = false
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: sdk.AccAddress("invalid_authority___").String(),
+		Params:    params,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// invalid params are rejected
+	invalidParams := params
+	invalidParams.CommunityTax = sdk.NewDec(2)
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.DistrKeeper.GetAuthority(),
+		Params:    invalidParams,
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.DistrKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, app.DistrKeeper.GetParams(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), app.DistrKeeper.GetCommunityTax(ctx))
+	require.False(t, app.DistrKeeper.GetWithdrawAddrEnabled(ctx))
+}
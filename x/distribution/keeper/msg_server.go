@@ -3,10 +3,12 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
@@ -194,6 +196,114 @@ func (k msgServer) WithdrawAllTokenizeShareRecordReward(goCtx context.Context, m
 	return &types.MsgWithdrawAllTokenizeShareRecordRewardResponse{}, nil
 }
 
+// ClaimTokenizeShareRecordReward defines a method for a share token holder to claim its part of the rewards of a TokenizeShareRecord
+func (k msgServer) ClaimTokenizeShareRecordReward(goCtx context.Context, msg *types.MsgClaimTokenizeShareRecordReward) (*types.MsgClaimTokenizeShareRecordRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	holderAddr, err := sdk.AccAddressFromBech32(msg.HolderAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.Keeper.ClaimTokenizeShareRecordReward(ctx, holderAddr, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "claim_tokenize_share_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.HolderAddress),
+		),
+	)
+
+	return &types.MsgClaimTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
+// SetAutoRestake defines a method to enable or disable the automatic restaking of the rewards of a delegation
+func (k msgServer) SetAutoRestake(goCtx context.Context, msg *types.MsgSetAutoRestake) (*types.MsgSetAutoRestakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	err = k.SetDelegationAutoRestake(ctx, delegatorAddress, valAddr, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgSetAutoRestakeResponse{}, nil
+}
+
+// SetTokenizeShareRecordAutoRestake defines a method to enable or disable the automatic restaking of the rewards of a TokenizeShareRecord
+func (k msgServer) SetTokenizeShareRecordAutoRestake(goCtx context.Context, msg *types.MsgSetTokenizeShareRecordAutoRestake) (*types.MsgSetTokenizeShareRecordAutoRestakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.SetTokenizeShareRecordAutoRestake(ctx, ownerAddr, msg.RecordId, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	)
+
+	return &types.MsgSetTokenizeShareRecordAutoRestakeResponse{}, nil
+}
+
+// SetCommissionSplit defines a method for a validator to set how its commission is split when it is withdrawn
+func (k msgServer) SetCommissionSplit(goCtx context.Context, msg *types.MsgSetCommissionSplit) (*types.MsgSetCommissionSplitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.SetValidatorCommissionSplit(ctx, msg.CommissionSplit())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+	)
+
+	return &types.MsgSetCommissionSplitResponse{}, nil
+}
+
 func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -215,3 +325,16 @@ func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCo
 
 	return &types.MsgFundCommunityPoolResponse{}, nil
 }
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -134,6 +134,7 @@ func TestQueries(t *testing.T) {
 		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 		WithdrawAddrEnabled: true,
+		AutoRestakeInterval: types.DefaultAutoRestakeInterval,
 	}
 
 	app.DistrKeeper.SetParams(ctx, params)
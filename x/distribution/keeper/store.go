@@ -383,3 +383,230 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// get the cumulative rewards per share token of a tokenize share record
+func (k Keeper) GetTokenizeShareRecordRewardsPerShare(ctx sdk.Context, recordId uint64) (rewardsPerShare sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetTokenizeShareRecordRewardsPerShareKey(recordId))
+	if b == nil {
+		return sdk.DecCoins{}
+	}
+	var value types.TokenizeShareRecordRewardsPerShare
+	k.cdc.MustUnmarshal(b, &value)
+	return value.RewardsPerShare
+}
+
+// set the cumulative rewards per share token of a tokenize share record
+func (k Keeper) SetTokenizeShareRecordRewardsPerShare(ctx sdk.Context, recordId uint64, rewardsPerShare sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.TokenizeShareRecordRewardsPerShare{RewardsPerShare: rewardsPerShare})
+	store.Set(types.GetTokenizeShareRecordRewardsPerShareKey(recordId), b)
+}
+
+// delete the cumulative rewards per share token of a tokenize share record
+func (k Keeper) DeleteTokenizeShareRecordRewardsPerShare(ctx sdk.Context, recordId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordRewardsPerShareKey(recordId))
+}
+
+// iterate over the cumulative rewards per share token of all tokenize share records
+func (k Keeper) IterateTokenizeShareRecordRewardsPerShare(ctx sdk.Context, handler func(recordId uint64, rewardsPerShare sdk.DecCoins) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordRewardsPerSharePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var value types.TokenizeShareRecordRewardsPerShare
+		k.cdc.MustUnmarshal(iter.Value(), &value)
+		recordId := sdk.BigEndianToUint64(iter.Key()[1:])
+		if handler(recordId, value.RewardsPerShare) {
+			break
+		}
+	}
+}
+
+// get the reward claim checkpoint of a share token holder
+func (k Keeper) GetTokenizeShareHolderRewardInfo(ctx sdk.Context, recordId uint64, holder sdk.AccAddress) (info types.TokenizeShareHolderRewardInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetTokenizeShareHolderRewardInfoKey(recordId, holder))
+	if b == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshal(b, &info)
+	return info, true
+}
+
+// set the reward claim checkpoint of a share token holder
+func (k Keeper) SetTokenizeShareHolderRewardInfo(ctx sdk.Context, recordId uint64, holder sdk.AccAddress, info types.TokenizeShareHolderRewardInfo) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&info)
+	store.Set(types.GetTokenizeShareHolderRewardInfoKey(recordId, holder), b)
+}
+
+// iterate over the reward claim checkpoints of all share token holders
+func (k Keeper) IterateTokenizeShareHolderRewardInfos(ctx sdk.Context, handler func(recordId uint64, holder sdk.AccAddress, info types.TokenizeShareHolderRewardInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TokenizeShareHolderRewardInfoPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.TokenizeShareHolderRewardInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		recordId, holder := types.GetTokenizeShareHolderRewardInfoRecordIdHolder(iter.Key())
+		if handler(recordId, holder, info) {
+			break
+		}
+	}
+}
+
+// delete the reward claim checkpoints of all share token holders of a tokenize share record
+func (k Keeper) DeleteTokenizeShareHolderRewardInfos(ctx sdk.Context, recordId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareHolderRewardInfoPrefix(recordId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// check whether auto-restake is enabled for a delegation
+func (k Keeper) HasAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoRestakeKey(delAddr, valAddr))
+}
+
+// enable auto-restake for a delegation
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.AutoRestake{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	store.Set(types.GetAutoRestakeKey(delAddr, valAddr), b)
+}
+
+// disable auto-restake for a delegation
+func (k Keeper) DeleteAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoRestakeKey(delAddr, valAddr))
+}
+
+// iterate over the auto-restake entries of a delegator
+func (k Keeper) IterateDelegatorAutoRestakes(ctx sdk.Context, delAddr sdk.AccAddress, handler func(autoRestake types.AutoRestake) (stop bool)) {
+	k.iterateAutoRestakes(ctx, types.GetAutoRestakePrefix(delAddr), handler)
+}
+
+// iterate over all the auto-restake entries
+func (k Keeper) IterateAutoRestakes(ctx sdk.Context, handler func(autoRestake types.AutoRestake) (stop bool)) {
+	k.iterateAutoRestakes(ctx, types.AutoRestakePrefix, handler)
+}
+
+func (k Keeper) iterateAutoRestakes(ctx sdk.Context, prefix []byte, handler func(autoRestake types.AutoRestake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var autoRestake types.AutoRestake
+		k.cdc.MustUnmarshal(iter.Value(), &autoRestake)
+		if handler(autoRestake) {
+			break
+		}
+	}
+}
+
+// get the progress of the current auto-restake pass
+func (k Keeper) GetAutoRestakeCursor(ctx sdk.Context) (cursor types.AutoRestakeCursor) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.AutoRestakeCursorKey)
+	if b == nil {
+		return cursor
+	}
+	k.cdc.MustUnmarshal(b, &cursor)
+	return cursor
+}
+
+// set the progress of the current auto-restake pass
+func (k Keeper) SetAutoRestakeCursor(ctx sdk.Context, cursor types.AutoRestakeCursor) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&cursor)
+	store.Set(types.AutoRestakeCursorKey, b)
+}
+
+// get the commission split of a validator
+func (k Keeper) GetCommissionSplit(ctx sdk.Context, valAddr sdk.ValAddress) (split types.CommissionSplit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetCommissionSplitKey(valAddr))
+	if b == nil {
+		return split, false
+	}
+	k.cdc.MustUnmarshal(b, &split)
+	return split, true
+}
+
+// set the commission split of a validator, and queue its next auto-withdraw
+func (k Keeper) SetCommissionSplit(ctx sdk.Context, valAddr sdk.ValAddress, split types.CommissionSplit) {
+	k.DeleteCommissionSplit(ctx, valAddr)
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&split)
+	store.Set(types.GetCommissionSplitKey(valAddr), b)
+	if split.AutoWithdrawInterval > 0 {
+		k.queueCommissionAutoWithdraw(ctx, valAddr, split.AutoWithdrawInterval)
+	}
+}
+
+// delete the commission split of a validator, along with its queued auto-withdraw
+func (k Keeper) DeleteCommissionSplit(ctx sdk.Context, valAddr sdk.ValAddress) {
+	split, found := k.GetCommissionSplit(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCommissionSplitKey(valAddr))
+	if split.AutoWithdrawInterval > 0 {
+		store.Delete(types.GetCommissionAutoWithdrawQueueKey(nextCommissionAutoWithdrawHeight(ctx.BlockHeight(), split.AutoWithdrawInterval), valAddr))
+	}
+}
+
+// nextCommissionAutoWithdrawHeight returns the first multiple of the auto-withdraw interval after the height.
+// The queued auto-withdraw of a split is always at this height, as the due entries are processed and queued
+// again at the start of every block.
+func nextCommissionAutoWithdrawHeight(height, interval int64) int64 {
+	return (height/interval + 1) * interval
+}
+
+// queue the next auto-withdraw of the commission of a validator
+func (k Keeper) queueCommissionAutoWithdraw(ctx sdk.Context, valAddr sdk.ValAddress, interval int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCommissionAutoWithdrawQueueKey(nextCommissionAutoWithdrawHeight(ctx.BlockHeight(), interval), valAddr), []byte{})
+}
+
+// dequeue the validators whose commission auto-withdraw is due at or before the height
+func (k Keeper) DequeueDueCommissionAutoWithdrawals(ctx sdk.Context, height int64) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.CommissionAutoWithdrawQueuePrefix, sdk.PrefixEndBytes(types.GetCommissionAutoWithdrawQueuePrefix(height)))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		valAddrs = append(valAddrs, types.GetCommissionAutoWithdrawQueueValAddr(iter.Key()))
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return valAddrs
+}
+
+// iterate over the commission splits of the validators
+func (k Keeper) IterateCommissionSplits(ctx sdk.Context, handler func(split types.CommissionSplit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CommissionSplitPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var split types.CommissionSplit
+		k.cdc.MustUnmarshal(iter.Value(), &split)
+		if handler(split) {
+			break
+		}
+	}
+}
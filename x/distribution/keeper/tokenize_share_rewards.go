@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/iqlusioninc/liquidity-staking-module/x/bank/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+var _ banktypes.SendHooks = Keeper{}
+
+// accumulateSplitShareRecordReward withdraws the delegation rewards of a tokenize share record whose
+// rewards are split into the record module account, and adds them to the cumulative rewards per share
+// token of the record. The rewards stay in the module account until they are claimed by the holders.
+func (k Keeper) accumulateSplitShareRecordReward(ctx sdk.Context, record stakingtypes.TokenizeShareRecord) error {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
+
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	del := k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr)
+	if val == nil || del == nil {
+		return nil
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, record.GetModuleAddress(), valAddr)
+	if err != nil {
+		return err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).Amount
+	if rewards.IsZero() || !supply.IsPositive() {
+		return nil
+	}
+
+	rewardsPerShare := k.GetTokenizeShareRecordRewardsPerShare(ctx, record.Id)
+	rewardsPerShare = rewardsPerShare.Add(sdk.NewDecCoinsFromCoins(rewards...).QuoDecTruncate(sdk.NewDecFromInt(supply))...)
+	k.SetTokenizeShareRecordRewardsPerShare(ctx, record.Id, rewardsPerShare)
+
+	return nil
+}
+
+// claimSplitShareRecordReward pays a share token holder the rewards accumulated since its last checkpoint
+// on its checkpointed share token balance, and checkpoints the current balance. A holder without a
+// checkpoint, whose share tokens were received before the rewards were split or through a bank keeper
+// without the send hooks, is not paid and only starts earning rewards from its checkpoint, as the time
+// it has held its balance is unknown.
+func (k Keeper) claimSplitShareRecordReward(ctx sdk.Context, record stakingtypes.TokenizeShareRecord, holder sdk.AccAddress) (sdk.Coins, error) {
+	rewardsPerShare := k.GetTokenizeShareRecordRewardsPerShare(ctx, record.Id)
+	balance := k.bankKeeper.GetBalance(ctx, holder, record.GetShareTokenDenom()).Amount
+
+	checkpoint, eligible := rewardsPerShare, sdk.ZeroInt()
+	if info, found := k.GetTokenizeShareHolderRewardInfo(ctx, record.Id, holder); found {
+		// share tokens burned outside of a transfer may leave the checkpointed balance above the
+		// current one
+		checkpoint, eligible = info.RewardsPerShare, sdk.MinInt(info.Balance, balance)
+	}
+
+	owed := rewardsPerShare.Sub(checkpoint).MulDecTruncate(sdk.NewDecFromInt(eligible))
+	rewards, _ := owed.TruncateDecimal()
+
+	// guard against rounding beyond what is left in the pool
+	rewards = rewards.Min(k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress()))
+
+	if !rewards.IsZero() {
+		err := k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), holder, rewards)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClaimTokenizeShareReward,
+				sdk.NewAttribute(types.AttributeKeyWithdrawAddress, holder.String()),
+				sdk.NewAttribute(types.AttributeKeyRecordId, strconv.FormatUint(record.Id, 10)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			),
+		)
+	}
+
+	k.checkpointSplitShareRecordHolder(ctx, record, holder)
+
+	return rewards, nil
+}
+
+// checkpointSplitShareRecordHolder records the current cumulative rewards per share token of a tokenize
+// share record and the current share token balance of a holder.
+func (k Keeper) checkpointSplitShareRecordHolder(ctx sdk.Context, record stakingtypes.TokenizeShareRecord, holder sdk.AccAddress) {
+	k.SetTokenizeShareHolderRewardInfo(ctx, record.Id, holder, types.TokenizeShareHolderRewardInfo{
+		RewardsPerShare: k.GetTokenizeShareRecordRewardsPerShare(ctx, record.Id),
+		Balance:         k.bankKeeper.GetBalance(ctx, holder, record.GetShareTokenDenom()).Amount,
+	})
+}
+
+// splitShareRecordsOf returns the tokenize share records whose rewards are split, among the records of
+// the share tokens in coins.
+func (k Keeper) splitShareRecordsOf(ctx sdk.Context, coins sdk.Coins) []stakingtypes.TokenizeShareRecord {
+	records := []stakingtypes.TokenizeShareRecord{}
+	for _, coin := range coins {
+		// share token denoms are of the form {validator}/{record id}
+		if !strings.Contains(coin.Denom, "/") {
+			continue
+		}
+
+		record, err := k.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, coin.Denom)
+		if err != nil || !record.SplitRewards {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// BeforeShareTokenSend is called by the bank keeper before coins are sent between the given addresses.
+// For each tokenize share record whose rewards are split and whose share tokens are sent, it accumulates
+// the pending rewards and pays the holders the rewards earned on their balances before the transfer, so
+// that the rewards are never claimed twice on the same share tokens.
+func (k Keeper) BeforeShareTokenSend(ctx sdk.Context, addrs []sdk.AccAddress, amt sdk.Coins) error {
+	for _, record := range k.splitShareRecordsOf(ctx, amt) {
+		if err := k.accumulateSplitShareRecordReward(ctx, record); err != nil {
+			return err
+		}
+
+		for _, addr := range addrs {
+			if _, err := k.claimSplitShareRecordReward(ctx, record, addr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AfterShareTokenSend is called by the bank keeper after coins are sent between the given addresses.
+// It checkpoints the new share token balances of the holders of the records whose rewards are split,
+// from which the holders earn rewards.
+func (k Keeper) AfterShareTokenSend(ctx sdk.Context, addrs []sdk.AccAddress, amt sdk.Coins) error {
+	for _, record := range k.splitShareRecordsOf(ctx, amt) {
+		for _, addr := range addrs {
+			k.checkpointSplitShareRecordHolder(ctx, record, addr)
+		}
+	}
+	return nil
+}
+
+// ClaimTokenizeShareRecordReward pays a share token holder its part of the rewards of a tokenize
+// share record whose rewards are split between the share token holders.
+func (k Keeper) ClaimTokenizeShareRecordReward(ctx sdk.Context, holder sdk.AccAddress, recordId uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !record.SplitRewards {
+		return nil, types.ErrTokenizeShareRecordRewardsNotSplit
+	}
+
+	if err := k.accumulateSplitShareRecordReward(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return k.claimSplitShareRecordReward(ctx, record, holder)
+}
+
+// WithdrawRemovedShareRecordReward settles the rewards of a tokenize share record before it is removed.
+// The remaining module account balance is sent to the record owner and, for a record whose rewards are
+// split, the reward accumulator and the holder checkpoints are deleted.
+func (k Keeper) WithdrawRemovedShareRecordReward(ctx sdk.Context, recordId uint64) error {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return err
+	}
+
+	if !record.SplitRewards {
+		return k.WithdrawSingleShareRecordReward(ctx, recordId)
+	}
+
+	if err := k.accumulateSplitShareRecordReward(ctx, record); err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	// the holders are paid on every transfer of their share tokens, including the one that redeems
+	// them, so only the rounding dust of the reward accumulator is left at this point
+	balances := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
+	if !balances.Empty() {
+		err = k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), owner, balances)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawTokenizeShareReward,
+				sdk.NewAttribute(types.AttributeKeyWithdrawAddress, owner.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+			),
+		)
+	}
+
+	k.DeleteTokenizeShareRecordRewardsPerShare(ctx, recordId)
+	k.DeleteTokenizeShareHolderRewardInfos(ctx, recordId)
+
+	return nil
+}
+
+// compoundShareRecordReward restakes the bond denom balance of the module account of a tokenize share
+// record to its validator, which increases the tokens behind each share token. If the restake fails,
+// the balance is left in the module account and paid to the owner along with the other rewards.
+func (k Keeper) compoundShareRecordReward(ctx sdk.Context, record stakingtypes.TokenizeShareRecord, valAddr sdk.ValAddress) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount := k.bankKeeper.GetBalance(ctx, record.GetModuleAddress(), bondDenom).Amount
+	if !amount.IsPositive() {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.stakingKeeper.Restake(cacheCtx, record.GetModuleAddress(), valAddr, amount); err != nil {
+		k.Logger(ctx).Error("failed to compound tokenize share record rewards", "record_id", record.Id, "err", err)
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompoundTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyRecordId, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		),
+	)
+}
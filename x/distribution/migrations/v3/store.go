@@ -0,0 +1,31 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 2 to 3,
+// which moves the module parameters from the x/params subspace to the x/distribution store.
+// The paramstore is expected to already have the current KeyTable registered
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	// start from the defaults so the params missing from the legacy subspace are valid
+	params := types.DefaultParams()
+	paramstore.GetParamSet(ctx, &params)
+
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+	return nil
+}
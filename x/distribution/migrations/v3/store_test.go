@@ -0,0 +1,31 @@
+package v3_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	v3 "github.com/iqlusioninc/liquidity-staking-module/x/distribution/migrations/v3"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	distrKey := app.GetKey(types.StoreKey)
+
+	// set the params in the legacy subspace only
+	params := types.DefaultParams()
+	params.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	params.WithdrawAddrEnabled = false
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.SetParamSet(ctx, &params)
+	ctx.KVStore(distrKey).Delete(types.ParamsKey)
+
+	require.NoError(t, v3.MigrateStore(ctx, distrKey, app.AppCodec(), subspace))
+
+	require.Equal(t, params, app.DistrKeeper.GetParams(ctx))
+}
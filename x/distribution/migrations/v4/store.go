@@ -0,0 +1,34 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 3 to 4,
+// which initializes the auto-restake params to their default values
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+
+	params.AutoRestakeGasBudget = types.DefaultAutoRestakeGasBudget
+	params.AutoRestakeInterval = types.DefaultAutoRestakeInterval
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
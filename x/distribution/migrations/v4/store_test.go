@@ -0,0 +1,32 @@
+package v4_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	v4 "github.com/iqlusioninc/liquidity-staking-module/x/distribution/migrations/v4"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	distrKey := app.GetKey(types.StoreKey)
+	cdc := app.AppCodec()
+
+	// store the params as they were before the auto-restake params were added
+	params := types.DefaultParams()
+	params.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	legacyParams := params
+	legacyParams.AutoRestakeGasBudget = 0
+	legacyParams.AutoRestakeInterval = 0
+	ctx.KVStore(distrKey).Set(types.ParamsKey, cdc.MustMarshal(&legacyParams))
+
+	require.NoError(t, v4.MigrateStore(ctx, distrKey, cdc))
+
+	require.Equal(t, params, app.DistrKeeper.GetParams(ctx))
+}
@@ -9,15 +9,18 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
 
 // Simulation parameter constants
 const (
-	CommunityTax        = "community_tax"
-	BaseProposerReward  = "base_proposer_reward"
-	BonusProposerReward = "bonus_proposer_reward"
-	WithdrawEnabled     = "withdraw_enabled"
+	CommunityTax         = "community_tax"
+	BaseProposerReward   = "base_proposer_reward"
+	BonusProposerReward  = "bonus_proposer_reward"
+	WithdrawEnabled      = "withdraw_enabled"
+	AutoRestakeGasBudget = "auto_restake_gas_budget"
+	AutoRestakeInterval  = "auto_restake_interval"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +43,16 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoRestakeGasBudget returns a randomized AutoRestakeGasBudget parameter.
+func GenAutoRestakeGasBudget(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(5)) * 1_000_000 // auto-restaking disabled in 20% of the cases
+}
+
+// GenAutoRestakeInterval returns a randomized AutoRestakeInterval parameter.
+func GenAutoRestakeInterval(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 1, 200))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,13 +79,27 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoRestakeGasBudget uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoRestakeGasBudget, &autoRestakeGasBudget, simState.Rand,
+		func(r *rand.Rand) { autoRestakeGasBudget = GenAutoRestakeGasBudget(r) },
+	)
+
+	var autoRestakeInterval int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoRestakeInterval, &autoRestakeInterval, simState.Rand,
+		func(r *rand.Rand) { autoRestakeInterval = GenAutoRestakeInterval(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:         communityTax,
+			BaseProposerReward:   baseProposerReward,
+			BonusProposerReward:  bonusProposerReward,
+			WithdrawAddrEnabled:  withdrawEnabled,
+			AutoRestakeGasBudget: autoRestakeGasBudget,
+			AutoRestakeInterval:  autoRestakeInterval,
 		},
 	}
 
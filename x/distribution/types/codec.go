@@ -19,6 +19,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
 	cdc.RegisterConcrete(&MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeShareRecordReward", nil)
 	cdc.RegisterConcrete(&MsgWithdrawAllTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawAllTokenizeShareRecordReward", nil)
+	cdc.RegisterConcrete(&MsgClaimTokenizeShareRecordReward{}, "cosmos-sdk/MsgClaimTokenizeShareRecordReward", nil)
+	cdc.RegisterConcrete(&MsgSetAutoRestake{}, "cosmos-sdk/MsgSetAutoRestake", nil)
+	cdc.RegisterConcrete(&MsgSetTokenizeShareRecordAutoRestake{}, "cosmos-sdk/MsgSetTokenizeShareRecordAutoRestake", nil)
+	cdc.RegisterConcrete(&MsgSetCommissionSplit{}, "cosmos-sdk/MsgSetCommissionSplit", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -30,6 +35,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgFundCommunityPool{},
 		&MsgWithdrawTokenizeShareRecordReward{},
 		&MsgWithdrawAllTokenizeShareRecordReward{},
+		&MsgClaimTokenizeShareRecordReward{},
+		&MsgSetAutoRestake{},
+		&MsgSetTokenizeShareRecordAutoRestake{},
+		&MsgSetCommissionSplit{},
+		&MsgUpdateParams{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
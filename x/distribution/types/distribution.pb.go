@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +33,13 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_restake_gas_budget is the maximum amount of gas spent per block on
+	// restaking rewards of the delegations with auto-restake enabled. Zero
+	// disables auto-restaking.
+	AutoRestakeGasBudget uint64 `protobuf:"varint,5,opt,name=auto_restake_gas_budget,json=autoRestakeGasBudget,proto3" json:"auto_restake_gas_budget,omitempty"`
+	// auto_restake_interval is the minimum number of blocks between the starts
+	// of two auto-restake passes over all the enabled delegations.
+	AutoRestakeInterval int64 `protobuf:"varint,6,opt,name=auto_restake_interval,json=autoRestakeInterval,proto3" json:"auto_restake_interval,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +81,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoRestakeGasBudget() uint64 {
+	if m != nil {
+		return m.AutoRestakeGasBudget
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRestakeInterval() int64 {
+	if m != nil {
+		return m.AutoRestakeInterval
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,11 +102,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -565,8 +588,28 @@ var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
 
 // TokenizeShareRecordReward represents the properties of tokenize share
 type TokenizeShareRecordReward struct {
-	RecordId uint64                                      `protobuf:"varint,1,opt,name=recordId,proto3" json:"recordId,omitempty"`
-	Reward   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
+	RecordId uint64 `protobuf:"varint,1,opt,name=recordId,proto3" json:"recordId,omitempty"`
+	// reward is the sum of the pending rewards and the idle balance of the record.
+	// It is empty for a record whose rewards are split, as its rewards go to the
+	// share token holders instead of the owner.
+	Reward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
+	// validator_address is the validator of the tokenized delegation.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// share_denom is the denom of the share tokens of the record.
+	ShareDenom string `protobuf:"bytes,4,opt,name=share_denom,json=shareDenom,proto3" json:"share_denom,omitempty" yaml:"share_denom"`
+	// pending_reward are the rewards accrued by the tokenized delegation that
+	// have not been withdrawn yet.
+	PendingReward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=pending_reward,json=pendingReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"pending_reward" yaml:"pending_reward"`
+	// idle_balance is the balance held by the module account of the record, unless
+	// the rewards of the record are split.
+	IdleBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=idle_balance,json=idleBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"idle_balance" yaml:"idle_balance"`
+	// error is set when the pending rewards of the record cannot be computed,
+	// e.g. because its validator or delegation no longer exists.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// holders_balance is the balance held by the module account of a record whose
+	// rewards are split, which are the rewards not yet claimed by the share token
+	// holders.
+	HoldersBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=holders_balance,json=holdersBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"holders_balance" yaml:"holders_balance"`
 }
 
 func (m *TokenizeShareRecordReward) Reset()         { *m = TokenizeShareRecordReward{} }
@@ -602,6 +645,327 @@ func (m *TokenizeShareRecordReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenizeShareRecordReward proto.InternalMessageInfo
 
+// TokenizeShareRecordRewardsPerShare is the cumulative amount of rewards per share
+// token of a tokenize share record whose rewards are split between its share token holders.
+type TokenizeShareRecordRewardsPerShare struct {
+	RewardsPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards_per_share,json=rewardsPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_share" yaml:"rewards_per_share"`
+}
+
+func (m *TokenizeShareRecordRewardsPerShare) Reset()         { *m = TokenizeShareRecordRewardsPerShare{} }
+func (m *TokenizeShareRecordRewardsPerShare) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecordRewardsPerShare) ProtoMessage()    {}
+func (*TokenizeShareRecordRewardsPerShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{12}
+}
+func (m *TokenizeShareRecordRewardsPerShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecordRewardsPerShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecordRewardsPerShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecordRewardsPerShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecordRewardsPerShare.Merge(m, src)
+}
+func (m *TokenizeShareRecordRewardsPerShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecordRewardsPerShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecordRewardsPerShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecordRewardsPerShare proto.InternalMessageInfo
+
+func (m *TokenizeShareRecordRewardsPerShare) GetRewardsPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerShare
+	}
+	return nil
+}
+
+// TokenizeShareHolderRewardInfo is the checkpoint of the last reward claim of a
+// share token holder of a tokenize share record whose rewards are split.
+type TokenizeShareHolderRewardInfo struct {
+	// rewards_per_share is the cumulative amount of rewards per share token at the last checkpoint.
+	RewardsPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards_per_share,json=rewardsPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_share" yaml:"rewards_per_share"`
+	// balance is the share token balance of the holder at the last checkpoint.
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+}
+
+func (m *TokenizeShareHolderRewardInfo) Reset()         { *m = TokenizeShareHolderRewardInfo{} }
+func (m *TokenizeShareHolderRewardInfo) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareHolderRewardInfo) ProtoMessage()    {}
+func (*TokenizeShareHolderRewardInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{13}
+}
+func (m *TokenizeShareHolderRewardInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareHolderRewardInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareHolderRewardInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareHolderRewardInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareHolderRewardInfo.Merge(m, src)
+}
+func (m *TokenizeShareHolderRewardInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareHolderRewardInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareHolderRewardInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareHolderRewardInfo proto.InternalMessageInfo
+
+func (m *TokenizeShareHolderRewardInfo) GetRewardsPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerShare
+	}
+	return nil
+}
+
+// AutoRestake marks a delegation whose rewards are automatically restaked to
+// its validator.
+type AutoRestake struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoRestake) Reset()         { *m = AutoRestake{} }
+func (m *AutoRestake) String() string { return proto.CompactTextString(m) }
+func (*AutoRestake) ProtoMessage()    {}
+func (*AutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{14}
+}
+func (m *AutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRestake.Merge(m, src)
+}
+func (m *AutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRestake proto.InternalMessageInfo
+
+func (m *AutoRestake) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoRestake) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// AutoRestakeCursor tracks the progress of the current auto-restake pass.
+type AutoRestakeCursor struct {
+	// next_key is the store key of the next auto-restake entry to process. It is
+	// empty when no pass is in progress.
+	NextKey []byte `protobuf:"bytes,1,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	// pass_start_height is the height at which the last pass started.
+	PassStartHeight int64 `protobuf:"varint,2,opt,name=pass_start_height,json=passStartHeight,proto3" json:"pass_start_height,omitempty"`
+}
+
+func (m *AutoRestakeCursor) Reset()         { *m = AutoRestakeCursor{} }
+func (m *AutoRestakeCursor) String() string { return proto.CompactTextString(m) }
+func (*AutoRestakeCursor) ProtoMessage()    {}
+func (*AutoRestakeCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{15}
+}
+func (m *AutoRestakeCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRestakeCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRestakeCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRestakeCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRestakeCursor.Merge(m, src)
+}
+func (m *AutoRestakeCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRestakeCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRestakeCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRestakeCursor proto.InternalMessageInfo
+
+func (m *AutoRestakeCursor) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func (m *AutoRestakeCursor) GetPassStartHeight() int64 {
+	if m != nil {
+		return m.PassStartHeight
+	}
+	return 0
+}
+
+// CommissionSplitRecipient defines an address that receives a fraction of the
+// commission of a validator.
+type CommissionSplitRecipient struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the fraction of each commission withdrawal sent to the address.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *CommissionSplitRecipient) Reset()         { *m = CommissionSplitRecipient{} }
+func (m *CommissionSplitRecipient) String() string { return proto.CompactTextString(m) }
+func (*CommissionSplitRecipient) ProtoMessage()    {}
+func (*CommissionSplitRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{16}
+}
+func (m *CommissionSplitRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionSplitRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionSplitRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionSplitRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionSplitRecipient.Merge(m, src)
+}
+func (m *CommissionSplitRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionSplitRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionSplitRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionSplitRecipient proto.InternalMessageInfo
+
+func (m *CommissionSplitRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// CommissionSplit defines how the commission of a validator is split when it is
+// withdrawn. The commission left after the recipients and the community pool
+// share is sent to the withdraw address of the validator operator.
+type CommissionSplit struct {
+	ValidatorAddress string                     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Recipients       []CommissionSplitRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+	// community_pool_share is the fraction of each commission withdrawal sent to
+	// the community pool.
+	CommunityPoolShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool_share,json=communityPoolShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_share"`
+	// auto_withdraw_interval is the number of blocks between the automatic
+	// withdrawals of the commission. Zero disables the automatic withdrawals.
+	AutoWithdrawInterval int64 `protobuf:"varint,4,opt,name=auto_withdraw_interval,json=autoWithdrawInterval,proto3" json:"auto_withdraw_interval,omitempty"`
+}
+
+func (m *CommissionSplit) Reset()         { *m = CommissionSplit{} }
+func (m *CommissionSplit) String() string { return proto.CompactTextString(m) }
+func (*CommissionSplit) ProtoMessage()    {}
+func (*CommissionSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{17}
+}
+func (m *CommissionSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionSplit.Merge(m, src)
+}
+func (m *CommissionSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionSplit proto.InternalMessageInfo
+
+func (m *CommissionSplit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *CommissionSplit) GetRecipients() []CommissionSplitRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *CommissionSplit) GetAutoWithdrawInterval() int64 {
+	if m != nil {
+		return m.AutoWithdrawInterval
+	}
+	return 0
+}
+
 // CommunityPoolSpendProposalWithDeposit defines a CommunityPoolSpendProposal
 // with a deposit
 type CommunityPoolSpendProposalWithDeposit struct {
@@ -616,7 +980,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{12}
+	return fileDescriptor_c3e6168184371676, []int{18}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,6 +1022,12 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "liquidstaking.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "liquidstaking.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*TokenizeShareRecordReward)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordReward")
+	proto.RegisterType((*TokenizeShareRecordRewardsPerShare)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordRewardsPerShare")
+	proto.RegisterType((*TokenizeShareHolderRewardInfo)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareHolderRewardInfo")
+	proto.RegisterType((*AutoRestake)(nil), "liquidstaking.distribution.v1beta1.AutoRestake")
+	proto.RegisterType((*AutoRestakeCursor)(nil), "liquidstaking.distribution.v1beta1.AutoRestakeCursor")
+	proto.RegisterType((*CommissionSplitRecipient)(nil), "liquidstaking.distribution.v1beta1.CommissionSplitRecipient")
+	proto.RegisterType((*CommissionSplit)(nil), "liquidstaking.distribution.v1beta1.CommissionSplit")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
 }
 
@@ -666,70 +1036,101 @@ func init() {
 }
 
 var fileDescriptor_c3e6168184371676 = []byte{
-	// 1005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xb4, 0x8e, 0x93, 0x4e, 0x69, 0x02, 0x13, 0x27, 0x75, 0x4c, 0x65, 0x47, 0x2b, 0xd1,
-	0x06, 0x2a, 0xdb, 0xb4, 0x3d, 0x20, 0x45, 0x5c, 0xf2, 0x0b, 0xd1, 0x13, 0xd1, 0xa6, 0x02, 0xc4,
-	0x65, 0x35, 0xde, 0x7d, 0xb1, 0x47, 0xd9, 0x9d, 0xd9, 0xcc, 0xcc, 0x3a, 0x09, 0xd7, 0x1e, 0xf8,
-	0x71, 0x02, 0x71, 0x41, 0x1c, 0x50, 0x8e, 0x08, 0x71, 0xcc, 0x3f, 0xc0, 0xad, 0xe2, 0x54, 0x7a,
-	0x29, 0xe2, 0x10, 0x50, 0x72, 0x41, 0xfc, 0x15, 0x68, 0x76, 0xc6, 0x6b, 0x17, 0x02, 0xf4, 0x90,
-	0xa8, 0xa7, 0xe4, 0xbd, 0xb7, 0xf3, 0xbe, 0xef, 0x7b, 0xf3, 0xde, 0x1b, 0xe3, 0x5b, 0x11, 0x53,
-	0x5a, 0xb2, 0x6e, 0xa6, 0x99, 0xe0, 0x9d, 0xc1, 0x9d, 0x2e, 0x68, 0x7a, 0xa7, 0x33, 0xee, 0x6c,
-	0xa7, 0x52, 0x68, 0x41, 0xbc, 0x98, 0xed, 0x66, 0x2c, 0x52, 0x9a, 0xee, 0x30, 0xde, 0x6b, 0x3f,
-	0xf3, 0x85, 0x3b, 0x56, 0xaf, 0xf6, 0x44, 0x4f, 0xe4, 0x9f, 0x77, 0xcc, 0x7f, 0xf6, 0x64, 0xbd,
-	0x11, 0x0a, 0x95, 0x08, 0xd5, 0xe9, 0x52, 0x05, 0x05, 0x42, 0x28, 0x98, 0xcb, 0x5c, 0x5f, 0xb0,
-	0xf1, 0xc0, 0x1e, 0xb4, 0x86, 0x0d, 0x79, 0x9f, 0x5c, 0xc6, 0x95, 0x4d, 0x2a, 0x69, 0xa2, 0x08,
-	0xc5, 0xd7, 0x42, 0x91, 0x24, 0x19, 0x67, 0xfa, 0x20, 0xd0, 0x74, 0xbf, 0x86, 0x16, 0xd1, 0xd2,
-	0x95, 0xd5, 0xb7, 0x1f, 0x1d, 0x37, 0x4b, 0xbf, 0x1e, 0x37, 0x6f, 0xf6, 0x98, 0xee, 0x67, 0xdd,
-	0x76, 0x28, 0x12, 0x97, 0xc2, 0xfd, 0x69, 0xa9, 0x68, 0xa7, 0xa3, 0x0f, 0x52, 0x50, 0xed, 0x75,
-	0x08, 0x9f, 0x1c, 0xb5, 0xb0, 0x43, 0x58, 0x87, 0xd0, 0x7f, 0xa9, 0x48, 0xf9, 0x80, 0xee, 0x13,
-	0x8e, 0xab, 0x86, 0xa3, 0x21, 0x92, 0x0a, 0x05, 0x32, 0x90, 0xb0, 0x47, 0x65, 0x54, 0xbb, 0x74,
-	0x0e, 0x48, 0xc4, 0x64, 0xde, 0x74, 0x89, 0xfd, 0x3c, 0x2f, 0x49, 0xf1, 0x5c, 0x57, 0xf0, 0x4c,
-	0xfd, 0x03, 0xf0, 0xf2, 0x39, 0x00, 0xce, 0xe6, 0xa9, 0xff, 0x86, 0x78, 0x17, 0xcf, 0xed, 0x31,
-	0xdd, 0x8f, 0x24, 0xdd, 0x0b, 0x68, 0x14, 0xc9, 0x00, 0x38, 0xed, 0xc6, 0x10, 0xd5, 0xca, 0x8b,
-	0x68, 0x69, 0xca, 0x9f, 0x1d, 0x06, 0x57, 0xa2, 0x48, 0x6e, 0xd8, 0xd0, 0x72, 0xf9, 0xeb, 0xc3,
-	0x66, 0xc9, 0xfb, 0x19, 0xe1, 0xfa, 0xfb, 0x34, 0x66, 0x11, 0xd5, 0x42, 0xbe, 0xcb, 0x94, 0x16,
-	0x92, 0x85, 0x34, 0xb6, 0x79, 0x15, 0xf9, 0x0c, 0xe1, 0xeb, 0x61, 0x96, 0x64, 0x31, 0xd5, 0x6c,
-	0x00, 0x4e, 0x47, 0x20, 0xa9, 0x66, 0xa2, 0x86, 0x16, 0x2f, 0x2f, 0x5d, 0xbd, 0x7b, 0xa3, 0xed,
-	0xc8, 0x99, 0x42, 0x0c, 0x3b, 0xc6, 0x30, 0x5d, 0x13, 0x8c, 0xaf, 0xde, 0x33, 0x5a, 0xbf, 0xff,
-	0xad, 0x79, 0xfb, 0xf9, 0xb4, 0x9a, 0x33, 0xca, 0x9f, 0x1b, 0x21, 0x5a, 0x1e, 0xbe, 0xc1, 0x23,
-	0xb7, 0xf0, 0x8c, 0x84, 0x6d, 0x90, 0xc0, 0x43, 0x08, 0x42, 0x91, 0x71, 0x9d, 0xdf, 0xe0, 0x35,
-	0x7f, 0xba, 0x70, 0xaf, 0x19, 0xaf, 0xf7, 0x2d, 0xc2, 0xd7, 0x0b, 0x4d, 0x6b, 0x99, 0x94, 0xc0,
-	0xf5, 0x50, 0xd0, 0x0e, 0x9e, 0xb4, 0x22, 0xd4, 0xc5, 0xf1, 0x1f, 0x22, 0x90, 0x79, 0x5c, 0x49,
-	0x41, 0x32, 0x61, 0x5b, 0xad, 0xec, 0x3b, 0xcb, 0xfb, 0x0a, 0xe1, 0x46, 0x41, 0x70, 0x25, 0x74,
-	0x72, 0x21, 0x5a, 0x13, 0x49, 0xc2, 0x94, 0x62, 0x82, 0x93, 0x5d, 0x8c, 0xc3, 0xc2, 0xba, 0x38,
-	0xaa, 0x63, 0x20, 0xde, 0xe7, 0x08, 0xbf, 0x5a, 0xb0, 0x7a, 0x2f, 0xd3, 0x4a, 0x53, 0x1e, 0x31,
-	0xde, 0x7b, 0x11, 0xa5, 0xf3, 0xbe, 0x41, 0x78, 0xb6, 0x20, 0xb3, 0x15, 0x53, 0xd5, 0xdf, 0x18,
-	0x00, 0xd7, 0xe4, 0x75, 0xfc, 0xf2, 0x60, 0xe8, 0x0e, 0x5c, 0x71, 0x51, 0x5e, 0xdc, 0x99, 0xc2,
-	0xbf, 0x99, 0xbb, 0xc9, 0x87, 0x78, 0x6a, 0x5b, 0xd2, 0xd0, 0x6c, 0xb2, 0x73, 0x19, 0xf5, 0x22,
-	0x9b, 0xf7, 0x25, 0xc2, 0xd5, 0x33, 0xc8, 0x29, 0xa2, 0xf0, 0xfc, 0x88, 0x9d, 0x32, 0x81, 0x00,
-	0xf2, 0x88, 0xab, 0xd8, 0x5b, 0xed, 0xff, 0xdf, 0xb6, 0xed, 0x33, 0x32, 0xaf, 0x96, 0x0d, 0x73,
-	0xbf, 0x3a, 0x38, 0x03, 0xd4, 0x0d, 0xf2, 0x43, 0x84, 0x27, 0xdf, 0x01, 0xd8, 0x14, 0x22, 0x26,
-	0xfb, 0x78, 0x7a, 0xb4, 0x53, 0x53, 0x21, 0xe2, 0x8b, 0xbb, 0xb0, 0xd1, 0xf2, 0x36, 0xc8, 0xde,
-	0xc3, 0x4b, 0xb8, 0xbe, 0x36, 0xee, 0xd9, 0x4a, 0x81, 0x47, 0x76, 0x5b, 0xd1, 0x98, 0x54, 0xf1,
-	0x84, 0x66, 0x3a, 0x06, 0xbb, 0xe4, 0x7d, 0x6b, 0x90, 0x45, 0x7c, 0x35, 0x02, 0x15, 0x4a, 0x96,
-	0x8e, 0xee, 0xca, 0x1f, 0x77, 0x91, 0x1b, 0xf8, 0x8a, 0x84, 0x90, 0xa5, 0x0c, 0xb8, 0xb6, 0x5b,
-	0xd4, 0x1f, 0x39, 0x48, 0x88, 0x2b, 0x34, 0xc9, 0xf7, 0x41, 0x39, 0x97, 0xb9, 0x70, 0xa6, 0xcc,
-	0x5c, 0xe3, 0x9b, 0x4e, 0xe3, 0xd2, 0x73, 0x68, 0xb4, 0x02, 0x5d, 0xea, 0xe5, 0x37, 0x3e, 0x3d,
-	0x6c, 0x96, 0x4c, 0xa5, 0xff, 0x38, 0x6c, 0x96, 0x7e, 0x3a, 0x6a, 0xd5, 0x1d, 0x46, 0x4f, 0x0c,
-	0xc6, 0x20, 0xb8, 0x06, 0xae, 0xbd, 0x1f, 0x11, 0x9e, 0x5b, 0x87, 0x18, 0x7a, 0xf9, 0x55, 0x69,
-	0x2a, 0x35, 0xe3, 0xbd, 0xfb, 0x7c, 0x3b, 0xdf, 0x61, 0xa9, 0x84, 0x01, 0x13, 0xe6, 0x75, 0x18,
-	0xef, 0xde, 0xe9, 0xa1, 0xdb, 0x35, 0xaf, 0x8f, 0x27, 0x4c, 0x93, 0xc0, 0xb9, 0x74, 0xae, 0x4d,
-	0x45, 0x6e, 0xe3, 0x4a, 0x1f, 0x58, 0xaf, 0x6f, 0x4b, 0x58, 0x5e, 0x9d, 0xfd, 0xf3, 0xb8, 0x39,
-	0x13, 0x4a, 0x30, 0xdb, 0x95, 0x07, 0x36, 0xe4, 0xbb, 0x4f, 0xbc, 0xa7, 0x08, 0x2f, 0x38, 0x0d,
-	0x4c, 0xf0, 0x42, 0x8d, 0x7b, 0x70, 0x36, 0xf0, 0x2b, 0xa3, 0x46, 0x37, 0x2f, 0x0e, 0x28, 0xe5,
-	0x5e, 0xee, 0xda, 0x93, 0xa3, 0x56, 0xd5, 0x81, 0xaf, 0xd8, 0xc8, 0x96, 0x96, 0x66, 0x8f, 0x8c,
-	0x26, 0xd7, 0xf9, 0x09, 0xc3, 0x95, 0xe2, 0x2d, 0xbe, 0xa0, 0x06, 0x75, 0x00, 0xcb, 0x53, 0xee,
-	0xfe, 0x90, 0xf7, 0x03, 0xc2, 0x0b, 0x0f, 0xc4, 0x0e, 0x70, 0xf6, 0x31, 0x6c, 0xf5, 0xa9, 0x04,
-	0x1f, 0x42, 0x21, 0x23, 0xa7, 0xac, 0x8e, 0xa7, 0x64, 0x6e, 0xdf, 0x1f, 0x5e, 0x4d, 0x61, 0xbf,
-	0x18, 0xba, 0x4f, 0x11, 0x7e, 0xed, 0xdf, 0x47, 0xea, 0x03, 0xa6, 0xfb, 0xeb, 0x90, 0x0a, 0xc5,
-	0xf4, 0x05, 0x4d, 0xd7, 0xfc, 0xd8, 0x74, 0x99, 0x90, 0xb3, 0x48, 0x0d, 0x4f, 0x46, 0x16, 0xb8,
-	0x36, 0x91, 0x07, 0x86, 0xe6, 0xf2, 0xcd, 0x21, 0xf7, 0xff, 0x1e, 0x93, 0xd5, 0xee, 0x77, 0x27,
-	0x0d, 0xf4, 0xe8, 0xa4, 0x81, 0x1e, 0x9f, 0x34, 0xd0, 0xef, 0x27, 0x0d, 0xf4, 0xc5, 0x69, 0xa3,
-	0xf4, 0xf8, 0xb4, 0x51, 0xfa, 0xe5, 0xb4, 0x51, 0xfa, 0x68, 0x7d, 0xac, 0x6c, 0x6c, 0x37, 0xce,
-	0xcc, 0x1b, 0xc5, 0x78, 0xd8, 0xb1, 0x1b, 0x94, 0xe9, 0x83, 0x96, 0xdb, 0xa2, 0xad, 0x44, 0x44,
-	0x59, 0x0c, 0x9d, 0xfd, 0x67, 0x7e, 0xde, 0xda, 0xc2, 0x76, 0x2b, 0xf9, 0x0f, 0xce, 0x7b, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x23, 0x17, 0x82, 0x10, 0x0b, 0x00, 0x00,
+	// 1489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x4f, 0x1c, 0x47,
+	0x16, 0xa7, 0x61, 0x18, 0xe0, 0x61, 0xc3, 0x52, 0x7c, 0x78, 0x60, 0xbd, 0x33, 0xa8, 0xa5, 0xb5,
+	0x59, 0x5b, 0xc0, 0xda, 0xde, 0x95, 0x25, 0xe4, 0x0b, 0x03, 0x5e, 0x9b, 0x4d, 0x24, 0xa3, 0xc6,
+	0x72, 0x22, 0x5f, 0x5a, 0x35, 0xdd, 0xe5, 0xa1, 0x44, 0x4f, 0xd5, 0xb8, 0xaa, 0x66, 0x80, 0x5c,
+	0xad, 0x48, 0x49, 0x0e, 0x51, 0x2c, 0x5f, 0xf2, 0x21, 0x45, 0x3e, 0xe4, 0x10, 0xe5, 0xec, 0x3f,
+	0x20, 0xb9, 0x59, 0x39, 0x39, 0xbe, 0x24, 0xca, 0x81, 0x44, 0xf8, 0x12, 0xe5, 0x48, 0xfe, 0x81,
+	0xa8, 0x3e, 0xa6, 0xa7, 0xc7, 0xc6, 0x31, 0x28, 0x90, 0xe4, 0x04, 0x55, 0xaf, 0xdf, 0x7b, 0xbf,
+	0xf7, 0xea, 0xd5, 0xef, 0xbd, 0x1a, 0x38, 0x1b, 0x53, 0xa9, 0x04, 0xad, 0x34, 0x14, 0xe5, 0x6c,
+	0xbe, 0x79, 0xa1, 0x42, 0x14, 0xbe, 0x30, 0x9f, 0xdd, 0x9c, 0xab, 0x0b, 0xae, 0x38, 0xf2, 0x13,
+	0x7a, 0xb7, 0x41, 0x63, 0xa9, 0xf0, 0x06, 0x65, 0xd5, 0xb9, 0x8e, 0x2f, 0x9c, 0xda, 0xd4, 0x58,
+	0x95, 0x57, 0xb9, 0xf9, 0x7c, 0x5e, 0xff, 0x67, 0x35, 0xa7, 0x8a, 0x11, 0x97, 0x35, 0x2e, 0xe7,
+	0x2b, 0x58, 0x92, 0xd4, 0x43, 0xc4, 0xa9, 0xb3, 0x3c, 0x35, 0x69, 0xe5, 0xa1, 0x55, 0xb4, 0x0b,
+	0x2b, 0xf2, 0xf7, 0x7a, 0x20, 0xbf, 0x8a, 0x05, 0xae, 0x49, 0x84, 0xe1, 0x64, 0xc4, 0x6b, 0xb5,
+	0x06, 0xa3, 0x6a, 0x3b, 0x54, 0x78, 0xab, 0xe0, 0x4d, 0x7b, 0x33, 0x03, 0xe5, 0x2b, 0x8f, 0x77,
+	0x4a, 0x5d, 0xdf, 0xef, 0x94, 0xce, 0x54, 0xa9, 0x5a, 0x6f, 0x54, 0xe6, 0x22, 0x5e, 0x73, 0x26,
+	0xdc, 0x9f, 0x59, 0x19, 0x6f, 0xcc, 0xab, 0xed, 0x3a, 0x91, 0x73, 0xcb, 0x24, 0x7a, 0xfa, 0x68,
+	0x16, 0x9c, 0x87, 0x65, 0x12, 0x05, 0x27, 0x52, 0x93, 0x37, 0xf1, 0x16, 0x62, 0x30, 0xa6, 0x31,
+	0x6a, 0x20, 0x75, 0x2e, 0x89, 0x08, 0x05, 0xd9, 0xc4, 0x22, 0x2e, 0x74, 0x1f, 0x81, 0x27, 0xa4,
+	0x2d, 0xaf, 0x3a, 0xc3, 0x81, 0xb1, 0x8b, 0xea, 0x30, 0x5e, 0xe1, 0xac, 0x21, 0x5f, 0x70, 0xd8,
+	0x73, 0x04, 0x0e, 0x47, 0x8d, 0xe9, 0xe7, 0x3c, 0x5e, 0x84, 0xf1, 0x4d, 0xaa, 0xd6, 0x63, 0x81,
+	0x37, 0x43, 0x1c, 0xc7, 0x22, 0x24, 0x0c, 0x57, 0x12, 0x12, 0x17, 0x72, 0xd3, 0xde, 0x4c, 0x7f,
+	0x30, 0xda, 0x12, 0x2e, 0xc6, 0xb1, 0xb8, 0x6a, 0x45, 0xe8, 0xbf, 0x70, 0x0a, 0x37, 0x14, 0x0f,
+	0x05, 0xd1, 0x67, 0x4f, 0xc2, 0x2a, 0x96, 0x61, 0xa5, 0x11, 0x57, 0x89, 0x2a, 0xf4, 0x4e, 0x7b,
+	0x33, 0xb9, 0x60, 0x4c, 0x8b, 0x03, 0x2b, 0xbd, 0x86, 0x65, 0xd9, 0xc8, 0xb4, 0xab, 0x0e, 0x35,
+	0xca, 0x14, 0x11, 0x4d, 0x9c, 0x14, 0xf2, 0xd3, 0xde, 0x4c, 0x4f, 0x30, 0x9a, 0x51, 0x5a, 0x71,
+	0xa2, 0x85, 0xdc, 0x87, 0x0f, 0x4b, 0x5d, 0xfe, 0x37, 0x1e, 0x4c, 0xdd, 0xc2, 0x09, 0x8d, 0xb1,
+	0xe2, 0xe2, 0x3a, 0x95, 0x8a, 0x0b, 0x1a, 0xe1, 0xc4, 0x86, 0x20, 0xd1, 0xbb, 0x1e, 0x9c, 0x8a,
+	0x1a, 0xb5, 0x46, 0x82, 0x15, 0x6d, 0x12, 0x97, 0xb2, 0x50, 0x60, 0x45, 0x79, 0xc1, 0x9b, 0xee,
+	0x99, 0x19, 0xbc, 0x78, 0x7a, 0xce, 0xe5, 0x41, 0xe7, 0xbc, 0x55, 0x9c, 0x3a, 0x29, 0x4b, 0x9c,
+	0xb2, 0xf2, 0x25, 0x9d, 0xd6, 0x2f, 0x7e, 0x28, 0x9d, 0x3f, 0x58, 0x5a, 0xb5, 0x8e, 0x0c, 0xc6,
+	0xdb, 0x1e, 0x2d, 0x8e, 0x40, 0xfb, 0x43, 0x67, 0x61, 0x58, 0x90, 0x3b, 0x44, 0x10, 0x16, 0x91,
+	0x30, 0xe2, 0x0d, 0xa6, 0x4c, 0xb1, 0x9c, 0x0c, 0x86, 0xd2, 0xed, 0x25, 0xbd, 0xeb, 0x7f, 0xea,
+	0xc1, 0xa9, 0x34, 0xa6, 0xa5, 0x86, 0x10, 0x84, 0xa9, 0x56, 0x40, 0x1b, 0xd0, 0x67, 0x83, 0x90,
+	0xc7, 0x87, 0xbf, 0xe5, 0x01, 0x4d, 0x40, 0xbe, 0x4e, 0x04, 0xe5, 0xb6, 0xaa, 0x73, 0x81, 0x5b,
+	0xf9, 0x0f, 0x3c, 0x28, 0xa6, 0x00, 0x17, 0x23, 0x17, 0x2e, 0x89, 0x97, 0x78, 0xad, 0x46, 0xa5,
+	0xa4, 0x9c, 0xa1, 0xbb, 0x00, 0x51, 0xba, 0x3a, 0x3e, 0xa8, 0x19, 0x27, 0xfe, 0x7b, 0x1e, 0xfc,
+	0x3d, 0x45, 0x75, 0xa3, 0xa1, 0xa4, 0xc2, 0x2c, 0xa6, 0xac, 0xfa, 0x67, 0xa4, 0xce, 0xff, 0xd8,
+	0x83, 0xd1, 0x14, 0xcc, 0x5a, 0x82, 0xe5, 0xfa, 0xd5, 0x26, 0x61, 0x0a, 0xfd, 0x0b, 0xfe, 0xd6,
+	0x6c, 0x6d, 0x87, 0x2e, 0xb9, 0x9e, 0x49, 0xee, 0x70, 0xba, 0xbf, 0x6a, 0xb6, 0xd1, 0x9b, 0xd0,
+	0x7f, 0x47, 0xe0, 0x48, 0x93, 0xe6, 0x91, 0xb0, 0x4a, 0x6a, 0xcd, 0xbf, 0xef, 0xc1, 0xd8, 0x3e,
+	0xe0, 0x24, 0x92, 0x30, 0xd1, 0x46, 0x27, 0xb5, 0x20, 0x24, 0x46, 0xe2, 0x32, 0x76, 0x79, 0xee,
+	0xd5, 0xc4, 0x3e, 0xb7, 0x8f, 0xe5, 0x72, 0x4e, 0x23, 0x0f, 0xc6, 0x9a, 0xfb, 0x38, 0x75, 0x17,
+	0xf9, 0x9e, 0x07, 0x7d, 0xff, 0x23, 0x64, 0x95, 0xf3, 0x04, 0x6d, 0xc1, 0x50, 0x9b, 0xbe, 0xeb,
+	0x9c, 0x27, 0xc7, 0x77, 0x60, 0xed, 0x3e, 0xa1, 0x3d, 0xfb, 0xf7, 0xba, 0x61, 0x6a, 0x29, 0xbb,
+	0xb3, 0x56, 0x27, 0x2c, 0xb6, 0xc4, 0x88, 0x13, 0x34, 0x06, 0xbd, 0x8a, 0xaa, 0x84, 0xd8, 0x7e,
+	0x12, 0xd8, 0x05, 0x9a, 0x86, 0xc1, 0x98, 0xc8, 0x48, 0xd0, 0x7a, 0xfb, 0xac, 0x82, 0xec, 0x16,
+	0x3a, 0x0d, 0x03, 0x82, 0x44, 0xb4, 0x4e, 0x09, 0x53, 0x96, 0xb0, 0x83, 0xf6, 0x06, 0x8a, 0x20,
+	0x8f, 0x6b, 0x86, 0x0f, 0x72, 0x26, 0xcc, 0xc9, 0x7d, 0xc3, 0x34, 0x31, 0xfe, 0xdb, 0xc5, 0x38,
+	0x73, 0x80, 0x18, 0x6d, 0x80, 0xce, 0xf4, 0xc2, 0xb9, 0x77, 0x1e, 0x96, 0xba, 0x74, 0xa6, 0x7f,
+	0x7a, 0x58, 0xea, 0xfa, 0xfa, 0xd1, 0xec, 0x94, 0xf3, 0x51, 0xe5, 0xcd, 0x8c, 0x0b, 0xa6, 0x08,
+	0x53, 0xfe, 0x57, 0x1e, 0x8c, 0x2f, 0x93, 0x84, 0x54, 0xcd, 0x51, 0x29, 0x2c, 0x14, 0x65, 0xd5,
+	0x15, 0x76, 0xc7, 0x70, 0x58, 0x5d, 0x90, 0x26, 0xe5, 0xba, 0x11, 0x65, 0xab, 0x77, 0xa8, 0xb5,
+	0xed, 0x8a, 0x37, 0x80, 0x5e, 0x43, 0xd7, 0x47, 0x52, 0xb9, 0xd6, 0x14, 0x3a, 0x0f, 0xf9, 0x75,
+	0x42, 0xab, 0xeb, 0x36, 0x85, 0xb9, 0xf2, 0xe8, 0xcf, 0x3b, 0xa5, 0xe1, 0x48, 0x10, 0xcd, 0xae,
+	0x2c, 0xb4, 0xa2, 0xc0, 0x7d, 0xe2, 0x7f, 0xeb, 0xc1, 0xa4, 0x8b, 0x81, 0x72, 0x96, 0x46, 0xe3,
+	0x7a, 0xdb, 0x55, 0x18, 0x69, 0x17, 0xba, 0x6e, 0x6e, 0x44, 0x4a, 0x37, 0x24, 0x14, 0x9e, 0x3e,
+	0x9a, 0x1d, 0x73, 0xce, 0x17, 0xad, 0x64, 0x4d, 0x09, 0xcd, 0x23, 0xed, 0x9b, 0xeb, 0xf6, 0x11,
+	0x85, 0x7c, 0xda, 0xf6, 0x8f, 0xa9, 0x40, 0x9d, 0x83, 0x85, 0x7e, 0x77, 0x7e, 0x9e, 0xff, 0x4b,
+	0x2f, 0x4c, 0xde, 0xe4, 0x1b, 0x84, 0xd1, 0xb7, 0xc8, 0xda, 0x3a, 0x16, 0x24, 0x20, 0x11, 0x17,
+	0xb1, 0x8b, 0x6c, 0x0a, 0xfa, 0x85, 0x59, 0xaf, 0xb4, 0x8e, 0x26, 0x5d, 0xff, 0x81, 0x70, 0xd1,
+	0xca, 0x7e, 0x09, 0xb6, 0xa3, 0xca, 0xe9, 0xbd, 0x9d, 0x52, 0x61, 0x1b, 0xd7, 0x92, 0x05, 0xff,
+	0x85, 0x4f, 0xfc, 0x7d, 0x92, 0x7c, 0x19, 0x06, 0xa5, 0x0e, 0x33, 0x8c, 0x09, 0xe3, 0x35, 0x33,
+	0x7d, 0x0c, 0x94, 0x27, 0xf6, 0x76, 0x4a, 0xc8, 0x1a, 0xc9, 0x08, 0xfd, 0x00, 0xcc, 0x6a, 0x59,
+	0x2f, 0xd0, 0x7d, 0x0f, 0x86, 0xf4, 0xf5, 0xa5, 0xac, 0xda, 0x1a, 0x96, 0x7a, 0x0f, 0x10, 0xf7,
+	0xeb, 0x3a, 0xee, 0xbd, 0x9d, 0xd2, 0xb8, 0x35, 0xdf, 0x69, 0xc1, 0x3f, 0x34, 0xc1, 0x38, 0x7d,
+	0x77, 0x3c, 0x6f, 0x7b, 0x70, 0x82, 0xc6, 0x09, 0x09, 0x2b, 0x38, 0xc1, 0x2c, 0x22, 0x85, 0xfc,
+	0xab, 0xae, 0xfc, 0x35, 0x07, 0x67, 0xd4, 0xc2, 0xc9, 0x2a, 0xfb, 0x87, 0x62, 0x82, 0x41, 0xad,
+	0x5a, 0xb6, 0x9a, 0x9a, 0xc9, 0x88, 0x10, 0x5c, 0x14, 0xfa, 0x2c, 0x93, 0x99, 0x05, 0x7a, 0xdf,
+	0x83, 0xe1, 0x75, 0x9e, 0xc4, 0x44, 0xc8, 0x14, 0x60, 0xff, 0xab, 0x00, 0xfe, 0xdf, 0x01, 0x9c,
+	0xb0, 0x00, 0x9f, 0xd3, 0x3f, 0x1c, 0xc6, 0x21, 0xa7, 0xed, 0x60, 0x66, 0xaa, 0xfe, 0x4b, 0x0f,
+	0xfc, 0x97, 0x56, 0xbd, 0x66, 0x1d, 0xb3, 0x89, 0x3e, 0xf2, 0x60, 0xc4, 0xf5, 0x60, 0x4d, 0x50,
+	0xa1, 0x29, 0x87, 0x03, 0xb5, 0x8f, 0x1b, 0x2e, 0x0c, 0x57, 0x9a, 0x2f, 0x18, 0x39, 0xf4, 0xc9,
+	0x0f, 0x8b, 0x4e, 0x6c, 0xfe, 0x83, 0x6e, 0xf8, 0x47, 0x47, 0x08, 0xd7, 0x4d, 0xb0, 0x36, 0x04,
+	0x43, 0xaf, 0x7f, 0x61, 0xf4, 0xe8, 0x16, 0xf4, 0xb5, 0x4a, 0xe2, 0xf0, 0x9c, 0xbe, 0xc2, 0x54,
+	0x86, 0xd3, 0x57, 0x98, 0x0a, 0x5a, 0xc6, 0xfc, 0x4f, 0x3c, 0x18, 0x5c, 0x6c, 0xcf, 0xf7, 0x9a,
+	0x9a, 0xe3, 0x16, 0x5b, 0x1f, 0x9c, 0x9a, 0x53, 0x15, 0xb7, 0xbf, 0x3f, 0xc3, 0x77, 0x1f, 0x96,
+	0xe1, 0xfd, 0xdb, 0x30, 0x92, 0x01, 0xb7, 0xd4, 0x10, 0x92, 0x0b, 0x34, 0x09, 0xfd, 0x8c, 0x6c,
+	0xa9, 0x70, 0x83, 0x6c, 0x1b, 0x64, 0x27, 0x82, 0x3e, 0xbd, 0x7e, 0x8d, 0x6c, 0xa3, 0x73, 0x30,
+	0x52, 0xc7, 0x52, 0x86, 0x52, 0x77, 0x4d, 0xd7, 0x93, 0x8c, 0xdb, 0x9e, 0x60, 0x58, 0x0b, 0x4c,
+	0x37, 0xbd, 0x6e, 0x5b, 0xd4, 0x67, 0x1e, 0x14, 0xda, 0x23, 0xf3, 0x5a, 0x3d, 0xa1, 0x2a, 0x48,
+	0x87, 0x82, 0x8b, 0xd0, 0x77, 0xd0, 0xe0, 0x5b, 0x1f, 0xa2, 0x9b, 0x90, 0xdf, 0x6c, 0x7b, 0xfc,
+	0xbd, 0x5d, 0xd7, 0xd9, 0xf2, 0x77, 0xbb, 0x61, 0xf8, 0x39, 0x98, 0x47, 0xd5, 0x3f, 0x2b, 0x00,
+	0xe9, 0x18, 0x24, 0x5d, 0x53, 0xba, 0x72, 0x90, 0x19, 0xf3, 0x65, 0x69, 0x73, 0x83, 0x66, 0xc6,
+	0xaa, 0x7e, 0xa8, 0x77, 0x0e, 0x93, 0xee, 0x56, 0x1d, 0xc5, 0xbb, 0x19, 0x75, 0x4c, 0x8f, 0xf6,
+	0x9e, 0xfc, 0x07, 0x26, 0xcc, 0x5b, 0x36, 0x7d, 0x3b, 0xa7, 0x8f, 0xd9, 0x9c, 0x29, 0x03, 0xf3,
+	0x02, 0x7e, 0xc3, 0x09, 0x5b, 0xaf, 0x59, 0x3d, 0xae, 0xfc, 0xf3, 0xe5, 0x83, 0xa7, 0xfe, 0x7c,
+	0x99, 0xd4, 0xb9, 0xa4, 0xea, 0x98, 0x66, 0xd0, 0x89, 0xcc, 0x0c, 0xaa, 0x45, 0x6e, 0x85, 0x0a,
+	0xd0, 0x17, 0x5b, 0xc7, 0xe6, 0x01, 0x3f, 0x10, 0xb4, 0x96, 0x0b, 0x67, 0x5a, 0xd4, 0xfc, 0xdb,
+	0xc3, 0x64, 0xb9, 0xf2, 0xf9, 0x6e, 0xd1, 0x7b, 0xbc, 0x5b, 0xf4, 0x9e, 0xec, 0x16, 0xbd, 0x1f,
+	0x77, 0x8b, 0xde, 0x07, 0xcf, 0x8a, 0x5d, 0x4f, 0x9e, 0x15, 0xbb, 0xbe, 0x7b, 0x56, 0xec, 0xba,
+	0xbd, 0x9c, 0xc9, 0x3b, 0xbd, 0x9b, 0x34, 0xf4, 0x91, 0x52, 0x16, 0xcd, 0xdb, 0x1a, 0xa0, 0x6a,
+	0x7b, 0xd6, 0xd5, 0xc1, 0x6c, 0x8d, 0xc7, 0x8d, 0x84, 0xcc, 0x6f, 0x75, 0xfc, 0xde, 0x64, 0x4f,
+	0xa6, 0x92, 0x37, 0xbf, 0x00, 0x5d, 0xfa, 0x75, 0x00, 0x78, 0x4d, 0x9a, 0x01, 0xa1, 0x12, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -763,6 +1164,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoRestakeGasBudget != that1.AutoRestakeGasBudget {
+		return false
+	}
+	if this.AutoRestakeInterval != that1.AutoRestakeInterval {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1064,16 +1471,49 @@ func (this *TokenizeShareRecordReward) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.ShareDenom != that1.ShareDenom {
+		return false
+	}
+	if len(this.PendingReward) != len(that1.PendingReward) {
+		return false
+	}
+	for i := range this.PendingReward {
+		if !this.PendingReward[i].Equal(&that1.PendingReward[i]) {
+			return false
+		}
+	}
+	if len(this.IdleBalance) != len(that1.IdleBalance) {
+		return false
+	}
+	for i := range this.IdleBalance {
+		if !this.IdleBalance[i].Equal(&that1.IdleBalance[i]) {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if len(this.HoldersBalance) != len(that1.HoldersBalance) {
+		return false
+	}
+	for i := range this.HoldersBalance {
+		if !this.HoldersBalance[i].Equal(&that1.HoldersBalance[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
+func (this *TokenizeShareRecordRewardsPerShare) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+	that1, ok := that.(*TokenizeShareRecordRewardsPerShare)
 	if !ok {
-		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
+		that2, ok := that.(TokenizeShareRecordRewardsPerShare)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1085,43 +1525,233 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	} else if this == nil {
 		return false
 	}
-	if this.Title != that1.Title {
+	if len(this.RewardsPerShare) != len(that1.RewardsPerShare) {
 		return false
 	}
-	if this.Description != that1.Description {
-		return false
+	for i := range this.RewardsPerShare {
+		if !this.RewardsPerShare[i].Equal(&that1.RewardsPerShare[i]) {
+			return false
+		}
 	}
-	if this.Recipient != that1.Recipient {
+	return true
+}
+func (this *TokenizeShareHolderRewardInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareHolderRewardInfo)
+	if !ok {
+		that2, ok := that.(TokenizeShareHolderRewardInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Amount != that1.Amount {
+	if len(this.RewardsPerShare) != len(that1.RewardsPerShare) {
 		return false
 	}
-	if this.Deposit != that1.Deposit {
+	for i := range this.RewardsPerShare {
+		if !this.RewardsPerShare[i].Equal(&that1.RewardsPerShare[i]) {
+			return false
+		}
+	}
+	if !this.Balance.Equal(that1.Balance) {
 		return false
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AutoRestake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	that1, ok := that.(*AutoRestake)
+	if !ok {
+		that2, ok := that.(AutoRestake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	return true
+}
+func (this *AutoRestakeCursor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoRestakeCursor)
+	if !ok {
+		that2, ok := that.(AutoRestakeCursor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.NextKey, that1.NextKey) {
+		return false
+	}
+	if this.PassStartHeight != that1.PassStartHeight {
+		return false
+	}
+	return true
+}
+func (this *CommissionSplitRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionSplitRecipient)
+	if !ok {
+		that2, ok := that.(CommissionSplitRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *CommissionSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionSplit)
+	if !ok {
+		that2, ok := that.(CommissionSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
+	}
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
+	}
+	if !this.CommunityPoolShare.Equal(that1.CommunityPoolShare) {
+		return false
+	}
+	if this.AutoWithdrawInterval != that1.AutoWithdrawInterval {
+		return false
+	}
+	return true
+}
+func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+	if !ok {
+		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRestakeInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoRestakeInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoRestakeGasBudget != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoRestakeGasBudget))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1600,6 +2230,69 @@ func (m *TokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.HoldersBalance) > 0 {
+		for iNdEx := len(m.HoldersBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HoldersBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IdleBalance) > 0 {
+		for iNdEx := len(m.IdleBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IdleBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingReward) > 0 {
+		for iNdEx := len(m.PendingReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ShareDenom) > 0 {
+		i -= len(m.ShareDenom)
+		copy(dAtA[i:], m.ShareDenom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ShareDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Reward) > 0 {
 		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1622,7 +2315,7 @@ func (m *TokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+func (m *TokenizeShareRecordRewardsPerShare) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1632,68 +2325,323 @@ func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenizeShareRecordRewardsPerShare) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenizeShareRecordRewardsPerShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.RewardsPerShare) > 0 {
+		for iNdEx := len(m.RewardsPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenizeShareHolderRewardInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *TokenizeShareHolderRewardInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareHolderRewardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RewardsPerShare) > 0 {
+		for iNdEx := len(m.RewardsPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRestakeCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRestakeCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRestakeCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PassStartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PassStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionSplitRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionSplitRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionSplitRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoWithdrawInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoWithdrawInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.CommunityPoolShare.Size()
+		i -= size
+		if _, err := m.CommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1706,6 +2654,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoRestakeGasBudget != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoRestakeGasBudget))
+	}
+	if m.AutoRestakeInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoRestakeInterval))
+	}
 	return n
 }
 
@@ -1897,26 +2851,160 @@ func (m *TokenizeShareRecordReward) Size() (n int) {
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.ShareDenom)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if len(m.PendingReward) > 0 {
+		for _, e := range m.PendingReward {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.IdleBalance) > 0 {
+		for _, e := range m.IdleBalance {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.HoldersBalance) > 0 {
+		for _, e := range m.HoldersBalance {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareRecordRewardsPerShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardsPerShare) > 0 {
+		for _, e := range m.RewardsPerShare {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareHolderRewardInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardsPerShare) > 0 {
+		for _, e := range m.RewardsPerShare {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *AutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoRestakeCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.PassStartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.PassStartHeight))
+	}
+	return n
+}
+
+func (m *CommissionSplitRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CommissionSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = m.CommunityPoolShare.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.AutoWithdrawInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoWithdrawInterval))
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
 	}
 	l = len(m.Amount)
 	if l > 0 {
@@ -2086,6 +3174,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeGasBudget", wireType)
+			}
+			m.AutoRestakeGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestakeGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeInterval", wireType)
+			}
+			m.AutoRestakeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRestakeInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2533,7 +3659,894 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			}
+			m.PreviousPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingReward = append(m.PendingReward, types.DecCoin{})
+			if err := m.PendingReward[len(m.PendingReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdleBalance = append(m.IdleBalance, types.Coin{})
+			if err := m.IdleBalance[len(m.IdleBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersBalance", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2543,23 +4556,23 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HoldersBalance = append(m.HoldersBalance, types.Coin{})
+			if err := m.HoldersBalance[len(m.HoldersBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2584,7 +4597,7 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecordRewardsPerShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2607,15 +4620,15 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecordRewardsPerShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecordRewardsPerShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2642,8 +4655,8 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardsPerShare = append(m.RewardsPerShare, types.DecCoin{})
+			if err := m.RewardsPerShare[len(m.RewardsPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2668,7 +4681,7 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeePool) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareHolderRewardInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2691,15 +4704,15 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareHolderRewardInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareHolderRewardInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2726,8 +4739,42 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardsPerShare = append(m.RewardsPerShare, types.DecCoin{})
+			if err := m.RewardsPerShare[len(m.RewardsPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2752,7 +4799,7 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *AutoRestake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2775,15 +4822,15 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoRestake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2811,43 +4858,11 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2875,41 +4890,7 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2932,7 +4913,7 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+func (m *AutoRestakeCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2955,36 +4936,17 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoRestakeCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoRestakeCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
-			}
-			m.PreviousPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2994,31 +4956,31 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PassStartHeight", wireType)
 			}
-			m.Height = 0
+			m.PassStartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3028,7 +4990,7 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.PassStartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3054,7 +5016,7 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *CommissionSplitRecipient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3077,15 +5039,15 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionSplitRecipient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionSplitRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3113,13 +5075,13 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3129,23 +5091,23 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3170,7 +5132,7 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+func (m *CommissionSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3193,17 +5155,17 @@ func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenizeShareRecordReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.RecordId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3213,14 +5175,27 @@ func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3247,11 +5222,64 @@ func (m *TokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipients = append(m.Recipients, CommissionSplitRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoWithdrawInterval", wireType)
+			}
+			m.AutoWithdrawInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoWithdrawInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -18,5 +18,8 @@ var (
 	// 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	// 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	// 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
-	ErrNotTokenizeShareRecordOwner = errorsmod.Register(ModuleName, 44, "not tokenize share record owner")
+	ErrNotTokenizeShareRecordOwner        = errorsmod.Register(ModuleName, 44, "not tokenize share record owner")
+	ErrTokenizeShareRecordRewardsNotSplit = errorsmod.Register(ModuleName, 45, "tokenize share record rewards are not split between share token holders")
+	ErrTokenizeShareRecordRewardsSplit    = errorsmod.Register(ModuleName, 46, "tokenize share record rewards are split between share token holders")
+	ErrInvalidCommissionSplit             = errorsmod.Register(ModuleName, 47, "invalid commission split")
 )
@@ -8,10 +8,22 @@ const (
 	EventTypeWithdrawRewards             = "withdraw_rewards"
 	EventTypeWithdrawCommission          = "withdraw_commission"
 	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+	EventTypeClaimTokenizeShareReward    = "claim_tokenize_share_reward"
 	EventTypeProposerReward              = "proposer_reward"
+	EventTypeSetAutoRestake              = "set_auto_restake"
+	EventTypeAutoRestake                 = "auto_restake"
+	EventTypeCompoundTokenizeShareReward = "compound_tokenize_share_reward"
+	EventTypeSetCommissionSplit          = "set_commission_split"
+	EventTypeCommissionSplit             = "commission_split"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyRecordId        = "record_id"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyRecipient       = "recipient"
+
+	AttributeValueCommunityPool = "community_pool"
 
 	AttributeValueCategory = ModuleName
 )
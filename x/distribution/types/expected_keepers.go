@@ -25,6 +25,7 @@ type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
@@ -73,6 +74,11 @@ type StakingKeeper interface {
 
 	GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (tokenizeShareRecords []stakingtypes.TokenizeShareRecord)
 	GetTokenizeShareRecord(ctx sdk.Context, id uint64) (tokenizeShareRecord stakingtypes.TokenizeShareRecord, err error)
+	GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (tokenizeShareRecord stakingtypes.TokenizeShareRecord, err error)
+	GetTokenizeShareRecordByModuleAccount(ctx sdk.Context, moduleAccount sdk.AccAddress) (tokenizeShareRecord stakingtypes.TokenizeShareRecord, err error)
+
+	BondDenom(ctx sdk.Context) string
+	Restake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) (newShares sdk.Dec, err error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,34 +11,44 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	rewardsPerShare []TokenizeShareRecordRewardsPerShareRecord, holderInfos []TokenizeShareHolderRewardInfoRecord,
+	autoRestakes []AutoRestake, commissionSplits []CommissionSplit,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                          params,
-		FeePool:                         fp,
-		DelegatorWithdrawInfos:          dwis,
-		PreviousProposer:                pp.String(),
-		OutstandingRewards:              r,
-		ValidatorAccumulatedCommissions: acc,
-		ValidatorHistoricalRewards:      historical,
-		ValidatorCurrentRewards:         cur,
-		DelegatorStartingInfos:          dels,
-		ValidatorSlashEvents:            slashes,
+		Params:                             params,
+		FeePool:                            fp,
+		DelegatorWithdrawInfos:             dwis,
+		PreviousProposer:                   pp.String(),
+		OutstandingRewards:                 r,
+		ValidatorAccumulatedCommissions:    acc,
+		ValidatorHistoricalRewards:         historical,
+		ValidatorCurrentRewards:            cur,
+		DelegatorStartingInfos:             dels,
+		ValidatorSlashEvents:               slashes,
+		TokenizeShareRecordRewardsPerShare: rewardsPerShare,
+		TokenizeShareHolderRewardInfos:     holderInfos,
+		AutoRestakes:                       autoRestakes,
+		CommissionSplits:                   commissionSplits,
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		FeePool:                         InitialFeePool(),
-		Params:                          DefaultParams(),
-		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
-		PreviousProposer:                "",
-		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
-		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
-		ValidatorHistoricalRewards:      []ValidatorHistoricalRewardsRecord{},
-		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
-		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
-		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FeePool:                            InitialFeePool(),
+		Params:                             DefaultParams(),
+		DelegatorWithdrawInfos:             []DelegatorWithdrawInfo{},
+		PreviousProposer:                   "",
+		OutstandingRewards:                 []ValidatorOutstandingRewardsRecord{},
+		ValidatorAccumulatedCommissions:    []ValidatorAccumulatedCommissionRecord{},
+		ValidatorHistoricalRewards:         []ValidatorHistoricalRewardsRecord{},
+		ValidatorCurrentRewards:            []ValidatorCurrentRewardsRecord{},
+		DelegatorStartingInfos:             []DelegatorStartingInfoRecord{},
+		ValidatorSlashEvents:               []ValidatorSlashEventRecord{},
+		TokenizeShareRecordRewardsPerShare: []TokenizeShareRecordRewardsPerShareRecord{},
+		TokenizeShareHolderRewardInfos:     []TokenizeShareHolderRewardInfoRecord{},
+		AutoRestakes:                       []AutoRestake{},
+		CommissionSplits:                   []CommissionSplit{},
 	}
 }
 
@@ -45,5 +57,49 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := validateAutoRestakes(gs.AutoRestakes); err != nil {
+		return err
+	}
+	if err := validateCommissionSplits(gs.CommissionSplits); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
+
+func validateAutoRestakes(autoRestakes []AutoRestake) error {
+	seen := make(map[string]bool, len(autoRestakes))
+	for _, autoRestake := range autoRestakes {
+		if _, err := sdk.AccAddressFromBech32(autoRestake.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid auto-restake delegator address %s: %w", autoRestake.DelegatorAddress, err)
+		}
+		if _, err := sdk.ValAddressFromBech32(autoRestake.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid auto-restake validator address %s: %w", autoRestake.ValidatorAddress, err)
+		}
+
+		key := autoRestake.DelegatorAddress + "/" + autoRestake.ValidatorAddress
+		if seen[key] {
+			return fmt.Errorf("duplicate auto-restake for delegator %s and validator %s",
+				autoRestake.DelegatorAddress, autoRestake.ValidatorAddress)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func validateCommissionSplits(commissionSplits []CommissionSplit) error {
+	seen := make(map[string]bool, len(commissionSplits))
+	for _, split := range commissionSplits {
+		if err := split.Validate(); err != nil {
+			return fmt.Errorf("invalid commission split of validator %s: %w", split.ValidatorAddress, err)
+		}
+		if split.IsEmpty() {
+			return fmt.Errorf("empty commission split of validator %s", split.ValidatorAddress)
+		}
+
+		if seen[split.ValidatorAddress] {
+			return fmt.Errorf("duplicate commission split for validator %s", split.ValidatorAddress)
+		}
+		seen[split.ValidatorAddress] = true
+	}
+	return nil
+}
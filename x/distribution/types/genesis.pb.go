@@ -325,6 +325,92 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// TokenizeShareRecordRewardsPerShareRecord is used for import / export via genesis json.
+type TokenizeShareRecordRewardsPerShareRecord struct {
+	// record_id is the id of the tokenize share record.
+	RecordId uint64 `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// rewards_per_share is the cumulative amount of rewards per share token of the record.
+	RewardsPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=rewards_per_share,json=rewardsPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_share"`
+}
+
+func (m *TokenizeShareRecordRewardsPerShareRecord) Reset() {
+	*m = TokenizeShareRecordRewardsPerShareRecord{}
+}
+func (m *TokenizeShareRecordRewardsPerShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecordRewardsPerShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecordRewardsPerShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02ffc8100ab19bc0, []int{7}
+}
+func (m *TokenizeShareRecordRewardsPerShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecordRewardsPerShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecordRewardsPerShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecordRewardsPerShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecordRewardsPerShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecordRewardsPerShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecordRewardsPerShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecordRewardsPerShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecordRewardsPerShareRecord proto.InternalMessageInfo
+
+// TokenizeShareHolderRewardInfoRecord is used for import / export via genesis json.
+type TokenizeShareHolderRewardInfoRecord struct {
+	// record_id is the id of the tokenize share record.
+	RecordId uint64 `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// holder_address is the address of the share token holder.
+	HolderAddress string `protobuf:"bytes,2,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	// info is the checkpoint of the last reward claim of the holder.
+	Info TokenizeShareHolderRewardInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info"`
+}
+
+func (m *TokenizeShareHolderRewardInfoRecord) Reset()         { *m = TokenizeShareHolderRewardInfoRecord{} }
+func (m *TokenizeShareHolderRewardInfoRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareHolderRewardInfoRecord) ProtoMessage()    {}
+func (*TokenizeShareHolderRewardInfoRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02ffc8100ab19bc0, []int{8}
+}
+func (m *TokenizeShareHolderRewardInfoRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareHolderRewardInfoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareHolderRewardInfoRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareHolderRewardInfoRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareHolderRewardInfoRecord.Merge(m, src)
+}
+func (m *TokenizeShareHolderRewardInfoRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareHolderRewardInfoRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareHolderRewardInfoRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareHolderRewardInfoRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
@@ -347,13 +433,23 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// tokenize_share_record_rewards_per_share defines the cumulative rewards per share token
+	// of the tokenize share records whose rewards are split at genesis.
+	TokenizeShareRecordRewardsPerShare []TokenizeShareRecordRewardsPerShareRecord `protobuf:"bytes,11,rep,name=tokenize_share_record_rewards_per_share,json=tokenizeShareRecordRewardsPerShare,proto3" json:"tokenize_share_record_rewards_per_share"`
+	// tokenize_share_holder_reward_infos defines the reward claim checkpoints of the share
+	// token holders at genesis.
+	TokenizeShareHolderRewardInfos []TokenizeShareHolderRewardInfoRecord `protobuf:"bytes,12,rep,name=tokenize_share_holder_reward_infos,json=tokenizeShareHolderRewardInfos,proto3" json:"tokenize_share_holder_reward_infos"`
+	// auto_restakes defines the delegations with auto-restake enabled at genesis.
+	AutoRestakes []AutoRestake `protobuf:"bytes,13,rep,name=auto_restakes,json=autoRestakes,proto3" json:"auto_restakes"`
+	// commission_splits defines the commission splits of the validators at genesis.
+	CommissionSplits []CommissionSplit `protobuf:"bytes,14,rep,name=commission_splits,json=commissionSplits,proto3" json:"commission_splits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02ffc8100ab19bc0, []int{7}
+	return fileDescriptor_02ffc8100ab19bc0, []int{9}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,6 +486,8 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "liquidstaking.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "liquidstaking.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*TokenizeShareRecordRewardsPerShareRecord)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordRewardsPerShareRecord")
+	proto.RegisterType((*TokenizeShareHolderRewardInfoRecord)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareHolderRewardInfoRecord")
 	proto.RegisterType((*GenesisState)(nil), "liquidstaking.distribution.v1beta1.GenesisState")
 }
 
@@ -398,66 +496,80 @@ func init() {
 }
 
 var fileDescriptor_02ffc8100ab19bc0 = []byte{
-	// 930 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x8b, 0x23, 0x45,
-	0x14, 0x4e, 0x67, 0xc6, 0xec, 0x4c, 0x65, 0xc5, 0xb5, 0x77, 0x76, 0xec, 0x19, 0xd7, 0xce, 0x6c,
-	0x10, 0x5c, 0x5c, 0xd2, 0x61, 0x67, 0x0f, 0xa2, 0xa2, 0x32, 0x49, 0x46, 0x47, 0x10, 0x1c, 0x12,
-	0x50, 0x50, 0x30, 0x54, 0xba, 0x2b, 0x9d, 0x72, 0x3b, 0x5d, 0x99, 0xaa, 0xea, 0x1e, 0x03, 0x82,
-	0xa0, 0x17, 0x0f, 0x82, 0x9e, 0x15, 0x64, 0x8f, 0x22, 0x78, 0xf3, 0x8f, 0xd8, 0x8b, 0xb0, 0x78,
-	0xf2, 0xa4, 0x92, 0x5c, 0x04, 0xff, 0x02, 0x6f, 0xd2, 0x55, 0xd5, 0xbf, 0x98, 0x9e, 0xd9, 0x64,
-	0x67, 0xe6, 0x94, 0x54, 0xd7, 0xfb, 0xf1, 0x7d, 0xef, 0x7d, 0xbc, 0x57, 0xa0, 0xee, 0x60, 0xc6,
-	0x29, 0x1e, 0x04, 0x1c, 0x13, 0xbf, 0x19, 0xde, 0x1d, 0x20, 0x0e, 0xef, 0x36, 0x5d, 0xe4, 0x23,
-	0x86, 0x99, 0x35, 0xa1, 0x84, 0x13, 0xbd, 0xee, 0xe1, 0xa3, 0x00, 0x3b, 0x8c, 0xc3, 0xfb, 0xd8,
-	0x77, 0xad, 0xac, 0x87, 0xa5, 0x3c, 0xb6, 0x37, 0x5c, 0xe2, 0x12, 0x61, 0xde, 0x8c, 0xfe, 0x49,
-	0xcf, 0x6d, 0xd3, 0x26, 0x6c, 0x4c, 0x58, 0x73, 0x00, 0x19, 0x4a, 0x82, 0xdb, 0x04, 0xfb, 0xea,
-	0xfe, 0xa5, 0xc2, 0xec, 0xb9, 0x04, 0xd2, 0x70, 0x4b, 0x06, 0xea, 0xcb, 0x0c, 0xf2, 0x20, 0xaf,
-	0xea, 0xbf, 0x68, 0xe0, 0x46, 0x07, 0x79, 0xc8, 0x85, 0x9c, 0xd0, 0x0f, 0x31, 0x1f, 0x39, 0x14,
-	0x1e, 0xbf, 0xeb, 0x0f, 0x89, 0xbe, 0x0f, 0x9e, 0x75, 0xe2, 0x8b, 0x3e, 0x74, 0x1c, 0x8a, 0x18,
-	0x33, 0xb4, 0x1d, 0xed, 0xf6, 0x7a, 0xcb, 0xf8, 0xfd, 0xd7, 0xc6, 0x86, 0x0a, 0xb3, 0x27, 0x6f,
-	0x7a, 0x9c, 0x62, 0xdf, 0xed, 0x5e, 0x4b, 0x5c, 0xd4, 0x77, 0xbd, 0x0d, 0xae, 0x1d, 0xab, 0xb0,
-	0x49, 0x94, 0xf2, 0x63, 0xa2, 0x3c, 0x13, 0x7b, 0xa8, 0xcf, 0xaf, 0xad, 0x7d, 0xfd, 0xa0, 0x56,
-	0xfa, 0xe7, 0x41, 0xad, 0x54, 0xff, 0x4f, 0x03, 0xb7, 0x3e, 0x80, 0x1e, 0x76, 0xa2, 0x1c, 0xef,
-	0x07, 0x9c, 0x71, 0xe8, 0x3b, 0x91, 0x0f, 0x3a, 0x86, 0xd4, 0x61, 0x5d, 0x64, 0x13, 0xea, 0x44,
-	0xd8, 0xc3, 0xd8, 0x68, 0x71, 0xec, 0x89, 0x4b, 0x8c, 0xfd, 0x4b, 0x0d, 0x5c, 0x27, 0x69, 0x8e,
-	0x3e, 0x95, 0x49, 0x8c, 0xf2, 0xce, 0xca, 0xed, 0xea, 0xee, 0x4d, 0x4b, 0x85, 0x89, 0xfa, 0x13,
-	0xb7, 0xd2, 0xea, 0x20, 0xbb, 0x4d, 0xb0, 0xdf, 0xba, 0xf7, 0xf0, 0xcf, 0x5a, 0xe9, 0xe7, 0xbf,
-	0x6a, 0x77, 0x5c, 0xcc, 0x47, 0xc1, 0xc0, 0xb2, 0xc9, 0x58, 0x55, 0x5e, 0xfd, 0x34, 0x98, 0x73,
-	0xbf, 0xc9, 0xa7, 0x13, 0xc4, 0x62, 0x1f, 0xd6, 0xd5, 0xc9, 0x09, 0x46, 0x19, 0xee, 0x73, 0x0d,
-	0xbc, 0x98, 0x70, 0xdf, 0xb3, 0xed, 0x60, 0x1c, 0x78, 0x90, 0x23, 0xa7, 0x4d, 0xc6, 0x63, 0xcc,
-	0x18, 0x26, 0xfe, 0xc5, 0xd2, 0xff, 0x14, 0x54, 0x61, 0x9a, 0x45, 0x74, 0xad, 0xba, 0xdb, 0xb2,
-	0x1e, 0xaf, 0x67, 0xeb, 0x6c, 0x94, 0xad, 0xd5, 0xa8, 0x36, 0xdd, 0x6c, 0xf0, 0x0c, 0xcb, 0x7f,
-	0x35, 0xb0, 0x93, 0xf8, 0x1f, 0x60, 0xc6, 0x09, 0xc5, 0x36, 0xf4, 0x2e, 0xa5, 0xc1, 0x9b, 0xa0,
-	0x32, 0x41, 0x14, 0x13, 0x49, 0x6e, 0xb5, 0xab, 0x4e, 0xfa, 0x27, 0xe0, 0x4a, 0xdc, 0xeb, 0x15,
-	0xc1, 0xfa, 0xcd, 0xa5, 0x58, 0x9f, 0x40, 0xad, 0x18, 0xc7, 0x41, 0x33, 0x6c, 0x7f, 0xd3, 0xc0,
-	0x0b, 0x89, 0x5f, 0x3b, 0xa0, 0x14, 0xf9, 0xfc, 0x52, 0xa8, 0x7e, 0x9c, 0x52, 0x92, 0x8d, 0x7c,
-	0x7d, 0x29, 0x4a, 0x79, 0x68, 0xa7, 0xf3, 0xf9, 0xa1, 0x0c, 0x9e, 0x4f, 0xe6, 0x49, 0x8f, 0x43,
-	0xca, 0xb1, 0xef, 0x46, 0xf3, 0x24, 0x65, 0x73, 0x11, 0x53, 0xa5, 0xb0, 0x28, 0xe5, 0xa5, 0x8b,
-	0xe2, 0x80, 0xa7, 0x99, 0xc2, 0xd8, 0xc7, 0xfe, 0x90, 0xa8, 0x6e, 0xbf, 0xba, 0x48, 0x69, 0x0a,
-	0x59, 0xaa, 0xc2, 0x5c, 0x65, 0x99, 0x6f, 0x99, 0xea, 0x7c, 0x5b, 0x06, 0x5b, 0x49, 0x49, 0x7b,
-	0x1e, 0x64, 0xa3, 0xfd, 0x50, 0x54, 0xf5, 0x82, 0x45, 0x3d, 0x42, 0xd8, 0x1d, 0xf1, 0x58, 0xd4,
-	0xf2, 0x94, 0x11, 0xfb, 0x4a, 0x4e, 0xec, 0x47, 0xe0, 0x46, 0x9a, 0x96, 0x45, 0xa0, 0xfa, 0x28,
-	0x42, 0x65, 0xac, 0x8a, 0x62, 0xbc, 0xb2, 0x94, 0x4e, 0x52, 0x52, 0xaa, 0x14, 0xd7, 0xc3, 0x93,
-	0x57, 0x99, 0x8a, 0xfc, 0xb8, 0x0e, 0xae, 0xbe, 0x23, 0xf7, 0x65, 0x8f, 0x43, 0x8e, 0xf4, 0x03,
-	0x50, 0x99, 0x40, 0x0a, 0xc7, 0x92, 0x79, 0x75, 0xf7, 0xe5, 0x45, 0xd2, 0x1f, 0x0a, 0x0f, 0x95,
-	0x51, 0xf9, 0xeb, 0xef, 0x81, 0xb5, 0x21, 0x42, 0xfd, 0x09, 0x21, 0x9e, 0x92, 0xfc, 0x9d, 0x45,
-	0x62, 0xbd, 0x8d, 0xd0, 0x21, 0x21, 0x5e, 0x2c, 0xf1, 0xa1, 0x3c, 0xea, 0x53, 0x60, 0xa4, 0xc2,
-	0x4d, 0x36, 0x5a, 0x24, 0x9a, 0x68, 0x46, 0xac, 0x2c, 0xad, 0x9a, 0xec, 0xae, 0x55, 0xb9, 0x36,
-	0x9d, 0xa2, 0x4b, 0x21, 0xf6, 0x09, 0x45, 0x21, 0x26, 0x81, 0x58, 0xe1, 0x13, 0xc2, 0x10, 0x15,
-	0xcd, 0x39, 0x53, 0x17, 0xb1, 0xcb, 0xa1, 0xf2, 0xd0, 0x3f, 0x2f, 0x5e, 0x66, 0x4f, 0x09, 0xf0,
-	0xfb, 0x4b, 0x75, 0xf9, 0xb4, 0xc5, 0xab, 0x88, 0x14, 0xac, 0x31, 0xfd, 0x7b, 0x0d, 0xdc, 0xca,
-	0xa8, 0x3b, 0x1d, 0xfd, 0x7d, 0x3b, 0x59, 0x0c, 0xcc, 0xa8, 0x08, 0x30, 0x07, 0xe7, 0xdf, 0x31,
-	0x39, 0x3c, 0xb5, 0xf0, 0x4c, 0x5b, 0xa6, 0x7f, 0xa3, 0x81, 0x9b, 0x29, 0xb8, 0x51, 0x32, 0xbe,
-	0x93, 0x22, 0x5d, 0x11, 0xb8, 0x3a, 0xe7, 0xdb, 0x02, 0x39, 0x4c, 0xdb, 0xe1, 0xa9, 0x76, 0xfa,
-	0x57, 0x1a, 0xd8, 0x4a, 0xe1, 0xd8, 0x72, 0xf4, 0x26, 0x58, 0xd6, 0x04, 0x96, 0xbd, 0x73, 0x8c,
-	0xef, 0x1c, 0x90, 0xe7, 0xc2, 0x62, 0x23, 0xfd, 0x8b, 0xac, 0xe2, 0x73, 0x63, 0x92, 0x19, 0xeb,
-	0x02, 0xc3, 0x5b, 0x4f, 0x3c, 0x27, 0x73, 0x08, 0x52, 0xdd, 0x67, 0x4d, 0x98, 0x3e, 0x05, 0x9b,
-	0x85, 0x83, 0x89, 0x19, 0x40, 0xa4, 0x7f, 0xe3, 0x09, 0x27, 0x53, 0x2e, 0xf9, 0x46, 0xc1, 0x7c,
-	0xca, 0x2c, 0xb4, 0xd6, 0xe0, 0xa7, 0x99, 0xa9, 0x3d, 0x9c, 0x99, 0xda, 0xa3, 0x99, 0xa9, 0xfd,
-	0x3d, 0x33, 0xb5, 0xef, 0xe6, 0x66, 0xe9, 0xd1, 0xdc, 0x2c, 0xfd, 0x31, 0x37, 0x4b, 0x1f, 0x75,
-	0x32, 0xaf, 0x3b, 0x7c, 0xe4, 0x05, 0x91, 0x9c, 0xb0, 0x6f, 0x37, 0x25, 0x30, 0xcc, 0xa7, 0x0d,
-	0x05, 0xae, 0x31, 0x26, 0x4e, 0xe0, 0xa1, 0xe6, 0x67, 0xb9, 0xf7, 0xb9, 0x7c, 0xff, 0x0d, 0x2a,
-	0xe2, 0x2d, 0x7e, 0xef, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x65, 0x4b, 0x32, 0x4f, 0x0c,
-	0x00, 0x00,
+	// 1153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x26, 0x26, 0x4d, 0x27, 0x49, 0x9b, 0x4c, 0xd3, 0xb0, 0x49, 0x8b, 0x9d, 0x1a, 0xa4,
+	0x46, 0x54, 0xb1, 0xd5, 0xe4, 0x80, 0x00, 0x41, 0x15, 0x27, 0xa1, 0xa9, 0x54, 0x89, 0xc8, 0x46,
+	0x20, 0x15, 0x89, 0xd5, 0x78, 0x77, 0x6c, 0x0f, 0x59, 0xef, 0x38, 0x33, 0xb3, 0x0e, 0x41, 0x20,
+	0x24, 0xb8, 0x70, 0x40, 0x82, 0x2b, 0x70, 0xe9, 0x8d, 0x0a, 0x89, 0x1b, 0x1f, 0xa2, 0x17, 0xa4,
+	0x88, 0x13, 0x27, 0x40, 0xc9, 0x05, 0xc1, 0x27, 0xe0, 0x86, 0x76, 0x66, 0xf6, 0x1f, 0xd9, 0x38,
+	0x76, 0x93, 0x9c, 0x92, 0x9d, 0x79, 0x7f, 0x7e, 0xbf, 0xf7, 0xde, 0xbc, 0xf7, 0x0c, 0x4a, 0x0e,
+	0xe1, 0x82, 0x91, 0x86, 0x2f, 0x08, 0xf5, 0x2a, 0xbd, 0xbb, 0x0d, 0x2c, 0xd0, 0xdd, 0x4a, 0x0b,
+	0x7b, 0x98, 0x13, 0x5e, 0xee, 0x32, 0x2a, 0x28, 0x2c, 0xb9, 0x64, 0xd7, 0x27, 0x0e, 0x17, 0x68,
+	0x87, 0x78, 0xad, 0x72, 0x52, 0xa3, 0xac, 0x35, 0x16, 0x66, 0x5b, 0xb4, 0x45, 0xa5, 0x78, 0x25,
+	0xf8, 0x4f, 0x69, 0x2e, 0x14, 0x6c, 0xca, 0x3b, 0x94, 0x57, 0x1a, 0x88, 0xe3, 0xc8, 0xb8, 0x4d,
+	0x89, 0xa7, 0xef, 0x6f, 0x67, 0x7a, 0x4f, 0x39, 0x50, 0x82, 0xf3, 0xca, 0x90, 0xa5, 0x3c, 0xa8,
+	0x0f, 0x75, 0x55, 0xfa, 0xc9, 0x00, 0xd7, 0x37, 0xb0, 0x8b, 0x5b, 0x48, 0x50, 0xf6, 0x1e, 0x11,
+	0x6d, 0x87, 0xa1, 0xbd, 0x07, 0x5e, 0x93, 0xc2, 0x4d, 0x30, 0xe3, 0x84, 0x17, 0x16, 0x72, 0x1c,
+	0x86, 0x39, 0x37, 0x8d, 0x45, 0x63, 0xe9, 0x72, 0xd5, 0xfc, 0xf5, 0xe7, 0xe5, 0x59, 0x6d, 0x66,
+	0x4d, 0xdd, 0xd4, 0x05, 0x23, 0x5e, 0xab, 0x36, 0x1d, 0xa9, 0xe8, 0x73, 0xb8, 0x0e, 0xa6, 0xf7,
+	0xb4, 0xd9, 0xc8, 0xca, 0xc8, 0x29, 0x56, 0xae, 0x86, 0x1a, 0xfa, 0xf8, 0xb5, 0xf1, 0x2f, 0x1f,
+	0x17, 0x73, 0x7f, 0x3d, 0x2e, 0xe6, 0x4a, 0xff, 0x1a, 0xe0, 0xd6, 0xbb, 0xc8, 0x25, 0x4e, 0xe0,
+	0xe3, 0x6d, 0x5f, 0x70, 0x81, 0x3c, 0x27, 0xd0, 0xc1, 0x7b, 0x88, 0x39, 0xbc, 0x86, 0x6d, 0xca,
+	0x9c, 0x00, 0x7b, 0x2f, 0x14, 0x1a, 0x1c, 0x7b, 0xa4, 0x12, 0x62, 0xff, 0xdc, 0x00, 0xd7, 0x68,
+	0xec, 0xc3, 0x62, 0xca, 0x89, 0x39, 0xb2, 0x38, 0xba, 0x34, 0xb1, 0x72, 0xb3, 0xac, 0xcd, 0x04,
+	0xf9, 0x09, 0x53, 0x59, 0xde, 0xc0, 0xf6, 0x3a, 0x25, 0x5e, 0x75, 0xf5, 0xe9, 0xef, 0xc5, 0xdc,
+	0x8f, 0x7f, 0x14, 0xef, 0xb4, 0x88, 0x68, 0xfb, 0x8d, 0xb2, 0x4d, 0x3b, 0x3a, 0xf2, 0xfa, 0xcf,
+	0x32, 0x77, 0x76, 0x2a, 0x62, 0xbf, 0x8b, 0x79, 0xa8, 0xc3, 0x6b, 0x90, 0x1e, 0x63, 0x94, 0xe0,
+	0x7e, 0x64, 0x80, 0x97, 0x22, 0xee, 0x6b, 0xb6, 0xed, 0x77, 0x7c, 0x17, 0x09, 0xec, 0xac, 0xd3,
+	0x4e, 0x87, 0x70, 0x4e, 0xa8, 0x77, 0xbe, 0xf4, 0x3f, 0x04, 0x13, 0x28, 0xf6, 0x22, 0xb3, 0x36,
+	0xb1, 0x52, 0x2d, 0x9f, 0x5e, 0xcf, 0xe5, 0xfe, 0x28, 0xab, 0xf9, 0x20, 0x36, 0xb5, 0xa4, 0xf1,
+	0x04, 0xcb, 0x7f, 0x0c, 0xb0, 0x18, 0xe9, 0x6f, 0x11, 0x2e, 0x28, 0x23, 0x36, 0x72, 0x2f, 0x24,
+	0xc1, 0x73, 0x60, 0xac, 0x8b, 0x19, 0xa1, 0x8a, 0x5c, 0xbe, 0xa6, 0xbf, 0xe0, 0x07, 0xe0, 0x52,
+	0x98, 0xeb, 0x51, 0xc9, 0xfa, 0xcd, 0xa1, 0x58, 0x1f, 0x43, 0xad, 0x19, 0x87, 0x46, 0x13, 0x6c,
+	0x7f, 0x31, 0xc0, 0x0b, 0x91, 0xde, 0xba, 0xcf, 0x18, 0xf6, 0xc4, 0x85, 0x50, 0x7d, 0x3f, 0xa6,
+	0xa4, 0x12, 0xf9, 0xfa, 0x50, 0x94, 0xd2, 0xd0, 0x4e, 0xe6, 0xf3, 0xfd, 0x08, 0xb8, 0x11, 0xf5,
+	0x93, 0xba, 0x40, 0x4c, 0x10, 0xaf, 0x15, 0xf4, 0x93, 0x98, 0xcd, 0x79, 0x74, 0x95, 0xcc, 0xa0,
+	0x8c, 0x0c, 0x1d, 0x14, 0x07, 0x4c, 0x71, 0x8d, 0xd1, 0x22, 0x5e, 0x93, 0xea, 0x6c, 0xbf, 0x3a,
+	0x48, 0x68, 0x32, 0x59, 0xea, 0xc0, 0x4c, 0xf2, 0xc4, 0x59, 0x22, 0x3a, 0x5f, 0x8f, 0x80, 0xf9,
+	0x28, 0xa4, 0x75, 0x17, 0xf1, 0xf6, 0x66, 0x4f, 0x46, 0xf5, 0x9c, 0x8b, 0xba, 0x8d, 0x49, 0xab,
+	0x2d, 0xc2, 0xa2, 0x56, 0x5f, 0x89, 0x62, 0x1f, 0x4d, 0x15, 0xfb, 0x2e, 0xb8, 0x1e, 0xbb, 0xe5,
+	0x01, 0x28, 0x0b, 0x07, 0xa8, 0xcc, 0xbc, 0x0c, 0xc6, 0x2b, 0x43, 0xd5, 0x49, 0x4c, 0x4a, 0x87,
+	0xe2, 0x5a, 0xef, 0xf8, 0x55, 0x22, 0x22, 0x07, 0x06, 0x58, 0x7a, 0x87, 0xee, 0x60, 0x8f, 0x7c,
+	0x8c, 0xeb, 0x6d, 0xc4, 0xb0, 0x8a, 0x85, 0xae, 0xb3, 0x6d, 0xcc, 0x12, 0x87, 0xf0, 0x06, 0xb8,
+	0xcc, 0xe4, 0x7f, 0x16, 0x71, 0x64, 0x60, 0xf2, 0xb5, 0x71, 0x75, 0xf0, 0xc0, 0x81, 0x9f, 0x82,
+	0x19, 0x5d, 0x8e, 0x56, 0x17, 0x33, 0x8b, 0x07, 0x7a, 0x17, 0xd7, 0xa9, 0xaf, 0xb2, 0x34, 0xc2,
+	0x04, 0xa5, 0xbf, 0x0d, 0xf0, 0x62, 0x8a, 0xd2, 0x16, 0x75, 0x1d, 0xcc, 0x14, 0xa5, 0xc4, 0x53,
+	0xe8, 0xcb, 0xe6, 0x1e, 0xb8, 0xd2, 0x96, 0x6a, 0x03, 0x57, 0xf7, 0x94, 0x92, 0x8f, 0xdf, 0x7b,
+	0x3e, 0x51, 0xd1, 0x6b, 0x83, 0x24, 0xb1, 0x2f, 0x68, 0x9d, 0x4e, 0x69, 0x34, 0x41, 0xf6, 0xc9,
+	0x14, 0x98, 0xbc, 0xaf, 0xf6, 0x9d, 0xba, 0x40, 0x02, 0xc3, 0x2d, 0x30, 0xd6, 0x45, 0x0c, 0x75,
+	0x54, 0xe5, 0x4e, 0xac, 0xbc, 0x3c, 0x88, 0xe7, 0x6d, 0xa9, 0xa1, 0x5d, 0x68, 0x7d, 0xf8, 0x10,
+	0x8c, 0x37, 0x31, 0xb6, 0xba, 0x94, 0xba, 0xba, 0x65, 0xdd, 0x19, 0xc4, 0xd6, 0x5b, 0x18, 0x6f,
+	0x53, 0xea, 0x86, 0x2d, 0xaa, 0xa9, 0x3e, 0xe1, 0x3e, 0x30, 0xe3, 0xc6, 0x13, 0x6d, 0x24, 0x01,
+	0x9b, 0xa0, 0xc7, 0x8f, 0x0e, 0xfd, 0xea, 0x93, 0xbb, 0x92, 0xf6, 0x35, 0xe7, 0x64, 0x5d, 0xca,
+	0x66, 0xd5, 0x65, 0xb8, 0x47, 0xa8, 0x2f, 0x57, 0xb0, 0x2e, 0xe5, 0x98, 0x99, 0xf9, 0x53, 0xd2,
+	0x39, 0x1d, 0xaa, 0x6c, 0x6b, 0x0d, 0xf8, 0x49, 0xf6, 0x32, 0xf2, 0x9c, 0x04, 0xbf, 0x39, 0xd4,
+	0x2b, 0x3d, 0x69, 0x71, 0xd2, 0x44, 0x32, 0xd6, 0x10, 0xf8, 0x9d, 0x01, 0x6e, 0x25, 0xba, 0x53,
+	0x3c, 0xba, 0x2d, 0x3b, 0x1a, 0xec, 0xdc, 0x1c, 0x93, 0x60, 0xb6, 0xce, 0xbe, 0x23, 0xa4, 0xf0,
+	0x14, 0x7b, 0x7d, 0x65, 0x39, 0xfc, 0xca, 0x00, 0x37, 0x63, 0x70, 0xed, 0x68, 0xfc, 0x46, 0x41,
+	0xba, 0x24, 0x71, 0x6d, 0x9c, 0x6d, 0x8a, 0xa7, 0x30, 0x2d, 0xf4, 0x4e, 0x94, 0x83, 0x5f, 0x18,
+	0x60, 0x3e, 0x86, 0x63, 0xab, 0xd1, 0x19, 0x61, 0x19, 0x5f, 0x1c, 0x1d, 0xf4, 0x45, 0xf6, 0xdd,
+	0x0c, 0x34, 0x90, 0xe7, 0x7b, 0xd9, 0x42, 0xf0, 0xb3, 0x64, 0xc5, 0xa7, 0xc6, 0x1c, 0x37, 0x2f,
+	0x4b, 0x0c, 0xf7, 0x9e, 0x79, 0xce, 0xa5, 0x10, 0xcc, 0x39, 0x59, 0x22, 0x1c, 0xee, 0x83, 0xb9,
+	0xcc, 0xc1, 0xc2, 0x4d, 0x20, 0xdd, 0xbf, 0xf1, 0x8c, 0x93, 0x25, 0xe5, 0x7c, 0x36, 0x63, 0xbe,
+	0x70, 0xf8, 0x83, 0x01, 0x6e, 0x0b, 0xdd, 0xce, 0xd4, 0x28, 0xb0, 0x74, 0xaf, 0x3d, 0x3e, 0x23,
+	0x26, 0x24, 0x98, 0x87, 0x43, 0x77, 0xc8, 0x3e, 0x93, 0x4a, 0x63, 0x2b, 0x89, 0x53, 0xe5, 0xe1,
+	0xb7, 0x06, 0x28, 0xfd, 0x0f, 0xa9, 0x6e, 0xfc, 0x0a, 0xa9, 0x4e, 0xd8, 0xa4, 0x04, 0x79, 0xff,
+	0xcc, 0x6d, 0x3c, 0x85, 0xaf, 0x20, 0xfa, 0x89, 0x72, 0xf8, 0x08, 0x4c, 0x21, 0x5f, 0x50, 0x8b,
+	0xe1, 0xc0, 0x21, 0xe6, 0xe6, 0x94, 0x44, 0x51, 0x19, 0x04, 0xc5, 0x9a, 0x2f, 0x68, 0x4d, 0xe9,
+	0x85, 0x4b, 0x11, 0x8a, 0x8f, 0x38, 0x6c, 0x82, 0x99, 0xb8, 0x71, 0x58, 0xbc, 0xeb, 0x12, 0xc1,
+	0xcd, 0x2b, 0xd2, 0xfe, 0xea, 0x20, 0xf6, 0xe3, 0xe7, 0x5f, 0x0f, 0x74, 0xb5, 0x8f, 0x69, 0x3b,
+	0x7d, 0x9c, 0x58, 0x4d, 0xab, 0x8d, 0x27, 0x87, 0x05, 0xe3, 0xe9, 0x61, 0xc1, 0x38, 0x38, 0x2c,
+	0x18, 0x7f, 0x1e, 0x16, 0x8c, 0x6f, 0x8e, 0x0a, 0xb9, 0x83, 0xa3, 0x42, 0xee, 0xb7, 0xa3, 0x42,
+	0xee, 0xd1, 0x46, 0x62, 0xfa, 0x93, 0x5d, 0xd7, 0x0f, 0x4c, 0x10, 0xcf, 0xae, 0x28, 0x28, 0x44,
+	0xec, 0x2f, 0x6b, 0x38, 0xcb, 0x1d, 0xea, 0xf8, 0x2e, 0xae, 0x7c, 0x94, 0xfa, 0xa5, 0xad, 0xf6,
+	0x83, 0xc6, 0x98, 0xfc, 0x55, 0xbd, 0xfa, 0xdf, 0x00, 0x47, 0x54, 0x48, 0x5a, 0x19, 0x10, 0x00,
+	0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -763,6 +875,93 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecordRewardsPerShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecordRewardsPerShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecordRewardsPerShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPerShare) > 0 {
+		for iNdEx := len(m.RewardsPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareHolderRewardInfoRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareHolderRewardInfoRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareHolderRewardInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.HolderAddress) > 0 {
+		i -= len(m.HolderAddress)
+		copy(dAtA[i:], m.HolderAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.HolderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -783,6 +982,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionSplits) > 0 {
+		for iNdEx := len(m.CommissionSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AutoRestakes) > 0 {
+		for iNdEx := len(m.AutoRestakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRestakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TokenizeShareHolderRewardInfos) > 0 {
+		for iNdEx := len(m.TokenizeShareHolderRewardInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareHolderRewardInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TokenizeShareRecordRewardsPerShare) > 0 {
+		for iNdEx := len(m.TokenizeShareRecordRewardsPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecordRewardsPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1046,6 +1301,42 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecordRewardsPerShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.RecordId))
+	}
+	if len(m.RewardsPerShare) > 0 {
+		for _, e := range m.RewardsPerShare {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareHolderRewardInfoRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.RecordId))
+	}
+	l = len(m.HolderAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1102,6 +1393,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TokenizeShareRecordRewardsPerShare) > 0 {
+		for _, e := range m.TokenizeShareRecordRewardsPerShare {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareHolderRewardInfos) > 0 {
+		for _, e := range m.TokenizeShareHolderRewardInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoRestakes) > 0 {
+		for _, e := range m.AutoRestakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommissionSplits) > 0 {
+		for _, e := range m.CommissionSplits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2005,7 +2320,7 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecordRewardsPerShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2028,17 +2343,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecordRewardsPerShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecordRewardsPerShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			var msglen int
+			m.RecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2048,28 +2363,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2096,46 +2397,297 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardsPerShare = append(m.RewardsPerShare, types.DecCoin{})
+			if err := m.RewardsPerShare[len(m.RewardsPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorWithdrawInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorWithdrawInfos = append(m.DelegatorWithdrawInfos, DelegatorWithdrawInfo{})
-			if err := m.DelegatorWithdrawInfos[len(m.DelegatorWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareHolderRewardInfoRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareHolderRewardInfoRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareHolderRewardInfoRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorWithdrawInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorWithdrawInfos = append(m.DelegatorWithdrawInfos, DelegatorWithdrawInfo{})
+			if err := m.DelegatorWithdrawInfos[len(m.DelegatorWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PreviousProposer", wireType)
 			}
 			var stringLen uint64
@@ -2370,6 +2922,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecordRewardsPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecordRewardsPerShare = append(m.TokenizeShareRecordRewardsPerShare, TokenizeShareRecordRewardsPerShareRecord{})
+			if err := m.TokenizeShareRecordRewardsPerShare[len(m.TokenizeShareRecordRewardsPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareHolderRewardInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareHolderRewardInfos = append(m.TokenizeShareHolderRewardInfos, TokenizeShareHolderRewardInfoRecord{})
+			if err := m.TokenizeShareHolderRewardInfos[len(m.TokenizeShareHolderRewardInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakes = append(m.AutoRestakes, AutoRestake{})
+			if err := m.AutoRestakes[len(m.AutoRestakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionSplits = append(m.CommissionSplits, CommissionSplit{})
+			if err := m.CommissionSplits[len(m.CommissionSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
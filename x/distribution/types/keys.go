@@ -42,6 +42,20 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<recordId_Bytes>: TokenizeShareRecordRewardsPerShare
+//
+// - 0x0A<recordId_Bytes><accAddrLen (1 Byte)><accAddr_Bytes>: TokenizeShareHolderRewardInfo
+//
+// - 0x0B: Params
+//
+// - 0x0C<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: AutoRestake
+//
+// - 0x0D: AutoRestakeCursor
+//
+// - 0x0E<valAddrLen (1 Byte)><valAddr_Bytes>: CommissionSplit
+//
+// - 0x0F<height_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{}
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +67,17 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	TokenizeShareRecordRewardsPerSharePrefix = []byte{0x09} // key for cumulative rewards per share token of a tokenize share record
+	TokenizeShareHolderRewardInfoPrefix      = []byte{0x0A} // key for reward claim checkpoint of a share token holder
+
+	ParamsKey = []byte{0x0B} // key for distribution module params
+
+	AutoRestakePrefix    = []byte{0x0C} // key for the delegations with auto-restake enabled
+	AutoRestakeCursorKey = []byte{0x0D} // key for the progress of the current auto-restake pass
+
+	CommissionSplitPrefix             = []byte{0x0E} // key for the commission split of a validator
+	CommissionAutoWithdrawQueuePrefix = []byte{0x0F} // key for the commission splits by next auto-withdraw height
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -213,3 +238,64 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetTokenizeShareHolderRewardInfoRecordIdHolder creates the record id and holder address from a share token holder's reward info key.
+func GetTokenizeShareHolderRewardInfoRecordIdHolder(key []byte) (recordId uint64, holder sdk.AccAddress) {
+	// key is in the format:
+	// 0x0A<recordId_Bytes><accAddrLen (1 Byte)><accAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 11)
+	recordId = binary.BigEndian.Uint64(key[1:9])
+	holder = sdk.AccAddress(key[10:])
+	kv.AssertKeyLength(holder, int(key[9]))
+	return
+}
+
+// GetTokenizeShareRecordRewardsPerShareKey creates the key for the cumulative rewards per share token of a tokenize share record.
+func GetTokenizeShareRecordRewardsPerShareKey(recordId uint64) []byte {
+	return append(TokenizeShareRecordRewardsPerSharePrefix, sdk.Uint64ToBigEndian(recordId)...)
+}
+
+// GetTokenizeShareHolderRewardInfoPrefix creates the prefix key for the reward claim checkpoints of a tokenize share record's holders.
+func GetTokenizeShareHolderRewardInfoPrefix(recordId uint64) []byte {
+	return append(TokenizeShareHolderRewardInfoPrefix, sdk.Uint64ToBigEndian(recordId)...)
+}
+
+// GetTokenizeShareHolderRewardInfoKey creates the key for the reward claim checkpoint of a share token holder.
+func GetTokenizeShareHolderRewardInfoKey(recordId uint64, holder sdk.AccAddress) []byte {
+	return append(GetTokenizeShareHolderRewardInfoPrefix(recordId), address.MustLengthPrefix(holder.Bytes())...)
+}
+
+// GetAutoRestakePrefix creates the prefix key for the auto-restake entries of a delegator.
+func GetAutoRestakePrefix(delAddr sdk.AccAddress) []byte {
+	return append(AutoRestakePrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoRestakeKey creates the key for the auto-restake entry of a delegation.
+func GetAutoRestakeKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetAutoRestakePrefix(delAddr), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetCommissionSplitKey creates the key for the commission split of a validator.
+func GetCommissionSplitKey(valAddr sdk.ValAddress) []byte {
+	return append(CommissionSplitPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetCommissionAutoWithdrawQueuePrefix creates the prefix key for the commission splits due for an auto-withdraw at a height.
+func GetCommissionAutoWithdrawQueuePrefix(height int64) []byte {
+	return append(CommissionAutoWithdrawQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetCommissionAutoWithdrawQueueKey creates the key for the next auto-withdraw of the commission of a validator.
+func GetCommissionAutoWithdrawQueueKey(height int64, valAddr sdk.ValAddress) []byte {
+	return append(GetCommissionAutoWithdrawQueuePrefix(height), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetCommissionAutoWithdrawQueueValAddr creates the validator address from a commission auto-withdraw queue key.
+func GetCommissionAutoWithdrawQueueValAddr(key []byte) sdk.ValAddress {
+	// key is in the format:
+	// 0x0F<height_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 11)
+	valAddr := sdk.ValAddress(key[10:])
+	kv.AssertKeyLength(valAddr, int(key[9]))
+	return valAddr
+}
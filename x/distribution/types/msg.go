@@ -16,6 +16,11 @@ const (
 	TypeMsgFundCommunityPool                    = "fund_community_pool"
 	TypeMsgWithdrawTokenizeShareRecordReward    = "withdraw_tokenize_share_record_reward"
 	TypeMsgWithdrawAllTokenizeShareRecordReward = "withdraw_all_tokenize_share_record_reward"
+	TypeMsgClaimTokenizeShareRecordReward       = "claim_tokenize_share_record_reward"
+	TypeMsgSetAutoRestake                       = "set_auto_restake"
+	TypeMsgSetTokenizeShareRecordAutoRestake    = "set_tokenize_share_record_auto_restake"
+	TypeMsgSetCommissionSplit                   = "set_commission_split"
+	TypeMsgUpdateParams                         = "update_params"
 )
 
 // Verify interface at compile time
@@ -23,6 +28,11 @@ var (
 	_, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
 	_       sdk.Msg = &MsgWithdrawTokenizeShareRecordReward{}
 	_       sdk.Msg = &MsgWithdrawAllTokenizeShareRecordReward{}
+	_       sdk.Msg = &MsgClaimTokenizeShareRecordReward{}
+	_       sdk.Msg = &MsgSetAutoRestake{}
+	_       sdk.Msg = &MsgSetTokenizeShareRecordAutoRestake{}
+	_       sdk.Msg = &MsgSetCommissionSplit{}
+	_       sdk.Msg = &MsgUpdateParams{}
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -129,6 +139,45 @@ func (msg MsgWithdrawValidatorCommission) ValidateBasic() error {
 
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
 // a funding amount.
+func NewMsgSetCommissionSplit(valAddr sdk.ValAddress, recipients []CommissionSplitRecipient, communityPoolShare sdk.Dec, autoWithdrawInterval int64) *MsgSetCommissionSplit {
+	return &MsgSetCommissionSplit{
+		ValidatorAddress:     valAddr.String(),
+		Recipients:           recipients,
+		CommunityPoolShare:   communityPoolShare,
+		AutoWithdrawInterval: autoWithdrawInterval,
+	}
+}
+
+func (msg MsgSetCommissionSplit) Route() string { return ModuleName }
+func (msg MsgSetCommissionSplit) Type() string  { return TypeMsgSetCommissionSplit }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetCommissionSplit) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetCommissionSplit) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetCommissionSplit) ValidateBasic() error {
+	return msg.CommissionSplit().Validate()
+}
+
+// CommissionSplit returns the commission split set by the message
+func (msg MsgSetCommissionSplit) CommissionSplit() CommissionSplit {
+	return CommissionSplit{
+		ValidatorAddress:     msg.ValidatorAddress,
+		Recipients:           msg.Recipients,
+		CommunityPoolShare:   msg.CommunityPoolShare,
+		AutoWithdrawInterval: msg.AutoWithdrawInterval,
+	}
+}
+
 func NewMsgFundCommunityPool(amount sdk.Coins, depositor sdk.AccAddress) *MsgFundCommunityPool {
 	return &MsgFundCommunityPool{
 		Amount:    amount,
@@ -238,3 +287,144 @@ func (msg MsgWithdrawAllTokenizeShareRecordReward) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgClaimTokenizeShareRecordReward(holderAddr sdk.AccAddress, recordId uint64) *MsgClaimTokenizeShareRecordReward {
+	return &MsgClaimTokenizeShareRecordReward{
+		HolderAddress: holderAddr.String(),
+		RecordId:      recordId,
+	}
+}
+
+func (msg MsgClaimTokenizeShareRecordReward) Route() string { return ModuleName }
+func (msg MsgClaimTokenizeShareRecordReward) Type() string {
+	return TypeMsgClaimTokenizeShareRecordReward
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgClaimTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.HolderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgClaimTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgClaimTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.HolderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", err)
+	}
+	return nil
+}
+
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoRestake {
+	return &MsgSetAutoRestake{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+func (msg MsgSetAutoRestake) Type() string  { return TypeMsgSetAutoRestake }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	return nil
+}
+
+func NewMsgSetTokenizeShareRecordAutoRestake(ownerAddr sdk.AccAddress, recordId uint64, enabled bool) *MsgSetTokenizeShareRecordAutoRestake {
+	return &MsgSetTokenizeShareRecordAutoRestake{
+		OwnerAddress: ownerAddr.String(),
+		RecordId:     recordId,
+		Enabled:      enabled,
+	}
+}
+
+func (msg MsgSetTokenizeShareRecordAutoRestake) Route() string { return ModuleName }
+func (msg MsgSetTokenizeShareRecordAutoRestake) Type() string {
+	return TypeMsgSetTokenizeShareRecordAutoRestake
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetTokenizeShareRecordAutoRestake) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetTokenizeShareRecordAutoRestake) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetTokenizeShareRecordAutoRestake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	return nil
+}
+
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.ValidateBasic()
+}
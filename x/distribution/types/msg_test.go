@@ -93,3 +93,43 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetCommissionSplit
+func TestMsgSetCommissionSplit(t *testing.T) {
+	recipient := func(addr sdk.AccAddress, weight string) CommissionSplitRecipient {
+		return CommissionSplitRecipient{Address: addr.String(), Weight: sdk.MustNewDecFromStr(weight)}
+	}
+	tooMany := make([]CommissionSplitRecipient, MaxCommissionSplitRecipients+1)
+	for i := range tooMany {
+		tooMany[i] = recipient(sdk.AccAddress([]byte{byte(i + 1)}), "0.01")
+	}
+
+	tests := []struct {
+		validatorAddr        sdk.ValAddress
+		recipients           []CommissionSplitRecipient
+		communityPoolShare   sdk.Dec
+		autoWithdrawInterval int64
+		expectPass           bool
+	}{
+		{valAddr1, []CommissionSplitRecipient{recipient(delAddr1, "0.5"), recipient(delAddr2, "0.4")}, sdk.MustNewDecFromStr("0.1"), 100, true},
+		{valAddr1, []CommissionSplitRecipient{}, sdk.ZeroDec(), 0, true},
+		{valAddr1, []CommissionSplitRecipient{}, sdk.OneDec(), 0, true},
+		{emptyValAddr, []CommissionSplitRecipient{recipient(delAddr1, "0.5")}, sdk.ZeroDec(), 0, false},
+		{valAddr1, []CommissionSplitRecipient{recipient(emptyDelAddr, "0.5")}, sdk.ZeroDec(), 0, false},
+		{valAddr1, []CommissionSplitRecipient{recipient(delAddr1, "0.5"), recipient(delAddr1, "0.2")}, sdk.ZeroDec(), 0, false},
+		{valAddr1, []CommissionSplitRecipient{recipient(delAddr1, "0")}, sdk.ZeroDec(), 0, false},
+		{valAddr1, []CommissionSplitRecipient{recipient(delAddr1, "-0.1")}, sdk.ZeroDec(), 0, false},
+		{valAddr1, []CommissionSplitRecipient{recipient(delAddr1, "0.6")}, sdk.MustNewDecFromStr("0.5"), 0, false},
+		{valAddr1, []CommissionSplitRecipient{}, sdk.MustNewDecFromStr("-0.1"), 0, false},
+		{valAddr1, []CommissionSplitRecipient{}, sdk.ZeroDec(), -1, false},
+		{valAddr1, tooMany, sdk.ZeroDec(), 0, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionSplit(tc.validatorAddr, tc.recipients, tc.communityPoolShare, tc.autoWithdrawInterval)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -17,6 +17,12 @@ var (
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
 )
 
+// Default auto-restake parameters
+const (
+	DefaultAutoRestakeGasBudget uint64 = 2_000_000
+	DefaultAutoRestakeInterval  int64  = 100
+)
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -25,10 +31,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:         sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:   sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:  sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:  true,
+		AutoRestakeGasBudget: DefaultAutoRestakeGasBudget,
+		AutoRestakeInterval:  DefaultAutoRestakeInterval,
 	}
 }
 
@@ -38,6 +46,9 @@ func (p Params) String() string {
 }
 
 // ParamSetPairs returns the parameter set pairs.
+//
+// NOTE: the auto-restake parameters were introduced after the migration of the
+// params out of x/params and are deliberately not part of the legacy set.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyCommunityTax, &p.CommunityTax, validateCommunityTax),
@@ -69,6 +80,11 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if p.AutoRestakeInterval <= 0 {
+		return fmt.Errorf(
+			"auto-restake interval must be positive: %d", p.AutoRestakeInterval,
+		)
+	}
 
 	return nil
 }
@@ -17,17 +17,19 @@ func TestParams_ValidateBasic(t *testing.T) {
 		BaseProposerReward  sdk.Dec
 		BonusProposerReward sdk.Dec
 		WithdrawAddrEnabled bool
+		AutoRestakeInterval int64
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false}, true},
-		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false}, true},
-		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false}, true},
-		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 1}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false, 1}, true},
+		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false, 1}, true},
+		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false, 1}, true},
+		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, 1}, true},
+		{"zero auto-restake interval", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 0}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -36,6 +38,7 @@ func TestParams_ValidateBasic(t *testing.T) {
 				BaseProposerReward:  tt.fields.BaseProposerReward,
 				BonusProposerReward: tt.fields.BonusProposerReward,
 				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				AutoRestakeInterval: tt.fields.AutoRestakeInterval,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -789,9 +789,11 @@ func (m *QueryTokenizeShareRecordRewardRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryTokenizeShareRecordRewardRequest proto.InternalMessageInfo
 
 type QueryTokenizeShareRecordRewardResponse struct {
-	// rewards defines all the rewards accrued by a delegator.
+	// rewards defines the rewards accrued by each tokenize share record of the owner,
+	// including the records whose validator or delegation no longer exists.
 	Rewards []TokenizeShareRecordReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
-	// total defines the sum of all the rewards.
+	// total defines the sum of all the rewards of the owner, including the idle
+	// balances and excluding the holders balances of the records whose rewards are split.
 	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
 }
 
@@ -844,6 +846,182 @@ func (m *QueryTokenizeShareRecordRewardResponse) GetTotal() github_com_cosmos_co
 	return nil
 }
 
+// QueryDelegatorAutoRestakesRequest is the request type for the
+// Query/DelegatorAutoRestakes RPC method.
+type QueryDelegatorAutoRestakesRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) Reset()         { *m = QueryDelegatorAutoRestakesRequest{} }
+func (m *QueryDelegatorAutoRestakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoRestakesRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoRestakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{18}
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoRestakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoRestakesRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoRestakesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoRestakesRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoRestakesResponse is the response type for the
+// Query/DelegatorAutoRestakes RPC method.
+type QueryDelegatorAutoRestakesResponse struct {
+	// validators defines the validators the delegator has auto-restake enabled with.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) Reset()         { *m = QueryDelegatorAutoRestakesResponse{} }
+func (m *QueryDelegatorAutoRestakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoRestakesResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoRestakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{19}
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoRestakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoRestakesResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoRestakesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoRestakesResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoRestakesResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// QueryCommissionSplitRequest is the request type for the
+// Query/CommissionSplit RPC method.
+type QueryCommissionSplitRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryCommissionSplitRequest) Reset()         { *m = QueryCommissionSplitRequest{} }
+func (m *QueryCommissionSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionSplitRequest) ProtoMessage()    {}
+func (*QueryCommissionSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{20}
+}
+func (m *QueryCommissionSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommissionSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionSplitRequest.Merge(m, src)
+}
+func (m *QueryCommissionSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionSplitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommissionSplitRequest proto.InternalMessageInfo
+
+// QueryCommissionSplitResponse is the response type for the
+// Query/CommissionSplit RPC method.
+type QueryCommissionSplitResponse struct {
+	// commission_split defines the commission split of the validator. It has no
+	// recipients and no community pool share if the validator has no commission
+	// split.
+	CommissionSplit CommissionSplit `protobuf:"bytes,1,opt,name=commission_split,json=commissionSplit,proto3" json:"commission_split"`
+}
+
+func (m *QueryCommissionSplitResponse) Reset()         { *m = QueryCommissionSplitResponse{} }
+func (m *QueryCommissionSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionSplitResponse) ProtoMessage()    {}
+func (*QueryCommissionSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bee02899ef89b167, []int{21}
+}
+func (m *QueryCommissionSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommissionSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionSplitResponse.Merge(m, src)
+}
+func (m *QueryCommissionSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommissionSplitResponse proto.InternalMessageInfo
+
+func (m *QueryCommissionSplitResponse) GetCommissionSplit() CommissionSplit {
+	if m != nil {
+		return m.CommissionSplit
+	}
+	return CommissionSplit{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "liquidstaking.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "liquidstaking.distribution.v1beta1.QueryParamsResponse")
@@ -863,90 +1041,103 @@ func init() {
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "liquidstaking.distribution.v1beta1.QueryCommunityPoolResponse")
 	proto.RegisterType((*QueryTokenizeShareRecordRewardRequest)(nil), "liquidstaking.distribution.v1beta1.QueryTokenizeShareRecordRewardRequest")
 	proto.RegisterType((*QueryTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.QueryTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*QueryDelegatorAutoRestakesRequest)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorAutoRestakesRequest")
+	proto.RegisterType((*QueryDelegatorAutoRestakesResponse)(nil), "liquidstaking.distribution.v1beta1.QueryDelegatorAutoRestakesResponse")
+	proto.RegisterType((*QueryCommissionSplitRequest)(nil), "liquidstaking.distribution.v1beta1.QueryCommissionSplitRequest")
+	proto.RegisterType((*QueryCommissionSplitResponse)(nil), "liquidstaking.distribution.v1beta1.QueryCommissionSplitResponse")
 }
 
 func init() { proto.RegisterFile("distribution/v1beta1/query.proto", fileDescriptor_bee02899ef89b167) }
 
 var fileDescriptor_bee02899ef89b167 = []byte{
-	// 1248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xba, 0x69, 0x4a, 0xa7, 0x0d, 0x4d, 0xa7, 0x11, 0x72, 0xb6, 0xc1, 0x8e, 0x36, 0xb4,
-	0x89, 0x1a, 0xc5, 0xab, 0x26, 0x12, 0x95, 0x82, 0x02, 0xe4, 0xb3, 0xa1, 0x8d, 0xfa, 0xe1, 0x44,
-	0x44, 0x20, 0x81, 0x35, 0xf1, 0x8e, 0xd6, 0xa3, 0xac, 0x77, 0x9c, 0x9d, 0xd9, 0x98, 0x10, 0xe5,
-	0x02, 0x2a, 0x42, 0x82, 0x03, 0x88, 0x0b, 0xc7, 0x9c, 0x38, 0x70, 0xe6, 0x1f, 0x40, 0x5c, 0x7a,
-	0x2c, 0x70, 0xe1, 0x54, 0x50, 0xd2, 0x03, 0x97, 0x4a, 0x15, 0x07, 0xce, 0x68, 0x67, 0x66, 0x6d,
-	0x6f, 0xfc, 0x5d, 0x3b, 0xe2, 0x14, 0xef, 0x9b, 0x79, 0xbf, 0xf7, 0x7e, 0xef, 0xcd, 0x9b, 0xf9,
-	0x29, 0x60, 0xd4, 0x22, 0x8c, 0x7b, 0x64, 0xcb, 0xe7, 0x84, 0xba, 0xe6, 0xee, 0xcd, 0x2d, 0xcc,
-	0xd1, 0x4d, 0x73, 0xc7, 0xc7, 0xde, 0x5e, 0xba, 0xe8, 0x51, 0x4e, 0xa1, 0xe1, 0x90, 0x1d, 0x9f,
-	0x58, 0x8c, 0xa3, 0x6d, 0xe2, 0xda, 0xe9, 0xea, 0xfd, 0x69, 0xb5, 0x5f, 0xbf, 0x91, 0xa3, 0xac,
-	0x40, 0x99, 0xb9, 0x85, 0x18, 0x96, 0xce, 0x65, 0xa8, 0x22, 0xb2, 0x89, 0x8b, 0xc4, 0x6e, 0x81,
-	0xa7, 0x0f, 0xd9, 0xd4, 0xa6, 0xe2, 0xa7, 0x19, 0xfc, 0x52, 0xd6, 0x11, 0x9b, 0x52, 0xdb, 0xc1,
-	0x26, 0x2a, 0x12, 0x13, 0xb9, 0x2e, 0xe5, 0xc2, 0x85, 0xa9, 0xd5, 0x64, 0x35, 0x7e, 0x88, 0x9c,
-	0xa3, 0x24, 0xc4, 0x1c, 0xaf, 0xcb, 0x22, 0x92, 0xaa, 0xda, 0xa8, 0x80, 0x5a, 0xb1, 0xd6, 0x87,
-	0xe5, 0xc6, 0xac, 0x4c, 0x54, 0x7e, 0xc8, 0x25, 0x63, 0x08, 0xc0, 0x87, 0xc1, 0xce, 0x07, 0xc8,
-	0x43, 0x05, 0x96, 0xc1, 0x3b, 0x3e, 0x66, 0xdc, 0xc8, 0x82, 0x2b, 0x11, 0x2b, 0x2b, 0x52, 0x97,
-	0x61, 0xb8, 0x0a, 0xfa, 0x8b, 0xc2, 0x92, 0xd0, 0x46, 0xb5, 0x89, 0x0b, 0xd3, 0x37, 0xd2, 0xad,
-	0xcb, 0x99, 0x96, 0x18, 0x0b, 0x7d, 0x8f, 0x9f, 0xa6, 0x62, 0x19, 0xe5, 0x6f, 0x14, 0xc1, 0xb8,
-	0x08, 0xf0, 0x3e, 0x72, 0x88, 0x85, 0x38, 0xf5, 0xee, 0xfb, 0x9c, 0x71, 0xe4, 0x5a, 0xc4, 0xb5,
-	0x33, 0xb8, 0x84, 0x3c, 0x2b, 0xcc, 0x05, 0x2e, 0x83, 0xcb, 0xbb, 0xe1, 0xae, 0x2c, 0xb2, 0x2c,
-	0x0f, 0x33, 0x19, 0xff, 0xfc, 0x42, 0xe2, 0xb7, 0x9f, 0xa6, 0x86, 0x14, 0x9d, 0x79, 0xb9, 0xb2,
-	0xce, 0xbd, 0x00, 0x62, 0xb0, 0xec, 0xa2, 0xec, 0xc6, 0x57, 0x1a, 0x98, 0x68, 0x1d, 0x52, 0x11,
-	0xcd, 0x82, 0x73, 0x9e, 0x34, 0x29, 0xa6, 0xef, 0xb4, 0xc3, 0xb4, 0x09, 0xb2, 0xa2, 0x1f, 0xa2,
-	0x1a, 0x79, 0x90, 0x8a, 0x26, 0xb3, 0x48, 0x0b, 0x05, 0xc2, 0x18, 0xa1, 0x6e, 0x8f, 0x79, 0x7f,
-	0xad, 0x81, 0xd1, 0xc6, 0xa1, 0x14, 0xdf, 0x3c, 0x00, 0xb9, 0xb2, 0x55, 0x51, 0x5e, 0xe8, 0x88,
-	0xf2, 0x7c, 0x2e, 0xe7, 0x17, 0x7c, 0x07, 0x71, 0x6c, 0x55, 0xf0, 0x15, 0xeb, 0x2a, 0x6c, 0xe3,
-	0x51, 0x1c, 0x8c, 0x44, 0xd3, 0x59, 0x77, 0x10, 0xcb, 0xe3, 0x1e, 0xb7, 0x1b, 0x8e, 0x83, 0x4b,
-	0x8c, 0x23, 0x8f, 0x13, 0xd7, 0xce, 0xe6, 0x31, 0xb1, 0xf3, 0x3c, 0x11, 0x1f, 0xd5, 0x26, 0xfa,
-	0x32, 0xaf, 0x86, 0xe6, 0x55, 0x61, 0x85, 0x63, 0x60, 0x00, 0x8b, 0x4e, 0x85, 0xdb, 0xce, 0x88,
-	0x6d, 0x17, 0xa5, 0x51, 0x6d, 0x5a, 0x01, 0xa0, 0x32, 0xfa, 0x89, 0x3e, 0x51, 0x9f, 0xeb, 0x69,
-	0x95, 0x4a, 0x30, 0xc7, 0x69, 0x39, 0x6e, 0x95, 0x33, 0x6f, 0x63, 0x45, 0x28, 0x53, 0xe5, 0x39,
-	0xfb, 0xca, 0x97, 0x87, 0xa9, 0xd8, 0xf7, 0x87, 0x29, 0xcd, 0xf8, 0x59, 0x03, 0xaf, 0x37, 0xa8,
-	0x83, 0xea, 0xc9, 0x26, 0x38, 0xc7, 0xa4, 0x29, 0xa1, 0x8d, 0x9e, 0x99, 0xb8, 0x30, 0x7d, 0xab,
-	0xa3, 0x86, 0x08, 0xb8, 0xe5, 0x5d, 0xec, 0xf2, 0xf0, 0xec, 0x29, 0x34, 0x78, 0x3b, 0x42, 0x26,
-	0x2e, 0xc8, 0x8c, 0xb7, 0x24, 0x23, 0xb3, 0xaa, 0x66, 0x63, 0xf8, 0xc0, 0x10, 0x14, 0x96, 0xb0,
-	0x83, 0x6d, 0x61, 0xda, 0xa0, 0x1c, 0x39, 0xb5, 0xf3, 0x6b, 0xc9, 0x0d, 0x9d, 0x34, 0xb4, 0xec,
-	0xa2, 0xec, 0xb2, 0x74, 0x7f, 0x1f, 0xa6, 0x62, 0xc6, 0x73, 0x0d, 0x8c, 0x35, 0x8d, 0xab, 0x0a,
-	0xf8, 0x51, 0xf5, 0x10, 0x07, 0x05, 0x9c, 0x6b, 0xa7, 0x80, 0x15, 0xd0, 0xa5, 0x30, 0x05, 0x09,
-	0x7c, 0x62, 0x84, 0xa1, 0x0d, 0xce, 0xf2, 0x20, 0x6c, 0x22, 0x2e, 0xc0, 0x47, 0x22, 0x15, 0xac,
-	0xa0, 0xe5, 0x16, 0x29, 0x71, 0x17, 0x66, 0x02, 0xdf, 0x1f, 0xff, 0x4c, 0x4d, 0xda, 0x84, 0xe7,
-	0xfd, 0xad, 0x74, 0x8e, 0x16, 0xd4, 0x3d, 0xac, 0xfe, 0x4c, 0x31, 0x6b, 0xdb, 0xe4, 0x7b, 0x45,
-	0xcc, 0x42, 0x1f, 0x96, 0x91, 0xf8, 0x86, 0xa7, 0xee, 0x8a, 0x72, 0x3e, 0xe5, 0x1e, 0x9f, 0x5e,
-	0x8d, 0xd7, 0xd4, 0xa5, 0x51, 0x37, 0xa6, 0xaa, 0x6f, 0x12, 0x80, 0xf2, 0xd8, 0xc9, 0x12, 0x9f,
-	0xcf, 0x54, 0x59, 0xaa, 0xd0, 0x4a, 0xe0, 0x8d, 0x28, 0xda, 0x26, 0xe1, 0x79, 0xcb, 0x43, 0x25,
-	0x15, 0xf8, 0xd4, 0x68, 0xec, 0x82, 0x6b, 0x2d, 0x02, 0x2b, 0x2e, 0x8b, 0x60, 0xb0, 0xa4, 0x96,
-	0xda, 0x0e, 0x7c, 0xa9, 0x14, 0x05, 0xab, 0x8a, 0x7b, 0x15, 0x0c, 0x8b, 0xb8, 0xc1, 0x55, 0xe8,
-	0xbb, 0x84, 0xef, 0x3d, 0xa0, 0xd4, 0x09, 0x1f, 0xd7, 0xcf, 0x35, 0xa0, 0xd7, 0x5b, 0x55, 0xa9,
-	0x60, 0xd0, 0x57, 0xa4, 0xd4, 0x51, 0x67, 0xf6, 0x14, 0x8e, 0x95, 0x80, 0x37, 0x8a, 0xaa, 0x34,
-	0x1b, 0x74, 0x1b, 0xbb, 0xe4, 0x53, 0xbc, 0x9e, 0x47, 0x1e, 0xce, 0xe0, 0x1c, 0xf5, 0x2c, 0x79,
-	0xde, 0xc3, 0xa6, 0xcc, 0x81, 0x01, 0x5a, 0x72, 0x71, 0x4d, 0x43, 0xfe, 0x79, 0x9a, 0x1a, 0xda,
-	0x43, 0x05, 0x67, 0xd6, 0x88, 0x2c, 0x1b, 0x99, 0x8b, 0xe2, 0xbb, 0xb6, 0x28, 0x2f, 0x34, 0x70,
-	0xbd, 0x55, 0xc8, 0xae, 0x46, 0xb7, 0x21, 0xee, 0xff, 0x35, 0xba, 0xd3, 0xbf, 0x42, 0x70, 0x56,
-	0x50, 0x86, 0x3f, 0x68, 0xa0, 0x5f, 0x2a, 0x21, 0xf8, 0x66, 0x3b, 0x5c, 0x6a, 0x45, 0x99, 0x7e,
-	0xab, 0x63, 0x3f, 0x59, 0x4d, 0x63, 0xf2, 0xb3, 0xdf, 0x9f, 0x7d, 0x17, 0xbf, 0x06, 0xc7, 0xcc,
-	0x66, 0x82, 0x51, 0x2a, 0x33, 0xf8, 0x6d, 0x1c, 0x5c, 0x6d, 0x22, 0x64, 0xe0, 0xdd, 0xb6, 0xb3,
-	0x68, 0xad, 0xed, 0xf4, 0xb5, 0xde, 0x80, 0x29, 0x9e, 0x9b, 0x82, 0xe7, 0x43, 0x78, 0xbf, 0x29,
-	0xcf, 0xca, 0x0d, 0x65, 0xee, 0xd7, 0x28, 0x8d, 0x03, 0x93, 0x56, 0xf0, 0xb3, 0xe1, 0x79, 0x79,
-	0xa1, 0x81, 0x2b, 0x75, 0xe4, 0x13, 0x5c, 0xec, 0x3c, 0xfd, 0x1a, 0x9d, 0xa7, 0x2f, 0x75, 0x07,
-	0xa2, 0xb8, 0xdf, 0x13, 0xdc, 0x57, 0xe1, 0x4a, 0x37, 0xdc, 0x2b, 0x3a, 0x0d, 0x3e, 0xd3, 0xc0,
-	0xe0, 0x49, 0x69, 0x02, 0xdf, 0xed, 0x3c, 0xd5, 0xa8, 0xba, 0xd3, 0xe7, 0xbb, 0x40, 0x50, 0x4c,
-	0xef, 0x0a, 0xa6, 0xcb, 0x70, 0xb1, 0x1b, 0xa6, 0xa1, 0x16, 0x7a, 0xae, 0x81, 0xcb, 0x95, 0x17,
-	0x3f, 0x3c, 0xe3, 0xb3, 0xe1, 0x85, 0xd0, 0x38, 0xbd, 0x1a, 0xa7, 0x90, 0xe1, 0x5b, 0x2f, 0xe5,
-	0xab, 0xb8, 0x65, 0x05, 0xb7, 0x0f, 0xe0, 0x66, 0x53, 0x6e, 0xe5, 0x07, 0x8f, 0x99, 0xfb, 0x35,
-	0xef, 0xe5, 0x81, 0xa9, 0x4e, 0x6d, 0x3d, 0xde, 0xf0, 0x5f, 0x0d, 0xbc, 0x56, 0x5f, 0x36, 0xc1,
-	0x95, 0xb6, 0x5b, 0xd3, 0x54, 0xef, 0xe9, 0xb7, 0xbb, 0xc6, 0xe9, 0xa8, 0xd1, 0xed, 0x15, 0x43,
-	0x8c, 0x70, 0x1d, 0x31, 0xd3, 0xc1, 0x08, 0x37, 0x96, 0x5f, 0x1d, 0x8c, 0x70, 0x13, 0x3d, 0xd5,
-	0xe6, 0x08, 0xb7, 0xe0, 0x5b, 0x39, 0xf7, 0xf0, 0x51, 0x1c, 0x24, 0x1a, 0x09, 0x1f, 0xb8, 0xda,
-	0x79, 0xca, 0xf5, 0x45, 0x9b, 0xfe, 0x5e, 0x0f, 0x90, 0x54, 0x05, 0x36, 0x44, 0x05, 0xee, 0xc1,
-	0xb5, 0x6e, 0x2a, 0x70, 0x52, 0xc7, 0xc1, 0x5f, 0x34, 0x30, 0x10, 0x91, 0x5a, 0x70, 0xae, 0xed,
-	0x94, 0xeb, 0x09, 0x38, 0xfd, 0xed, 0x97, 0x75, 0x57, 0x34, 0x67, 0x04, 0xcd, 0x29, 0x38, 0xd9,
-	0x94, 0x66, 0x2e, 0xf4, 0xcd, 0x06, 0x7a, 0x0d, 0x7e, 0x11, 0x07, 0xc3, 0x0d, 0x05, 0x0e, 0x6c,
-	0xbf, 0x09, 0xad, 0xf4, 0x9e, 0x7e, 0xa7, 0x17, 0x50, 0x8a, 0x69, 0x46, 0x30, 0x5d, 0x83, 0x77,
-	0x9a, 0x32, 0xdd, 0x8f, 0x08, 0xc8, 0x03, 0x93, 0x2b, 0xdc, 0x2c, 0x0b, 0x80, 0xb3, 0x9e, 0x40,
-	0x0e, 0x1f, 0xe3, 0x85, 0x8f, 0x1f, 0x1f, 0x25, 0xb5, 0x27, 0x47, 0x49, 0xed, 0xaf, 0xa3, 0xa4,
-	0xf6, 0xcd, 0x71, 0x32, 0xf6, 0xe4, 0x38, 0x19, 0xfb, 0xe3, 0x38, 0x19, 0xfb, 0x70, 0xa9, 0x4a,
-	0xa1, 0x91, 0x1d, 0xc7, 0x0f, 0x1e, 0x32, 0xe2, 0xe6, 0x4c, 0xc9, 0x87, 0xf0, 0xbd, 0x29, 0xc5,
-	0x69, 0xaa, 0x40, 0x2d, 0xdf, 0xc1, 0xe6, 0x27, 0xd1, 0x7c, 0x84, 0x86, 0xdb, 0xea, 0x17, 0xff,
-	0x18, 0x9b, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x77, 0xa6, 0xd8, 0x4d, 0x14, 0x00, 0x00,
+	// 1377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6c, 0x13, 0xc7,
+	0x17, 0xcf, 0x98, 0x00, 0x7f, 0x1e, 0xf0, 0x4f, 0x18, 0x52, 0x64, 0x96, 0xd4, 0x4e, 0x97, 0x42,
+	0x22, 0x50, 0xbc, 0x82, 0x48, 0x45, 0xa2, 0xa2, 0x90, 0x4f, 0x02, 0x44, 0x7c, 0x38, 0xa8, 0x51,
+	0x2b, 0xb5, 0xd6, 0xc6, 0x3b, 0x5a, 0x8f, 0x58, 0xef, 0x38, 0xbb, 0xb3, 0x49, 0x53, 0xc4, 0xa5,
+	0x2d, 0x55, 0xa5, 0xf6, 0xd0, 0xaa, 0x97, 0x1e, 0x39, 0xf5, 0xd0, 0x73, 0x4f, 0xbd, 0x55, 0xbd,
+	0x70, 0x44, 0xed, 0xa5, 0x27, 0x5a, 0x01, 0x87, 0xaa, 0x12, 0x12, 0xea, 0xa1, 0x52, 0x6f, 0xd5,
+	0xce, 0xcc, 0xda, 0xde, 0xf8, 0x6b, 0x17, 0x27, 0xea, 0x29, 0xf6, 0x9b, 0xf7, 0x7e, 0xef, 0xfd,
+	0xde, 0xbc, 0xb7, 0xfb, 0x73, 0x60, 0xcc, 0xa2, 0x3e, 0xf7, 0xe8, 0x6a, 0xc0, 0x29, 0x73, 0x8d,
+	0xf5, 0x33, 0xab, 0x84, 0x9b, 0x67, 0x8c, 0xb5, 0x80, 0x78, 0x9b, 0x85, 0x9a, 0xc7, 0x38, 0xc3,
+	0xba, 0x43, 0xd7, 0x02, 0x6a, 0xf9, 0xdc, 0xbc, 0x43, 0x5d, 0xbb, 0xd0, 0xec, 0x5f, 0x50, 0xfe,
+	0xda, 0xa9, 0x32, 0xf3, 0xab, 0xcc, 0x37, 0x56, 0x4d, 0x9f, 0xc8, 0xe0, 0x3a, 0x54, 0xcd, 0xb4,
+	0xa9, 0x6b, 0x0a, 0x6f, 0x81, 0xa7, 0x8d, 0xd8, 0xcc, 0x66, 0xe2, 0xa3, 0x11, 0x7e, 0x52, 0xd6,
+	0x51, 0x9b, 0x31, 0xdb, 0x21, 0x86, 0x59, 0xa3, 0x86, 0xe9, 0xba, 0x8c, 0x8b, 0x10, 0x5f, 0x9d,
+	0xe6, 0x9a, 0xf1, 0x23, 0xe4, 0x32, 0xa3, 0x11, 0xe6, 0x78, 0x5b, 0x16, 0xb1, 0x52, 0x95, 0xa3,
+	0x02, 0xea, 0xc5, 0x5a, 0x3b, 0x2a, 0x1d, 0x4b, 0xb2, 0x50, 0xf9, 0x45, 0x1e, 0xe9, 0x23, 0x80,
+	0x6f, 0x85, 0x9e, 0x37, 0x4d, 0xcf, 0xac, 0xfa, 0x45, 0xb2, 0x16, 0x10, 0x9f, 0xeb, 0x25, 0x38,
+	0x1c, 0xb3, 0xfa, 0x35, 0xe6, 0xfa, 0x04, 0x2f, 0xc2, 0x9e, 0x9a, 0xb0, 0x64, 0xd1, 0x18, 0x9a,
+	0xd8, 0x7f, 0xf6, 0x54, 0xa1, 0x77, 0x3b, 0x0b, 0x12, 0x63, 0x66, 0xf0, 0xe1, 0xe3, 0xfc, 0x40,
+	0x51, 0xc5, 0xeb, 0x35, 0x18, 0x17, 0x09, 0xde, 0x36, 0x1d, 0x6a, 0x99, 0x9c, 0x79, 0x37, 0x02,
+	0xee, 0x73, 0xd3, 0xb5, 0xa8, 0x6b, 0x17, 0xc9, 0x86, 0xe9, 0x59, 0x51, 0x2d, 0x78, 0x1e, 0x0e,
+	0xad, 0x47, 0x5e, 0x25, 0xd3, 0xb2, 0x3c, 0xe2, 0xcb, 0xfc, 0xfb, 0x66, 0xb2, 0x3f, 0x7f, 0x3f,
+	0x39, 0xa2, 0xe8, 0x4c, 0xcb, 0x93, 0x65, 0xee, 0x85, 0x10, 0xc3, 0xf5, 0x10, 0x65, 0xd7, 0x3f,
+	0x47, 0x30, 0xd1, 0x3b, 0xa5, 0x22, 0x5a, 0x82, 0xbd, 0x9e, 0x34, 0x29, 0xa6, 0x17, 0x93, 0x30,
+	0xed, 0x82, 0xac, 0xe8, 0x47, 0xa8, 0x7a, 0x05, 0xf2, 0xf1, 0x62, 0x66, 0x59, 0xb5, 0x4a, 0x7d,
+	0x9f, 0x32, 0x77, 0x9b, 0x79, 0x7f, 0x81, 0x60, 0xac, 0x73, 0x2a, 0xc5, 0xb7, 0x02, 0x50, 0xae,
+	0x5b, 0x15, 0xe5, 0x99, 0x54, 0x94, 0xa7, 0xcb, 0xe5, 0xa0, 0x1a, 0x38, 0x26, 0x27, 0x56, 0x03,
+	0x5f, 0xb1, 0x6e, 0xc2, 0xd6, 0xef, 0x67, 0x60, 0x34, 0x5e, 0xce, 0xb2, 0x63, 0xfa, 0x15, 0xb2,
+	0xcd, 0xd7, 0x8d, 0xc7, 0x61, 0xc8, 0xe7, 0xa6, 0xc7, 0xa9, 0x6b, 0x97, 0x2a, 0x84, 0xda, 0x15,
+	0x9e, 0xcd, 0x8c, 0xa1, 0x89, 0xc1, 0xe2, 0xff, 0x23, 0xf3, 0xa2, 0xb0, 0xe2, 0xe3, 0x70, 0x90,
+	0xb8, 0x56, 0x93, 0xdb, 0x2e, 0xe1, 0x76, 0x40, 0x1a, 0x95, 0xd3, 0x02, 0x40, 0x63, 0xf5, 0xb3,
+	0x83, 0xa2, 0x3f, 0x27, 0x0b, 0xaa, 0x94, 0x70, 0x8f, 0x0b, 0x72, 0xdd, 0x1a, 0x33, 0x6f, 0x13,
+	0x45, 0xa8, 0xd8, 0x14, 0x79, 0xfe, 0x7f, 0x9f, 0x3d, 0xc8, 0x0f, 0x7c, 0xf3, 0x20, 0x8f, 0xf4,
+	0x1f, 0x11, 0xbc, 0xda, 0xa1, 0x0f, 0xea, 0x4e, 0x56, 0x60, 0xaf, 0x2f, 0x4d, 0x59, 0x34, 0xb6,
+	0x6b, 0x62, 0xff, 0xd9, 0x73, 0xa9, 0x2e, 0x44, 0xc0, 0xcd, 0xaf, 0x13, 0x97, 0x47, 0xb3, 0xa7,
+	0xd0, 0xf0, 0xe5, 0x18, 0x99, 0x8c, 0x20, 0x33, 0xde, 0x93, 0x8c, 0xac, 0xaa, 0x99, 0x8d, 0x1e,
+	0x80, 0x2e, 0x28, 0xcc, 0x11, 0x87, 0xd8, 0xc2, 0x74, 0x9b, 0x71, 0xd3, 0x69, 0xdd, 0x5f, 0x4b,
+	0x3a, 0xa4, 0xb9, 0xd0, 0x7a, 0x88, 0xb2, 0xcb, 0xd6, 0xfd, 0xf1, 0x20, 0x3f, 0xa0, 0x3f, 0x47,
+	0x70, 0xbc, 0x6b, 0x5e, 0xd5, 0xc0, 0xf7, 0x9a, 0x97, 0x38, 0x6c, 0xe0, 0x85, 0x24, 0x0d, 0x6c,
+	0x80, 0xce, 0x45, 0x25, 0x48, 0xe0, 0x2d, 0x2b, 0x8c, 0x6d, 0xd8, 0xcd, 0xc3, 0xb4, 0xd9, 0x8c,
+	0x00, 0x1f, 0x8d, 0x75, 0xb0, 0x81, 0x56, 0x9e, 0x65, 0xd4, 0x9d, 0x99, 0x0a, 0x63, 0xbf, 0xfb,
+	0x2d, 0x7f, 0xda, 0xa6, 0xbc, 0x12, 0xac, 0x16, 0xca, 0xac, 0xaa, 0x9e, 0xc3, 0xea, 0xcf, 0xa4,
+	0x6f, 0xdd, 0x31, 0xf8, 0x66, 0x8d, 0xf8, 0x51, 0x8c, 0x5f, 0x94, 0xf8, 0xba, 0xa7, 0x9e, 0x15,
+	0xf5, 0x7a, 0xea, 0x77, 0xbc, 0x73, 0x3d, 0x5e, 0x82, 0xb1, 0xce, 0x39, 0x55, 0x7f, 0x73, 0x00,
+	0xf5, 0xb5, 0x93, 0x2d, 0xde, 0x57, 0x6c, 0xb2, 0x34, 0xa1, 0x6d, 0xc0, 0xeb, 0x71, 0xb4, 0x15,
+	0xca, 0x2b, 0x96, 0x67, 0x6e, 0xa8, 0xc4, 0x3b, 0x46, 0x63, 0x1d, 0x4e, 0xf4, 0x48, 0xac, 0xb8,
+	0xcc, 0xc2, 0xf0, 0x86, 0x3a, 0x4a, 0x9c, 0x78, 0x68, 0x23, 0x0e, 0xd6, 0x94, 0xf7, 0x18, 0x1c,
+	0x15, 0x79, 0xc3, 0x47, 0x61, 0xe0, 0x52, 0xbe, 0x79, 0x93, 0x31, 0x27, 0x7a, 0xb9, 0x7e, 0x8c,
+	0x40, 0x6b, 0x77, 0xaa, 0x4a, 0x21, 0x30, 0x58, 0x63, 0xcc, 0xc9, 0xa2, 0x9d, 0x1a, 0x2b, 0x01,
+	0xaf, 0xd7, 0x54, 0x6b, 0x6e, 0xb3, 0x3b, 0xc4, 0xa5, 0x1f, 0x92, 0xe5, 0x8a, 0xe9, 0x91, 0x22,
+	0x29, 0x33, 0xcf, 0x92, 0xf3, 0x1e, 0x5d, 0xca, 0x05, 0x38, 0xc8, 0x36, 0x5c, 0xd2, 0x72, 0x21,
+	0x7f, 0x3d, 0xce, 0x8f, 0x6c, 0x9a, 0x55, 0xe7, 0xbc, 0x1e, 0x3b, 0xd6, 0x8b, 0x07, 0xc4, 0xf7,
+	0xd6, 0xa6, 0xbc, 0x40, 0x70, 0xb2, 0x57, 0xca, 0xbe, 0x56, 0xb7, 0x23, 0xee, 0x7f, 0xb6, 0xba,
+	0x1c, 0x5e, 0x8b, 0xcf, 0xdf, 0x74, 0xc0, 0x59, 0x91, 0x84, 0x2c, 0xc8, 0xce, 0x4d, 0xfd, 0x1c,
+	0xe8, 0xdd, 0xb2, 0x26, 0x5b, 0x5f, 0xdd, 0x85, 0x63, 0xf5, 0x29, 0x95, 0x2f, 0xef, 0xe5, 0x9a,
+	0x43, 0xf9, 0xf6, 0xbe, 0xa7, 0x9b, 0xaa, 0xfe, 0x04, 0xc1, 0x68, 0xfb, 0x84, 0xaa, 0x60, 0x0b,
+	0x86, 0x1b, 0x42, 0xa2, 0xe4, 0x87, 0x67, 0x4a, 0xaa, 0x4c, 0x25, 0x99, 0x8e, 0x2d, 0xb0, 0x6a,
+	0x26, 0x86, 0xca, 0x71, 0xf3, 0xd9, 0x1f, 0x8e, 0xc0, 0x6e, 0x51, 0x06, 0xfe, 0x16, 0xc1, 0x1e,
+	0x29, 0x5e, 0xf1, 0x1b, 0x49, 0x12, 0xb4, 0xea, 0x68, 0xed, 0x5c, 0xea, 0x38, 0xc9, 0x55, 0x3f,
+	0xfd, 0xd1, 0x2f, 0xcf, 0xbe, 0xce, 0x9c, 0xc0, 0xc7, 0x8d, 0x6e, 0x1a, 0x5f, 0x8a, 0x69, 0xfc,
+	0x55, 0x06, 0x8e, 0x75, 0xd1, 0x9e, 0xf8, 0x5a, 0xe2, 0x2a, 0x7a, 0xcb, 0x71, 0x6d, 0x69, 0x7b,
+	0xc0, 0x14, 0xcf, 0x15, 0xc1, 0xf3, 0x16, 0xbe, 0xd1, 0x95, 0x67, 0x63, 0x2a, 0x8d, 0xbb, 0x2d,
+	0x43, 0x77, 0xcf, 0x60, 0x0d, 0xfc, 0x52, 0xb4, 0xe2, 0x2f, 0x10, 0x1c, 0x6e, 0xa3, 0x78, 0xf1,
+	0x6c, 0xfa, 0xf2, 0x5b, 0xa4, 0xb9, 0x36, 0xd7, 0x1f, 0x88, 0xe2, 0x7e, 0x5d, 0x70, 0x5f, 0xc4,
+	0x0b, 0xfd, 0x70, 0x6f, 0x8c, 0x2f, 0x7e, 0x86, 0x60, 0x78, 0xab, 0x9a, 0xc4, 0x97, 0xd2, 0x97,
+	0x1a, 0x17, 0xe4, 0xda, 0x74, 0x1f, 0x08, 0x8a, 0xe9, 0x35, 0xc1, 0x74, 0x1e, 0xcf, 0xf6, 0xc3,
+	0x34, 0x92, 0xaf, 0xcf, 0x11, 0x1c, 0x6a, 0x88, 0xb4, 0x68, 0xc6, 0xcf, 0x47, 0xcf, 0xf0, 0xce,
+	0xe5, 0xb5, 0x04, 0x45, 0x0c, 0xdf, 0x7c, 0xa9, 0x58, 0xc5, 0xad, 0x24, 0xb8, 0xbd, 0x83, 0x57,
+	0xba, 0x72, 0xab, 0x3f, 0xad, 0x7d, 0xe3, 0x6e, 0xcb, 0xc3, 0xfe, 0x9e, 0xa1, 0xa6, 0xb6, 0x1d,
+	0x6f, 0xfc, 0x37, 0x82, 0x23, 0xed, 0x95, 0x2e, 0x5e, 0x48, 0x7c, 0x35, 0x5d, 0x25, 0xba, 0x76,
+	0xb9, 0x6f, 0x9c, 0x54, 0x17, 0x9d, 0xac, 0x19, 0x62, 0x85, 0xdb, 0xe8, 0xcf, 0x14, 0x2b, 0xdc,
+	0x59, 0x31, 0x6b, 0x73, 0xfd, 0x81, 0xa4, 0x5a, 0xe1, 0x1e, 0x7c, 0x1b, 0x73, 0x8f, 0xef, 0x67,
+	0x20, 0xdb, 0x49, 0xab, 0xe2, 0xc5, 0xf4, 0x25, 0xb7, 0xd7, 0xd9, 0xda, 0x95, 0x6d, 0x40, 0x52,
+	0x1d, 0xb8, 0x2d, 0x3a, 0x70, 0x1d, 0x2f, 0xf5, 0xd3, 0x81, 0xad, 0xd2, 0x1b, 0xff, 0x84, 0xe0,
+	0x60, 0x4c, 0x1d, 0xe3, 0x0b, 0x89, 0x4b, 0x6e, 0xa7, 0xb9, 0xb5, 0xb7, 0x5e, 0x36, 0x5c, 0xd1,
+	0x9c, 0x12, 0x34, 0x27, 0xf1, 0xe9, 0xae, 0x34, 0xcb, 0x51, 0x6c, 0x29, 0x94, 0xd8, 0xf8, 0xd3,
+	0x0c, 0x1c, 0xed, 0xa8, 0x49, 0x71, 0xf2, 0x4b, 0xe8, 0x25, 0xd1, 0xb5, 0xab, 0xdb, 0x01, 0xa5,
+	0x98, 0x16, 0x05, 0xd3, 0x25, 0x7c, 0xb5, 0x2b, 0xd3, 0xbb, 0x31, 0xcd, 0x7f, 0xcf, 0xe0, 0x0a,
+	0xb7, 0xe4, 0x87, 0xc0, 0x25, 0x4f, 0x20, 0xd7, 0x5f, 0xc6, 0xff, 0x20, 0x78, 0xa5, 0xad, 0x18,
+	0xc5, 0xf3, 0xe9, 0x27, 0xb1, 0x8d, 0x84, 0xd6, 0x16, 0xfa, 0x85, 0x51, 0xe4, 0x6f, 0x09, 0xf2,
+	0xd7, 0xf0, 0x95, 0x7e, 0xa6, 0xd9, 0x0c, 0x38, 0x2b, 0x79, 0x11, 0xc3, 0x3f, 0x11, 0x0c, 0x6d,
+	0x91, 0x9e, 0xf8, 0x62, 0xaa, 0x69, 0x6c, 0x15, 0xdf, 0xda, 0xa5, 0x97, 0x07, 0x48, 0xb5, 0xb7,
+	0x89, 0xc5, 0x87, 0x94, 0xe3, 0x33, 0xef, 0x3f, 0x7c, 0x92, 0x43, 0x8f, 0x9e, 0xe4, 0xd0, 0xef,
+	0x4f, 0x72, 0xe8, 0xcb, 0xa7, 0xb9, 0x81, 0x47, 0x4f, 0x73, 0x03, 0xbf, 0x3e, 0xcd, 0x0d, 0xbc,
+	0x3b, 0xd7, 0xf4, 0xeb, 0x89, 0xae, 0x39, 0x41, 0x18, 0x44, 0xdd, 0xb2, 0x21, 0x79, 0x50, 0xbe,
+	0x39, 0xa9, 0xb8, 0x4c, 0x56, 0x99, 0x15, 0x38, 0xc4, 0xf8, 0x20, 0x5e, 0x91, 0xf8, 0x7d, 0xb5,
+	0xba, 0x47, 0xfc, 0xd3, 0x7a, 0xea, 0xdf, 0x01, 0x00, 0xd9, 0x4f, 0x46, 0x53, 0xe9, 0x17, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -982,6 +1173,11 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// TokenizeShareRecordReward queries the tokenize share record rewards
 	TokenizeShareRecordReward(ctx context.Context, in *QueryTokenizeShareRecordRewardRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordRewardResponse, error)
+	// DelegatorAutoRestakes queries the validators a delegator has auto-restake
+	// enabled with.
+	DelegatorAutoRestakes(ctx context.Context, in *QueryDelegatorAutoRestakesRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoRestakesResponse, error)
+	// CommissionSplit queries the commission split of a validator.
+	CommissionSplit(ctx context.Context, in *QueryCommissionSplitRequest, opts ...grpc.CallOption) (*QueryCommissionSplitResponse, error)
 }
 
 type queryClient struct {
@@ -1082,6 +1278,24 @@ func (c *queryClient) TokenizeShareRecordReward(ctx context.Context, in *QueryTo
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoRestakes(ctx context.Context, in *QueryDelegatorAutoRestakesRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoRestakesResponse, error) {
+	out := new(QueryDelegatorAutoRestakesResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/DelegatorAutoRestakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommissionSplit(ctx context.Context, in *QueryCommissionSplitRequest, opts ...grpc.CallOption) (*QueryCommissionSplitResponse, error) {
+	out := new(QueryCommissionSplitResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Query/CommissionSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1105,6 +1319,11 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// TokenizeShareRecordReward queries the tokenize share record rewards
 	TokenizeShareRecordReward(context.Context, *QueryTokenizeShareRecordRewardRequest) (*QueryTokenizeShareRecordRewardResponse, error)
+	// DelegatorAutoRestakes queries the validators a delegator has auto-restake
+	// enabled with.
+	DelegatorAutoRestakes(context.Context, *QueryDelegatorAutoRestakesRequest) (*QueryDelegatorAutoRestakesResponse, error)
+	// CommissionSplit queries the commission split of a validator.
+	CommissionSplit(context.Context, *QueryCommissionSplitRequest) (*QueryCommissionSplitResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1141,6 +1360,12 @@ func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCo
 func (*UnimplementedQueryServer) TokenizeShareRecordReward(ctx context.Context, req *QueryTokenizeShareRecordRewardRequest) (*QueryTokenizeShareRecordRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordReward not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoRestakes(ctx context.Context, req *QueryDelegatorAutoRestakesRequest) (*QueryDelegatorAutoRestakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoRestakes not implemented")
+}
+func (*UnimplementedQueryServer) CommissionSplit(ctx context.Context, req *QueryCommissionSplitRequest) (*QueryCommissionSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionSplit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1326,6 +1551,42 @@ func _Query_TokenizeShareRecordReward_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoRestakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoRestakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoRestakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/DelegatorAutoRestakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoRestakes(ctx, req.(*QueryDelegatorAutoRestakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommissionSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommissionSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommissionSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Query/CommissionSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommissionSplit(ctx, req.(*QueryCommissionSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "liquidstaking.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1370,6 +1631,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenizeShareRecordReward",
 			Handler:    _Query_TokenizeShareRecordReward_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoRestakes",
+			Handler:    _Query_DelegatorAutoRestakes_Handler,
+		},
+		{
+			MethodName: "CommissionSplit",
+			Handler:    _Query_CommissionSplit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "distribution/v1beta1/query.proto",
@@ -2002,6 +2271,131 @@ func (m *QueryTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoRestakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CommissionSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2269,6 +2663,58 @@ func (m *QueryTokenizeShareRecordRewardResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoRestakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCommissionSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommissionSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionSplit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3876,6 +4322,335 @@ func (m *QueryTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoRestakesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoRestakesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommissionSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommissionSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommissionSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommissionSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommissionSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommissionSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
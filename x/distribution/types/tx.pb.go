@@ -444,6 +444,335 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawAllTokenizeShareRecordRewardResponse proto.InternalMessageInfo
 
+// MsgClaimTokenizeShareRecordReward claims the part of a share token holder of the
+// rewards of a tokenize share record whose rewards are split
+type MsgClaimTokenizeShareRecordReward struct {
+	HolderAddress string `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty" yaml:"holder_address"`
+	RecordId      uint64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *MsgClaimTokenizeShareRecordReward) Reset()         { *m = MsgClaimTokenizeShareRecordReward{} }
+func (m *MsgClaimTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgClaimTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{10}
+}
+func (m *MsgClaimTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgClaimTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgClaimTokenizeShareRecordRewardResponse defines the Msg/ClaimTokenizeShareRecordReward response type.
+type MsgClaimTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgClaimTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgClaimTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgClaimTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgClaimTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{11}
+}
+func (m *MsgClaimTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgClaimTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgClaimTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetAutoRestake enables or disables the automatic restaking of the
+// rewards a delegator earns from a validator.
+type MsgSetAutoRestake struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoRestake) Reset()         { *m = MsgSetAutoRestake{} }
+func (m *MsgSetAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestake) ProtoMessage()    {}
+func (*MsgSetAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{12}
+}
+func (m *MsgSetAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestake.Merge(m, src)
+}
+func (m *MsgSetAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestake proto.InternalMessageInfo
+
+// MsgSetAutoRestakeResponse defines the Msg/SetAutoRestake response type.
+type MsgSetAutoRestakeResponse struct {
+}
+
+func (m *MsgSetAutoRestakeResponse) Reset()         { *m = MsgSetAutoRestakeResponse{} }
+func (m *MsgSetAutoRestakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestakeResponse) ProtoMessage()    {}
+func (*MsgSetAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{13}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestakeResponse proto.InternalMessageInfo
+
+// MsgSetTokenizeShareRecordAutoRestake enables or disables the automatic
+// restaking of the rewards of a tokenize share record's delegation
+type MsgSetTokenizeShareRecordAutoRestake struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	RecordId     uint64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Enabled      bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetTokenizeShareRecordAutoRestake) Reset()         { *m = MsgSetTokenizeShareRecordAutoRestake{} }
+func (m *MsgSetTokenizeShareRecordAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTokenizeShareRecordAutoRestake) ProtoMessage()    {}
+func (*MsgSetTokenizeShareRecordAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{14}
+}
+func (m *MsgSetTokenizeShareRecordAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTokenizeShareRecordAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTokenizeShareRecordAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTokenizeShareRecordAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTokenizeShareRecordAutoRestake.Merge(m, src)
+}
+func (m *MsgSetTokenizeShareRecordAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTokenizeShareRecordAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTokenizeShareRecordAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTokenizeShareRecordAutoRestake proto.InternalMessageInfo
+
+// MsgSetTokenizeShareRecordAutoRestakeResponse defines the Msg/SetTokenizeShareRecordAutoRestake response type.
+type MsgSetTokenizeShareRecordAutoRestakeResponse struct {
+}
+
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) Reset() {
+	*m = MsgSetTokenizeShareRecordAutoRestakeResponse{}
+}
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetTokenizeShareRecordAutoRestakeResponse) ProtoMessage() {}
+func (*MsgSetTokenizeShareRecordAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{15}
+}
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTokenizeShareRecordAutoRestakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTokenizeShareRecordAutoRestakeResponse.Merge(m, src)
+}
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTokenizeShareRecordAutoRestakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTokenizeShareRecordAutoRestakeResponse proto.InternalMessageInfo
+
+// MsgSetCommissionSplit sets the commission split of a validator. A split
+// without recipients, community pool share and auto-withdraw interval removes
+// the commission split of the validator.
+type MsgSetCommissionSplit struct {
+	ValidatorAddress     string                                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Recipients           []CommissionSplitRecipient             `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+	CommunityPoolShare   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool_share,json=communityPoolShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_share"`
+	AutoWithdrawInterval int64                                  `protobuf:"varint,4,opt,name=auto_withdraw_interval,json=autoWithdrawInterval,proto3" json:"auto_withdraw_interval,omitempty"`
+}
+
+func (m *MsgSetCommissionSplit) Reset()         { *m = MsgSetCommissionSplit{} }
+func (m *MsgSetCommissionSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionSplit) ProtoMessage()    {}
+func (*MsgSetCommissionSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{16}
+}
+func (m *MsgSetCommissionSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionSplit.Merge(m, src)
+}
+func (m *MsgSetCommissionSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionSplit proto.InternalMessageInfo
+
+// MsgSetCommissionSplitResponse defines the Msg/SetCommissionSplit response type.
+type MsgSetCommissionSplitResponse struct {
+}
+
+func (m *MsgSetCommissionSplitResponse) Reset()         { *m = MsgSetCommissionSplitResponse{} }
+func (m *MsgSetCommissionSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionSplitResponse) ProtoMessage()    {}
+func (*MsgSetCommissionSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{17}
+}
+func (m *MsgSetCommissionSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionSplitResponse.Merge(m, src)
+}
+func (m *MsgSetCommissionSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionSplitResponse proto.InternalMessageInfo
+
 // MsgFundCommunityPool allows an account to directly
 // fund the community pool.
 type MsgFundCommunityPool struct {
@@ -455,7 +784,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0452d52deb0ca76, []int{10}
+	return fileDescriptor_f0452d52deb0ca76, []int{18}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +821,7 @@ func (m *MsgFundCommunityPoolResponse) Reset()         { *m = MsgFundCommunityPo
 func (m *MsgFundCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPoolResponse) ProtoMessage()    {}
 func (*MsgFundCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f0452d52deb0ca76, []int{11}
+	return fileDescriptor_f0452d52deb0ca76, []int{19}
 }
 func (m *MsgFundCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +850,101 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the account allowed to update the params (the gov module account by default).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/distribution parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{20}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{21}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "liquidstaking.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -532,62 +956,97 @@ func init() {
 	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
 	proto.RegisterType((*MsgWithdrawAllTokenizeShareRecordReward)(nil), "liquidstaking.distribution.v1beta1.MsgWithdrawAllTokenizeShareRecordReward")
 	proto.RegisterType((*MsgWithdrawAllTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.MsgWithdrawAllTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*MsgClaimTokenizeShareRecordReward)(nil), "liquidstaking.distribution.v1beta1.MsgClaimTokenizeShareRecordReward")
+	proto.RegisterType((*MsgClaimTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.MsgClaimTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*MsgSetAutoRestake)(nil), "liquidstaking.distribution.v1beta1.MsgSetAutoRestake")
+	proto.RegisterType((*MsgSetAutoRestakeResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetAutoRestakeResponse")
+	proto.RegisterType((*MsgSetTokenizeShareRecordAutoRestake)(nil), "liquidstaking.distribution.v1beta1.MsgSetTokenizeShareRecordAutoRestake")
+	proto.RegisterType((*MsgSetTokenizeShareRecordAutoRestakeResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetTokenizeShareRecordAutoRestakeResponse")
+	proto.RegisterType((*MsgSetCommissionSplit)(nil), "liquidstaking.distribution.v1beta1.MsgSetCommissionSplit")
+	proto.RegisterType((*MsgSetCommissionSplitResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetCommissionSplitResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "liquidstaking.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "liquidstaking.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "liquidstaking.distribution.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "liquidstaking.distribution.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("distribution/v1beta1/tx.proto", fileDescriptor_f0452d52deb0ca76) }
 
 var fileDescriptor_f0452d52deb0ca76 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x58, 0x29, 0xed, 0xa8, 0xd8, 0x86, 0x68, 0xdb, 0xad, 0xdd, 0xd4, 0xa5, 0x68, 0x11,
-	0xb3, 0x6b, 0x2a, 0x88, 0x06, 0x14, 0x9b, 0xfe, 0xc0, 0x1f, 0x04, 0xca, 0x46, 0x14, 0xbc, 0x94,
-	0x4d, 0x66, 0xd8, 0x0e, 0xdd, 0xdd, 0x49, 0x77, 0x66, 0x9b, 0xc6, 0xa3, 0x17, 0x15, 0x2c, 0x8a,
-	0x7f, 0x41, 0xbd, 0x89, 0x20, 0x78, 0xf0, 0xee, 0xc1, 0x4b, 0xd1, 0x4b, 0xf1, 0xe4, 0xa9, 0x4a,
-	0x7a, 0xd0, 0xb3, 0x7f, 0x81, 0x64, 0x7f, 0x75, 0x43, 0x92, 0x26, 0x6d, 0x63, 0x4f, 0x9b, 0x9d,
-	0xf7, 0xbe, 0xef, 0x7d, 0xdf, 0xcc, 0x9b, 0x97, 0x85, 0x63, 0x88, 0x30, 0x6e, 0x93, 0x82, 0xc3,
-	0x09, 0xb5, 0x94, 0xd5, 0x74, 0x01, 0x73, 0x2d, 0xad, 0xf0, 0x35, 0xb9, 0x64, 0x53, 0x4e, 0xe3,
-	0x92, 0x41, 0x56, 0x1c, 0x82, 0x18, 0xd7, 0x96, 0x89, 0xa5, 0xcb, 0xd1, 0x64, 0xd9, 0x4f, 0x16,
-	0x12, 0x3a, 0xd5, 0xa9, 0x9b, 0xae, 0xd4, 0x7e, 0x79, 0x48, 0x41, 0x2c, 0x52, 0x66, 0x52, 0xa6,
-	0x14, 0x34, 0x86, 0x43, 0xde, 0x22, 0x25, 0x96, 0x1f, 0x1f, 0xf1, 0xe2, 0x8b, 0x1e, 0xd0, 0x7b,
-	0xf1, 0x43, 0x43, 0x3e, 0xd4, 0x64, 0xba, 0xb2, 0x9a, 0xae, 0x3d, 0xbc, 0x80, 0xf4, 0x05, 0xc0,
-	0x33, 0x39, 0xa6, 0xe7, 0x31, 0x7f, 0x44, 0xf8, 0x12, 0xb2, 0xb5, 0xf2, 0x34, 0x42, 0x36, 0x66,
-	0x2c, 0x3e, 0x07, 0x07, 0x11, 0x36, 0xb0, 0xae, 0x71, 0x6a, 0x2f, 0x6a, 0xde, 0xe2, 0x30, 0x18,
-	0x07, 0x93, 0xfd, 0xd9, 0xe1, 0xef, 0x9f, 0x52, 0x09, 0x9f, 0xdf, 0x4f, 0xcf, 0x73, 0x9b, 0x58,
-	0xba, 0x3a, 0x10, 0x42, 0x02, 0x9a, 0x19, 0x38, 0x50, 0xf6, 0x99, 0x43, 0x96, 0x63, 0x6d, 0x58,
-	0x4e, 0x97, 0xeb, 0xb5, 0x64, 0xc4, 0xe7, 0x1b, 0xc9, 0xd8, 0x9f, 0x8d, 0x64, 0xec, 0xe9, 0xef,
-	0x8f, 0x97, 0x1a, 0x65, 0x49, 0x49, 0x38, 0xd6, 0xd4, 0x84, 0x8a, 0x59, 0x89, 0x5a, 0x0c, 0x4b,
-	0x5f, 0x01, 0x14, 0x72, 0x4c, 0x0f, 0xc2, 0xb3, 0x01, 0x83, 0x8a, 0xcb, 0x9a, 0x8d, 0xba, 0xe5,
-	0x75, 0x0e, 0x0e, 0xae, 0x6a, 0x06, 0x41, 0x75, 0x34, 0xed, 0xcc, 0x0e, 0x84, 0x90, 0x4e, 0xdd,
-	0xbe, 0x00, 0x50, 0x6a, 0x6d, 0x26, 0xf0, 0x1c, 0x2f, 0xc2, 0x5e, 0xcd, 0xa4, 0x8e, 0xc5, 0x87,
-	0xc1, 0x78, 0xcf, 0xe4, 0x89, 0xa9, 0x11, 0xd9, 0xaf, 0x5f, 0xeb, 0x9f, 0xa0, 0xd5, 0xe4, 0x19,
-	0x4a, 0xac, 0xec, 0x95, 0xcd, 0xed, 0x64, 0xec, 0xfd, 0xcf, 0xe4, 0xa4, 0x4e, 0xf8, 0x92, 0x53,
-	0x90, 0x8b, 0xd4, 0xf4, 0xfb, 0xc7, 0x7f, 0xa4, 0x18, 0x5a, 0x56, 0x78, 0xa5, 0x84, 0x99, 0x0b,
-	0x60, 0xaa, 0x4f, 0x2d, 0x3d, 0x03, 0x50, 0x8c, 0x68, 0x79, 0x18, 0x78, 0x99, 0xa1, 0xa6, 0x49,
-	0x18, 0x23, 0xd4, 0x6a, 0xbe, 0x2b, 0xe0, 0x90, 0xbb, 0xd2, 0xc0, 0x28, 0xad, 0x03, 0x78, 0x61,
-	0x6f, 0x25, 0x47, 0xbb, 0x33, 0x2f, 0x01, 0x9c, 0x88, 0xe8, 0x79, 0x40, 0x97, 0xb1, 0x45, 0x9e,
-	0xe0, 0xfc, 0x92, 0x66, 0x63, 0x15, 0x17, 0x69, 0xed, 0xa4, 0xdc, 0xe6, 0xbb, 0x09, 0x4f, 0xd1,
-	0xb2, 0x85, 0x1b, 0xf6, 0xe6, 0xef, 0x76, 0x32, 0x51, 0xd1, 0x4c, 0x23, 0x23, 0xd5, 0x85, 0x25,
-	0xf5, 0xa4, 0xfb, 0x1e, 0x34, 0xdd, 0x28, 0xec, 0xb7, 0x5d, 0xba, 0x45, 0x82, 0xdc, 0x66, 0x3b,
-	0xae, 0xf6, 0x79, 0x0b, 0x77, 0x51, 0xa6, 0x2f, 0xd8, 0x34, 0x49, 0x86, 0x97, 0x3b, 0x51, 0x13,
-	0xde, 0x18, 0x1b, 0x5e, 0x8c, 0xe4, 0x4f, 0x1b, 0xc6, 0xff, 0x32, 0x10, 0xd1, 0x98, 0x86, 0x4a,
-	0x87, 0x35, 0x43, 0x99, 0xdf, 0x00, 0x4c, 0xe4, 0x98, 0x3e, 0xef, 0x58, 0xa8, 0x76, 0xd0, 0x8e,
-	0x45, 0x78, 0x65, 0x81, 0x52, 0xe3, 0x48, 0xce, 0x38, 0x7e, 0x0d, 0xf6, 0x23, 0x5c, 0xa2, 0x8c,
-	0x70, 0x6a, 0xb7, 0xbd, 0xe8, 0xbb, 0xa9, 0x99, 0xb3, 0xd1, 0x5e, 0xde, 0x5d, 0x97, 0x44, 0x78,
-	0xae, 0x99, 0x99, 0xc0, 0xed, 0xd4, 0x7a, 0x1f, 0xec, 0xc9, 0x31, 0x3d, 0xfe, 0x06, 0xc0, 0x78,
-	0x93, 0x91, 0x7d, 0x43, 0x6e, 0xff, 0xdf, 0x22, 0x37, 0x1d, 0x94, 0xc2, 0xf4, 0x81, 0xa1, 0xe1,
-	0xad, 0x7a, 0x0b, 0xe0, 0x50, 0xab, 0x01, 0x7b, 0xab, 0x43, 0xfa, 0x16, 0x78, 0x61, 0xfe, 0x70,
-	0xf8, 0x50, 0xe3, 0x07, 0x00, 0x47, 0xf7, 0x9a, 0x55, 0xd9, 0x7d, 0xd6, 0x69, 0xc2, 0x21, 0xdc,
-	0x3b, 0x3c, 0x47, 0xa8, 0xf7, 0x33, 0x80, 0xe7, 0xdb, 0x4f, 0x90, 0x3b, 0xfb, 0xac, 0xd8, 0x92,
-	0x49, 0x58, 0xe8, 0x16, 0x53, 0xe8, 0x60, 0x13, 0xc0, 0x89, 0x8e, 0xa6, 0xc8, 0xfd, 0x7d, 0x96,
-	0xde, 0x8b, 0x4c, 0xc8, 0x77, 0x91, 0x2c, 0xb4, 0xf2, 0x0a, 0xc0, 0xc1, 0xc6, 0x41, 0x73, 0xbd,
-	0xc3, 0x52, 0x0d, 0x48, 0xe1, 0xf6, 0x41, 0x91, 0x81, 0xa2, 0x6c, 0xe1, 0x5d, 0x55, 0x04, 0x9b,
-	0x55, 0x11, 0x6c, 0x55, 0x45, 0xf0, 0xab, 0x2a, 0x82, 0xd7, 0x3b, 0x62, 0x6c, 0x6b, 0x47, 0x8c,
-	0xfd, 0xd8, 0x11, 0x63, 0x8f, 0x67, 0x23, 0xf3, 0x8c, 0xac, 0x18, 0x4e, 0xad, 0xb3, 0x88, 0x55,
-	0x54, 0xbc, 0xaa, 0x84, 0x57, 0x52, 0x7e, 0xe5, 0x94, 0x49, 0x91, 0x63, 0x60, 0x65, 0x4d, 0xa9,
-	0xfb, 0x74, 0x75, 0x27, 0x5e, 0xa1, 0xd7, 0xfd, 0x50, 0xbc, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0x7e, 0x76, 0x14, 0x5b, 0xd7, 0x0a, 0x00, 0x00,
+	// 1166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0xde, 0xf9, 0xa5, 0xea, 0x2f, 0x79, 0xdb, 0xa6, 0x89, 0xb5, 0x6d, 0x36, 0x0e, 0xf5, 0xa6,
+	0x56, 0xd5, 0x86, 0x8a, 0xec, 0x92, 0x14, 0x10, 0x84, 0x16, 0x35, 0x9b, 0xa4, 0x4a, 0x41, 0x2b,
+	0x45, 0x5e, 0x3e, 0x24, 0x2e, 0x2b, 0xef, 0x7a, 0xe4, 0x8c, 0x62, 0x7b, 0xb6, 0x9e, 0xd9, 0xa4,
+	0xe1, 0x84, 0x38, 0x40, 0x91, 0x8a, 0xa8, 0xf8, 0x0b, 0x8a, 0x90, 0x10, 0x20, 0x21, 0x71, 0xa8,
+	0xb8, 0x72, 0xe0, 0x12, 0xc1, 0xa5, 0xea, 0x09, 0x81, 0x14, 0x50, 0x72, 0x80, 0x73, 0xff, 0x02,
+	0xe4, 0xaf, 0x89, 0xf7, 0x23, 0x6b, 0x27, 0xd9, 0x46, 0x9c, 0x12, 0x7b, 0xde, 0xe7, 0x79, 0x9f,
+	0xe7, 0xf5, 0x3b, 0x33, 0xaf, 0x16, 0x2e, 0x18, 0x84, 0x71, 0x97, 0xd4, 0x9a, 0x9c, 0x50, 0xa7,
+	0xb8, 0x3e, 0x53, 0xc3, 0x5c, 0x9f, 0x29, 0xf2, 0xbb, 0x85, 0x86, 0x4b, 0x39, 0x95, 0x54, 0x8b,
+	0xdc, 0x69, 0x12, 0x83, 0x71, 0x7d, 0x8d, 0x38, 0x66, 0x21, 0x1e, 0x5c, 0x08, 0x83, 0xe5, 0xac,
+	0x49, 0x4d, 0xea, 0x87, 0x17, 0xbd, 0xff, 0x02, 0xa4, 0xac, 0xd4, 0x29, 0xb3, 0x29, 0x2b, 0xd6,
+	0x74, 0x86, 0x05, 0x6f, 0x9d, 0x12, 0x27, 0x5c, 0x1f, 0x0f, 0xd6, 0xab, 0x01, 0x30, 0x78, 0x08,
+	0x97, 0xc6, 0x42, 0xa8, 0xcd, 0xcc, 0xe2, 0xfa, 0x8c, 0xf7, 0x27, 0x5c, 0xb8, 0xd2, 0x55, 0x6c,
+	0x8b, 0x28, 0x3f, 0x50, 0xfd, 0x19, 0xc1, 0xb9, 0x32, 0x33, 0x2b, 0x98, 0xbf, 0x47, 0xf8, 0xaa,
+	0xe1, 0xea, 0x1b, 0xf3, 0x86, 0xe1, 0x62, 0xc6, 0xa4, 0x25, 0x18, 0x35, 0xb0, 0x85, 0x4d, 0x9d,
+	0x53, 0xb7, 0xaa, 0x07, 0x2f, 0x73, 0x68, 0x12, 0x4d, 0x0d, 0x95, 0x72, 0x4f, 0x1e, 0x4d, 0x67,
+	0x43, 0x21, 0x61, 0x78, 0x85, 0xbb, 0xc4, 0x31, 0xb5, 0x11, 0x01, 0x89, 0x68, 0x16, 0x60, 0x64,
+	0x23, 0x64, 0x16, 0x2c, 0xff, 0x4b, 0x60, 0x39, 0xbb, 0xd1, 0xaa, 0x65, 0x4e, 0xb9, 0xf7, 0x30,
+	0x9f, 0xf9, 0xe7, 0x61, 0x3e, 0xf3, 0xd1, 0xdf, 0x3f, 0x5c, 0xed, 0x94, 0xa5, 0xe6, 0xe1, 0x42,
+	0x57, 0x13, 0x1a, 0x66, 0x0d, 0xea, 0x30, 0xac, 0xfe, 0x82, 0x40, 0x2e, 0x33, 0x33, 0x5a, 0x5e,
+	0x8c, 0x18, 0x34, 0xbc, 0xa1, 0xbb, 0x46, 0xbf, 0xbc, 0x2e, 0xc1, 0xe8, 0xba, 0x6e, 0x11, 0xa3,
+	0x85, 0x26, 0xc9, 0xec, 0x88, 0x80, 0xa4, 0x75, 0xfb, 0x29, 0x02, 0x75, 0x7f, 0x33, 0x91, 0x67,
+	0xa9, 0x0e, 0x27, 0x75, 0x9b, 0x36, 0x1d, 0x9e, 0x43, 0x93, 0x03, 0x53, 0xa7, 0x66, 0xc7, 0x0b,
+	0x61, 0x7e, 0xaf, 0xd1, 0xa2, 0x9e, 0x2c, 0x2c, 0x50, 0xe2, 0x94, 0x5e, 0xdc, 0xda, 0xce, 0x67,
+	0xbe, 0xfb, 0x33, 0x3f, 0x65, 0x12, 0xbe, 0xda, 0xac, 0x15, 0xea, 0xd4, 0x0e, 0x1b, 0x2d, 0xfc,
+	0x33, 0xcd, 0x8c, 0xb5, 0x22, 0xdf, 0x6c, 0x60, 0xe6, 0x03, 0x98, 0x16, 0x52, 0xab, 0x9f, 0x20,
+	0x50, 0x62, 0x5a, 0xde, 0x8d, 0xbc, 0x2c, 0x50, 0xdb, 0x26, 0x8c, 0x11, 0xea, 0x74, 0xaf, 0x0a,
+	0x3a, 0x62, 0x55, 0x3a, 0x18, 0xd5, 0xcf, 0x10, 0x5c, 0xee, 0xad, 0xe4, 0x78, 0x2b, 0x73, 0x1f,
+	0xc1, 0xa5, 0x98, 0x9e, 0xb7, 0xe9, 0x1a, 0x76, 0xc8, 0x07, 0xb8, 0xb2, 0xaa, 0xbb, 0x58, 0xc3,
+	0x75, 0xea, 0x1a, 0xc1, 0xf7, 0x92, 0x6e, 0xc0, 0x19, 0xba, 0xe1, 0xe0, 0x8e, 0xda, 0x3c, 0xdd,
+	0xce, 0x67, 0x37, 0x75, 0xdb, 0x9a, 0x53, 0x5b, 0x96, 0x55, 0xed, 0xb4, 0xff, 0x1c, 0x35, 0xdd,
+	0x04, 0x0c, 0xb9, 0x3e, 0x5d, 0x95, 0x18, 0x7e, 0xb3, 0x9d, 0xd0, 0x06, 0x83, 0x17, 0xb7, 0x8d,
+	0xb9, 0xc1, 0xa8, 0x68, 0x6a, 0x01, 0x5e, 0x48, 0xa3, 0x46, 0xec, 0x18, 0x17, 0xae, 0xc4, 0xe2,
+	0xe7, 0x2d, 0xeb, 0x59, 0x19, 0x88, 0x69, 0x9c, 0x81, 0x62, 0xca, 0x9c, 0x42, 0xe6, 0x7d, 0x04,
+	0x17, 0xcb, 0xcc, 0x5c, 0xb0, 0x74, 0x62, 0xef, 0xaf, 0xf0, 0x26, 0x0c, 0xaf, 0x52, 0xcb, 0xe8,
+	0x90, 0x38, 0xfe, 0x74, 0x3b, 0x7f, 0x2e, 0x90, 0xd8, 0xba, 0xae, 0x6a, 0x67, 0x82, 0x17, 0x07,
+	0xac, 0xf2, 0x03, 0x04, 0xcf, 0x27, 0xca, 0x39, 0xde, 0x3e, 0xfc, 0x03, 0xc1, 0x68, 0x70, 0x38,
+	0xce, 0x37, 0x39, 0xd5, 0xb0, 0x77, 0x43, 0xe1, 0xff, 0xd6, 0x89, 0x27, 0xe5, 0xe0, 0xff, 0xd8,
+	0xd1, 0x6b, 0x16, 0x36, 0x72, 0x03, 0x93, 0x68, 0x6a, 0x50, 0x8b, 0x1e, 0x13, 0xcf, 0xc2, 0x09,
+	0x18, 0xef, 0x30, 0x27, 0x9a, 0xe3, 0xeb, 0x60, 0x0b, 0x56, 0x30, 0xef, 0xf2, 0x2d, 0xe2, 0xd5,
+	0x78, 0x86, 0x5b, 0xb0, 0x87, 0xb7, 0xf6, 0xcd, 0x99, 0xa8, 0x53, 0x18, 0xbb, 0x37, 0x10, 0xdd,
+	0xda, 0x7b, 0xa7, 0x5b, 0xa5, 0x61, 0x11, 0xde, 0xa7, 0xc3, 0x56, 0xaa, 0x01, 0xb8, 0xb8, 0x4e,
+	0x1a, 0x04, 0x3b, 0xdc, 0xfb, 0xa0, 0x5e, 0x77, 0x5e, 0x2f, 0x24, 0x8f, 0x38, 0x85, 0x36, 0x3d,
+	0x5a, 0x44, 0x52, 0x3a, 0xe1, 0x35, 0xb0, 0x16, 0x63, 0x95, 0x1c, 0xc8, 0xd6, 0xa9, 0x6d, 0x37,
+	0x1d, 0xc2, 0x37, 0xab, 0x0d, 0x4a, 0xad, 0x2a, 0xf3, 0x5c, 0xfb, 0x55, 0x1a, 0x2a, 0x5d, 0xf7,
+	0xe2, 0x7f, 0xdf, 0xce, 0x5f, 0x4e, 0xd1, 0xf0, 0x8b, 0xb8, 0xfe, 0xe4, 0xd1, 0x34, 0x84, 0xde,
+	0x16, 0x71, 0x5d, 0x93, 0x04, 0xf3, 0x0a, 0xa5, 0x96, 0x5f, 0x4d, 0xe9, 0x25, 0x38, 0xaf, 0x37,
+	0x39, 0xad, 0x8a, 0x71, 0x84, 0x38, 0x1c, 0xbb, 0xeb, 0xba, 0x95, 0x3b, 0x31, 0x89, 0xa6, 0x06,
+	0xb4, 0xac, 0xb7, 0x1a, 0x1d, 0x3e, 0xb7, 0xc3, 0xb5, 0xc4, 0x6b, 0x47, 0x8c, 0x1e, 0x1d, 0xce,
+	0xc3, 0x6f, 0xf5, 0x2b, 0x82, 0x6c, 0x99, 0x99, 0xb7, 0x9a, 0x8e, 0xb1, 0x10, 0x17, 0x75, 0x2c,
+	0xbb, 0x5f, 0x7a, 0x05, 0x86, 0x0c, 0xdc, 0xa0, 0x8c, 0x70, 0xea, 0x26, 0x6e, 0xcc, 0xbd, 0xd0,
+	0xb9, 0xf3, 0x71, 0xdb, 0x7b, 0xef, 0x55, 0x05, 0x9e, 0xeb, 0x66, 0x46, 0xb8, 0xfd, 0x0a, 0xc1,
+	0xd9, 0x32, 0x33, 0xdf, 0x69, 0x18, 0x3a, 0xc7, 0x2b, 0xba, 0xab, 0xdb, 0xcc, 0xd3, 0xa0, 0x37,
+	0xf9, 0x2a, 0x75, 0x09, 0xdf, 0x4c, 0xec, 0xc5, 0xbd, 0x50, 0x69, 0x19, 0x4e, 0x36, 0x7c, 0x06,
+	0x5f, 0xf8, 0xa9, 0xd9, 0xab, 0x69, 0x1a, 0x30, 0xc8, 0x19, 0xb6, 0x5b, 0x88, 0x9f, 0x1b, 0xf6,
+	0x5d, 0x08, 0x66, 0x75, 0x1c, 0xc6, 0xda, 0x44, 0x46, 0x06, 0x66, 0xbf, 0x1d, 0x86, 0x81, 0x32,
+	0x33, 0xa5, 0x2f, 0x10, 0x48, 0x5d, 0xa6, 0xe2, 0xd7, 0xd2, 0x68, 0xe8, 0x3a, 0x8b, 0xca, 0xf3,
+	0x87, 0x86, 0x8a, 0x0b, 0xe3, 0x4b, 0x04, 0x63, 0xfb, 0xcd, 0xb0, 0x6f, 0xa4, 0xa4, 0xdf, 0x07,
+	0x2f, 0xdf, 0x3a, 0x1a, 0x5e, 0x68, 0xfc, 0x1e, 0xc1, 0x44, 0xaf, 0x71, 0xb0, 0x74, 0xc0, 0x3c,
+	0x5d, 0x38, 0xe4, 0x37, 0x8f, 0xce, 0x21, 0xf4, 0xfe, 0x84, 0xe0, 0x62, 0xf2, 0x90, 0xb6, 0x7c,
+	0xc0, 0x8c, 0xfb, 0x32, 0xc9, 0x2b, 0xfd, 0x62, 0x12, 0x0e, 0xb6, 0x10, 0x5c, 0x4a, 0x35, 0xa8,
+	0xbd, 0x75, 0xc0, 0xd4, 0xbd, 0xc8, 0xe4, 0x4a, 0x1f, 0xc9, 0x84, 0x95, 0x1f, 0x11, 0x28, 0x09,
+	0xb3, 0xdc, 0x52, 0xca, 0xbc, 0xbd, 0x69, 0xe4, 0x72, 0x5f, 0x68, 0x84, 0xf0, 0x8f, 0x11, 0x0c,
+	0xb7, 0x8d, 0x58, 0x2f, 0xa7, 0xdf, 0xef, 0x31, 0x98, 0x7c, 0xe3, 0x50, 0xb0, 0x96, 0x76, 0x4e,
+	0x1e, 0x78, 0x96, 0xd3, 0x27, 0xe9, 0xcd, 0x24, 0xaf, 0xf4, 0x8b, 0x49, 0x38, 0x08, 0x4f, 0xde,
+	0xf6, 0xc9, 0xe6, 0x00, 0x27, 0x6f, 0x1b, 0x54, 0x9e, 0x3f, 0x34, 0x54, 0x88, 0xfa, 0x1c, 0xc1,
+	0x68, 0xe7, 0x15, 0xfe, 0x6a, 0x4a, 0xe2, 0x0e, 0xa4, 0x7c, 0xf3, 0xb0, 0x48, 0xa1, 0xe8, 0x43,
+	0x04, 0xa7, 0x5b, 0xae, 0xd9, 0x6b, 0x29, 0x29, 0xe3, 0x20, 0xf9, 0xf5, 0x43, 0x80, 0x22, 0x09,
+	0xa5, 0xda, 0x37, 0x3b, 0x0a, 0xda, 0xda, 0x51, 0xd0, 0xe3, 0x1d, 0x05, 0xfd, 0xb5, 0xa3, 0xa0,
+	0x07, 0xbb, 0x4a, 0xe6, 0xf1, 0xae, 0x92, 0xf9, 0x6d, 0x57, 0xc9, 0xbc, 0xbf, 0x18, 0x1b, 0x56,
+	0xc8, 0x1d, 0xab, 0xe9, 0x15, 0x95, 0x38, 0xf5, 0x62, 0x90, 0x90, 0xf0, 0xcd, 0xe9, 0x30, 0xe9,
+	0xb4, 0x4d, 0x8d, 0xa6, 0x85, 0x8b, 0x77, 0x5b, 0x7e, 0xa0, 0x0a, 0xc6, 0x99, 0xda, 0x49, 0xff,
+	0x77, 0xaa, 0x6b, 0xff, 0x0e, 0x00, 0xff, 0x49, 0xde, 0xe6, 0x7f, 0x13, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -711,14 +1170,14 @@ func (this *MsgWithdrawAllTokenizeShareRecordRewardResponse) Equal(that interfac
 	}
 	return true
 }
-func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
+func (this *MsgClaimTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgFundCommunityPoolResponse)
+	that1, ok := that.(*MsgClaimTokenizeShareRecordRewardResponse)
 	if !ok {
-		that2, ok := that.(MsgFundCommunityPoolResponse)
+		that2, ok := that.(MsgClaimTokenizeShareRecordRewardResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -730,12 +1189,152 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
 	return true
 }
+func (this *MsgSetAutoRestakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+	that1, ok := that.(*MsgSetAutoRestakeResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoRestakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSetTokenizeShareRecordAutoRestakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetTokenizeShareRecordAutoRestakeResponse)
+	if !ok {
+		that2, ok := that.(MsgSetTokenizeShareRecordAutoRestakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSetCommissionSplitResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetCommissionSplitResponse)
+	if !ok {
+		that2, ok := that.(MsgSetCommissionSplitResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFundCommunityPoolResponse)
+	if !ok {
+		that2, ok := that.(MsgFundCommunityPoolResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
@@ -758,9 +1357,26 @@ type MsgClient interface {
 	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 	// WithdrawAllTokenizeShareRecordReward defines a method to withdraw reward for all owning TokenizeShareRecord
 	WithdrawAllTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawAllTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawAllTokenizeShareRecordRewardResponse, error)
+	// ClaimTokenizeShareRecordReward defines a method for a share token holder to claim
+	// its part of the rewards of a tokenize share record whose rewards are split.
+	ClaimTokenizeShareRecordReward(ctx context.Context, in *MsgClaimTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgClaimTokenizeShareRecordRewardResponse, error)
+	// SetAutoRestake defines a method to enable or disable the automatic
+	// restaking of the rewards of a delegation.
+	SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error)
+	// SetTokenizeShareRecordAutoRestake defines a method for the owner of a
+	// tokenize share record to enable or disable the automatic restaking of the
+	// rewards of the record's delegation.
+	SetTokenizeShareRecordAutoRestake(ctx context.Context, in *MsgSetTokenizeShareRecordAutoRestake, opts ...grpc.CallOption) (*MsgSetTokenizeShareRecordAutoRestakeResponse, error)
+	// SetCommissionSplit defines a method for a validator to set how its
+	// commission is split when it is withdrawn, and how often it is withdrawn
+	// automatically.
+	SetCommissionSplit(ctx context.Context, in *MsgSetCommissionSplit, opts ...grpc.CallOption) (*MsgSetCommissionSplitResponse, error)
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// UpdateParams defines a governance operation for updating the x/distribution
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -816,6 +1432,42 @@ func (c *msgClient) WithdrawAllTokenizeShareRecordReward(ctx context.Context, in
 	return out, nil
 }
 
+func (c *msgClient) ClaimTokenizeShareRecordReward(ctx context.Context, in *MsgClaimTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgClaimTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgClaimTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/ClaimTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error) {
+	out := new(MsgSetAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/SetAutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTokenizeShareRecordAutoRestake(ctx context.Context, in *MsgSetTokenizeShareRecordAutoRestake, opts ...grpc.CallOption) (*MsgSetTokenizeShareRecordAutoRestakeResponse, error) {
+	out := new(MsgSetTokenizeShareRecordAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/SetTokenizeShareRecordAutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCommissionSplit(ctx context.Context, in *MsgSetCommissionSplit, opts ...grpc.CallOption) (*MsgSetCommissionSplitResponse, error) {
+	out := new(MsgSetCommissionSplitResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/SetCommissionSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error) {
 	out := new(MsgFundCommunityPoolResponse)
 	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/FundCommunityPool", in, out, opts...)
@@ -825,6 +1477,15 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityP
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -840,9 +1501,26 @@ type MsgServer interface {
 	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 	// WithdrawAllTokenizeShareRecordReward defines a method to withdraw reward for all owning TokenizeShareRecord
 	WithdrawAllTokenizeShareRecordReward(context.Context, *MsgWithdrawAllTokenizeShareRecordReward) (*MsgWithdrawAllTokenizeShareRecordRewardResponse, error)
+	// ClaimTokenizeShareRecordReward defines a method for a share token holder to claim
+	// its part of the rewards of a tokenize share record whose rewards are split.
+	ClaimTokenizeShareRecordReward(context.Context, *MsgClaimTokenizeShareRecordReward) (*MsgClaimTokenizeShareRecordRewardResponse, error)
+	// SetAutoRestake defines a method to enable or disable the automatic
+	// restaking of the rewards of a delegation.
+	SetAutoRestake(context.Context, *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error)
+	// SetTokenizeShareRecordAutoRestake defines a method for the owner of a
+	// tokenize share record to enable or disable the automatic restaking of the
+	// rewards of the record's delegation.
+	SetTokenizeShareRecordAutoRestake(context.Context, *MsgSetTokenizeShareRecordAutoRestake) (*MsgSetTokenizeShareRecordAutoRestakeResponse, error)
+	// SetCommissionSplit defines a method for a validator to set how its
+	// commission is split when it is withdrawn, and how often it is withdrawn
+	// automatically.
+	SetCommissionSplit(context.Context, *MsgSetCommissionSplit) (*MsgSetCommissionSplitResponse, error)
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// UpdateParams defines a governance operation for updating the x/distribution
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -864,9 +1542,24 @@ func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Con
 func (*UnimplementedMsgServer) WithdrawAllTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawAllTokenizeShareRecordReward) (*MsgWithdrawAllTokenizeShareRecordRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllTokenizeShareRecordReward not implemented")
 }
+func (*UnimplementedMsgServer) ClaimTokenizeShareRecordReward(ctx context.Context, req *MsgClaimTokenizeShareRecordReward) (*MsgClaimTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTokenizeShareRecordReward not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoRestake(ctx context.Context, req *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRestake not implemented")
+}
+func (*UnimplementedMsgServer) SetTokenizeShareRecordAutoRestake(ctx context.Context, req *MsgSetTokenizeShareRecordAutoRestake) (*MsgSetTokenizeShareRecordAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTokenizeShareRecordAutoRestake not implemented")
+}
+func (*UnimplementedMsgServer) SetCommissionSplit(ctx context.Context, req *MsgSetCommissionSplit) (*MsgSetCommissionSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommissionSplit not implemented")
+}
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -962,6 +1655,78 @@ func _Msg_WithdrawAllTokenizeShareRecordReward_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/ClaimTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimTokenizeShareRecordReward(ctx, req.(*MsgClaimTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRestake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/SetAutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRestake(ctx, req.(*MsgSetAutoRestake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTokenizeShareRecordAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTokenizeShareRecordAutoRestake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTokenizeShareRecordAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/SetTokenizeShareRecordAutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTokenizeShareRecordAutoRestake(ctx, req.(*MsgSetTokenizeShareRecordAutoRestake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCommissionSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCommissionSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCommissionSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/SetCommissionSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCommissionSplit(ctx, req.(*MsgSetCommissionSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgFundCommunityPool)
 	if err := dec(in); err != nil {
@@ -980,6 +1745,24 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "liquidstaking.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1004,10 +1787,30 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawAllTokenizeShareRecordReward",
 			Handler:    _Msg_WithdrawAllTokenizeShareRecordReward_Handler,
 		},
+		{
+			MethodName: "ClaimTokenizeShareRecordReward",
+			Handler:    _Msg_ClaimTokenizeShareRecordReward_Handler,
+		},
+		{
+			MethodName: "SetAutoRestake",
+			Handler:    _Msg_SetAutoRestake_Handler,
+		},
+		{
+			MethodName: "SetTokenizeShareRecordAutoRestake",
+			Handler:    _Msg_SetTokenizeShareRecordAutoRestake_Handler,
+		},
+		{
+			MethodName: "SetCommissionSplit",
+			Handler:    _Msg_SetCommissionSplit_Handler,
+		},
 		{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "distribution/v1beta1/tx.proto",
@@ -1325,7 +2128,7 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(d
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1335,41 +2138,32 @@ func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+	if m.RecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.HolderAddress) > 0 {
+		i -= len(m.HolderAddress)
+		copy(dAtA[i:], m.HolderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HolderAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1379,166 +2173,468 @@ func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFundCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func (m *MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgSetAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSetAutoRestakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawValidatorCommission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetAutoRestakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAutoRestakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTokenizeShareRecordAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTokenizeShareRecordAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTokenizeShareRecordAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.RecordId != 0 {
-		n += 1 + sovTx(uint64(m.RecordId))
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawAllTokenizeShareRecordReward) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetCommissionSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommissionSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AutoWithdrawInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AutoWithdrawInterval))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	{
+		size := m.CommunityPoolShare.Size()
+		i -= size
+		if _, err := m.CommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetCommissionSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommissionSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundCommunityPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawValidatorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1548,29 +2644,915 @@ func (m *MsgFundCommunityPool) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawAllTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HolderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgClaimTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoRestakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTokenizeShareRecordAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCommissionSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.CommunityPoolShare.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AutoWithdrawInterval != 0 {
+		n += 1 + sovTx(uint64(m.AutoWithdrawInterval))
+	}
+	return n
+}
+
+func (m *MsgSetCommissionSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgFundCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1593,47 +3575,15 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1661,7 +3611,7 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1684,7 +3634,7 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1707,10 +3657,10 @@ func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1734,7 +3684,7 @@ func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1757,15 +3707,15 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTokenizeShareRecordReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1793,13 +3743,82 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.HolderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1809,23 +3828,25 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1848,7 +3869,7 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRestake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1871,17 +3892,17 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoRestake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1891,26 +3912,126 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRestakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1932,7 +4053,7 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTokenizeShareRecordAutoRestake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1955,15 +4076,15 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTokenizeShareRecordAutoRestake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTokenizeShareRecordAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1991,8 +4112,47 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2014,7 +4174,7 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTokenizeShareRecordAutoRestakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2037,46 +4197,12 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTokenizeShareRecordAutoRestakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTokenizeShareRecordAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2098,7 +4224,7 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCommissionSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2121,15 +4247,15 @@ func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCommissionSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCommissionSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2157,13 +4283,81 @@ func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionSplitRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoWithdrawInterval", wireType)
 			}
-			m.RecordId = 0
+			m.AutoWithdrawInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2173,7 +4367,7 @@ func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordId |= uint64(b&0x7F) << shift
+				m.AutoWithdrawInterval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2199,7 +4393,7 @@ func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCommissionSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,10 +4416,10 @@ func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCommissionSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCommissionSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2249,7 +4443,7 @@ func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2272,15 +4466,49 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2308,7 +4536,7 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2331,7 +4559,7 @@ func (m *MsgWithdrawAllTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2354,10 +4582,10 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawAllTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2381,7 +4609,7 @@ func (m *MsgWithdrawAllTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2404,17 +4632,17 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2424,31 +4652,29 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2458,23 +4684,24 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2497,7 +4724,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2520,10 +4747,10 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
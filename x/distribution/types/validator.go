@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // create a new ValidatorHistoricalRewards
@@ -46,3 +47,61 @@ func (vs ValidatorSlashEvents) String() string {
 	}
 	return strings.TrimSpace(out)
 }
+
+// MaxCommissionSplitRecipients is the maximum number of recipients of a commission split
+const MaxCommissionSplitRecipients = 10
+
+// create a new CommissionSplit
+func NewCommissionSplit(valAddr sdk.ValAddress, recipients []CommissionSplitRecipient, communityPoolShare sdk.Dec, autoWithdrawInterval int64) CommissionSplit {
+	return CommissionSplit{
+		ValidatorAddress:     valAddr.String(),
+		Recipients:           recipients,
+		CommunityPoolShare:   communityPoolShare,
+		AutoWithdrawInterval: autoWithdrawInterval,
+	}
+}
+
+// IsEmpty returns true if the commission split sends the whole commission to the operator
+// and never withdraws it automatically
+func (cs CommissionSplit) IsEmpty() bool {
+	return len(cs.Recipients) == 0 && (cs.CommunityPoolShare.IsNil() || cs.CommunityPoolShare.IsZero()) &&
+		cs.AutoWithdrawInterval == 0
+}
+
+// Validate checks that the recipients are distinct and that the weights of the recipients and the
+// community pool share add up to at most one
+func (cs CommissionSplit) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(cs.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if len(cs.Recipients) > MaxCommissionSplitRecipients {
+		return ErrInvalidCommissionSplit.Wrapf("more than %d recipients", MaxCommissionSplitRecipients)
+	}
+	if cs.CommunityPoolShare.IsNil() || cs.CommunityPoolShare.IsNegative() {
+		return ErrInvalidCommissionSplit.Wrap("community pool share must not be negative")
+	}
+	if cs.AutoWithdrawInterval < 0 {
+		return ErrInvalidCommissionSplit.Wrap("auto-withdraw interval must not be negative")
+	}
+
+	total := cs.CommunityPoolShare
+	seen := make(map[string]bool, len(cs.Recipients))
+	for _, recipient := range cs.Recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		}
+		if seen[recipient.Address] {
+			return ErrInvalidCommissionSplit.Wrapf("duplicate recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return ErrInvalidCommissionSplit.Wrapf("weight of recipient %s must be positive", recipient.Address)
+		}
+		total = total.Add(recipient.Weight)
+	}
+	if total.GT(sdk.OneDec()) {
+		return ErrInvalidCommissionSplit.Wrapf("weights and community pool share add up to %s, more than one", total)
+	}
+	return nil
+}
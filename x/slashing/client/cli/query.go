@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQuerySlashRecords(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +109,49 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQuerySlashRecords implements the command to query the slash history of a validator.
+func GetCmdQuerySlashRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-records [validator-addr]",
+		Short: "Query the slash history of a validator",
+		Long: strings.TrimSpace(`Query the slashes of a validator, with the infraction and the tokenize share records that lost tokens to each slash:
+
+$ <appd> query slashing slash-records cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySlashRecordsRequest{ValidatorAddress: valAddr.String(), Pagination: pageReq}
+			res, err := queryClient.SlashRecords(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash records")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
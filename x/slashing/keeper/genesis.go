@@ -38,7 +38,17 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
-	keeper.SetParams(ctx, data.Params)
+	for _, record := range data.SlashRecords {
+		address, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		keeper.AppendSlashRecord(ctx, address, record)
+	}
+
+	if err := keeper.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -65,5 +75,5 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	return types.NewGenesisState(params, signingInfos, missedBlocks, keeper.GetAllSlashRecords(ctx))
 }
@@ -72,3 +72,37 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) SlashRecords(c context.Context, req *types.QuerySlashRecordsRequest) (*types.QuerySlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var records []types.SlashRecord
+
+	recordStore := prefix.NewStore(store, types.SlashRecordsPrefixKey(valAddr))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.SlashRecord
+		err := k.cdc.Unmarshal(value, &record)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySlashRecordsResponse{SlashRecords: records, Pagination: pageRes}, nil
+}
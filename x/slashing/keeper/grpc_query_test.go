@@ -105,6 +105,54 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCSlashRecords() {
+	queryClient := suite.queryClient
+	valAddr := sdk.ValAddress(suite.addrDels[0])
+
+	recordsResp, err := queryClient.SlashRecords(gocontext.Background(), &types.QuerySlashRecordsRequest{})
+	suite.Error(err)
+	suite.Nil(recordsResp)
+
+	newRecord := func(height int64, infraction types.Infraction) types.SlashRecord {
+		return types.SlashRecord{
+			ValidatorAddress: valAddr.String(),
+			Height:           height,
+			Time:             time.Unix(height, 0).UTC(),
+			InfractionHeight: height,
+			Infraction:       infraction,
+			SlashFraction:    sdk.NewDecWithPrec(1, 2),
+			TokensBurned:     sdk.NewInt(height),
+		}
+	}
+	records := []types.SlashRecord{
+		newRecord(5, types.Infraction_INFRACTION_DOWNTIME),
+		newRecord(5, types.Infraction_INFRACTION_DOUBLE_SIGN),
+		newRecord(12, types.Infraction_INFRACTION_DOWNTIME),
+	}
+	// stored out of order, returned by height and by order within a height
+	suite.app.SlashingKeeper.AppendSlashRecord(suite.ctx, valAddr, records[2])
+	suite.app.SlashingKeeper.AppendSlashRecord(suite.ctx, valAddr, records[0])
+	suite.app.SlashingKeeper.AppendSlashRecord(suite.ctx, valAddr, records[1])
+
+	// the other validator was never slashed
+	recordsResp, err = queryClient.SlashRecords(gocontext.Background(),
+		&types.QuerySlashRecordsRequest{ValidatorAddress: sdk.ValAddress(suite.addrDels[1]).String()})
+	suite.NoError(err)
+	suite.Empty(recordsResp.SlashRecords)
+
+	recordsResp, err = queryClient.SlashRecords(gocontext.Background(),
+		&types.QuerySlashRecordsRequest{ValidatorAddress: valAddr.String()})
+	suite.NoError(err)
+	suite.Equal(records, recordsResp.SlashRecords)
+
+	recordsResp, err = queryClient.SlashRecords(gocontext.Background(),
+		&types.QuerySlashRecordsRequest{ValidatorAddress: valAddr.String(), Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal(records[:2], recordsResp.SlashRecords)
+	suite.NotNil(recordsResp.Pagination.NextKey)
+	suite.Equal(uint64(3), recordsResp.Pagination.Total)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
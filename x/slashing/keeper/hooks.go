@@ -79,6 +79,16 @@ func (h Hooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordId uint64
 	return nil
 }
 
+// Implements sdk.ValidatorHooks - just addition to fulfill the staking hook interface
+func (h Hooks) BeforeTokenizeShareRecordModified(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+// Implements sdk.ValidatorHooks - just addition to fulfill the staking hook interface
+func (h Hooks) BeforeTokenizeShareRecordRedeemed(_ sdk.Context, _ uint64, _ sdk.AccAddress, _ sdk.Dec) error {
+	return nil
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
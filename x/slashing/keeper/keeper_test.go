@@ -10,7 +10,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/testslashing"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
 )
@@ -220,3 +222,38 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, sdkstaking.Unbonding, true)
 }
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.SlashingKeeper)
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 500
+	params.DowntimeJailDuration = time.Hour
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: sdk.AccAddress("invalid_authority___").String(),
+		Params:    params,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// invalid params are rejected
+	invalidParams := params
+	invalidParams.SignedBlocksWindow = 0
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.SlashingKeeper.GetAuthority(),
+		Params:    invalidParams,
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.SlashingKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, app.SlashingKeeper.GetParams(ctx))
+	require.Equal(t, int64(500), app.SlashingKeeper.SignedBlocksWindow(ctx))
+	require.Equal(t, time.Hour, app.SlashingKeeper.DowntimeJailDuration(ctx))
+}
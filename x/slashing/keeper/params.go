@@ -9,46 +9,58 @@ import (
 
 // SignedBlocksWindow - sliding window for downtime slashing
 func (k Keeper) SignedBlocksWindow(ctx sdk.Context) (res int64) {
-	k.paramspace.Get(ctx, types.KeySignedBlocksWindow, &res)
-	return
+	return k.GetParams(ctx).SignedBlocksWindow
 }
 
 // MinSignedPerWindow - minimum blocks signed per window
 func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
-	var minSignedPerWindow sdk.Dec
-	k.paramspace.Get(ctx, types.KeyMinSignedPerWindow, &minSignedPerWindow)
-	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	params := k.GetParams(ctx)
 
 	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
 	//       less than 1.
-	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
+	return params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64()
 }
 
 // DowntimeJailDuration - Downtime unbond duration
 func (k Keeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
-	k.paramspace.Get(ctx, types.KeyDowntimeJailDuration, &res)
-	return
+	return k.GetParams(ctx).DowntimeJailDuration
 }
 
 // SlashFractionDoubleSign - fraction of power slashed in case of double sign
 func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDoubleSign, &res)
-	return
+	return k.GetParams(ctx).SlashFractionDoubleSign
 }
 
 // SlashFractionDowntime - fraction of power slashed for downtime
 func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDowntime, &res)
-	return
+	return k.GetParams(ctx).SlashFractionDowntime
 }
 
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramspace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the slashing parameters to the param space.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramspace.SetParamSet(ctx, &params)
+// SetParams sets the slashing parameters to the store, they are validated
+// before they are written.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
 }
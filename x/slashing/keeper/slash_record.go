@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// AppendSlashRecord stores a slash record after the other slash records of the
+// validator at the same height
+func (k Keeper) AppendSlashRecord(ctx sdk.Context, valAddr sdk.ValAddress, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	index := uint64(0)
+	iter := sdk.KVStorePrefixIterator(store, types.SlashRecordsByHeightPrefixKey(valAddr, record.Height))
+	for ; iter.Valid(); iter.Next() {
+		index++
+	}
+	iter.Close()
+
+	store.Set(types.SlashRecordKey(valAddr, record.Height, index), k.cdc.MustMarshal(&record))
+}
+
+// GetSlashRecords returns the slash records of a validator, oldest first
+func (k Keeper) GetSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress) (records []types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SlashRecordsPrefixKey(valAddr))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllSlashRecords returns the slash records of all the validators
+func (k Keeper) GetAllSlashRecords(ctx sdk.Context) (records []types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SlashRecordKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// slash delegates the slash to the staking module and records it in the slash
+// history of the validator, along with the tokens each of its tokenize share
+// records lost
+func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64, infraction types.Infraction) math.Int {
+	coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil {
+		return coinsBurned
+	}
+	valAddr := validator.GetOperator()
+
+	record := types.SlashRecord{
+		ValidatorAddress: valAddr.String(),
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockTime(),
+		InfractionHeight: distributionHeight,
+		Infraction:       infraction,
+		SlashFraction:    fraction,
+		TokensBurned:     coinsBurned,
+	}
+
+	// Slashing burns tokens without changing any shares, so each record lost its
+	// share of the validator's delegator shares of the burned tokens
+	delegatorShares := validator.GetDelegatorShares()
+	if delegatorShares.IsPositive() && coinsBurned.IsPositive() {
+		for _, shareRecord := range k.sk.GetTokenizeShareRecordsByValidator(ctx, valAddr) {
+			delegation := k.sk.Delegation(ctx, shareRecord.GetModuleAddress(), valAddr)
+			if delegation == nil {
+				continue
+			}
+
+			record.TokenizeShareRecords = append(record.TokenizeShareRecords, types.TokenizeShareRecordSlash{
+				RecordId:        shareRecord.Id,
+				ShareTokenDenom: shareRecord.GetShareTokenDenom(),
+				TokensSlashed:   delegation.GetShares().Quo(delegatorShares).MulInt(coinsBurned),
+			})
+		}
+	}
+
+	k.AppendSlashRecord(ctx, valAddr, record)
+
+	return coinsBurned
+}
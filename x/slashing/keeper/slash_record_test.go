@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+)
+
+// Test that downtime and double sign slashes are recorded along with the
+// tokenize share records of the validator that lost tokens to them
+func TestSlashRecords(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(100, 0).UTC()})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(200)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// a quarter of the stake is tokenized
+	tstaking.CreateValidatorWithValPower(addr, val, 100, true)
+	tstaking.DelegateWithPower(addrDels[1], addr, 100)
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 50)
+	tstaking.TokenizeShares(addrDels[1], addr, sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokenizeAmount), addrDels[1], true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	shareRecords := app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, addr)
+	require.Len(t, shareRecords, 1)
+	shareRecord := shareRecords[0]
+
+	// miss enough blocks to be slashed for downtime
+	height := int64(0)
+	for ; height < app.SlashingKeeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+	require.Empty(t, app.SlashingKeeper.GetSlashRecords(ctx, addr))
+
+	tokensBefore := app.StakingKeeper.Validator(ctx, addr).GetTokens()
+	for ; height < app.SlashingKeeper.SignedBlocksWindow(ctx)+(app.SlashingKeeper.SignedBlocksWindow(ctx)-app.SlashingKeeper.MinSignedPerWindow(ctx))+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+	downtimeBurned := tokensBefore.Sub(app.StakingKeeper.Validator(ctx, addr).GetTokens())
+	require.True(t, downtimeBurned.IsPositive())
+
+	// double sign slash in the same block
+	tokensBefore = app.StakingKeeper.Validator(ctx, addr).GetTokens()
+	app.SlashingKeeper.Slash(ctx, consAddr, app.SlashingKeeper.SlashFractionDoubleSign(ctx), power, ctx.BlockHeight())
+	doubleSignBurned := tokensBefore.Sub(app.StakingKeeper.Validator(ctx, addr).GetTokens())
+	require.True(t, doubleSignBurned.IsPositive())
+
+	records := app.SlashingKeeper.GetSlashRecords(ctx, addr)
+	require.Equal(t, []types.SlashRecord{
+		{
+			ValidatorAddress: addr.String(),
+			Height:           ctx.BlockHeight(),
+			Time:             ctx.BlockTime(),
+			InfractionHeight: ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1,
+			Infraction:       types.Infraction_INFRACTION_DOWNTIME,
+			SlashFraction:    app.SlashingKeeper.SlashFractionDowntime(ctx),
+			TokensBurned:     downtimeBurned,
+			TokenizeShareRecords: []types.TokenizeShareRecordSlash{
+				{
+					RecordId:        shareRecord.Id,
+					ShareTokenDenom: shareRecord.GetShareTokenDenom(),
+					TokensSlashed:   sdk.NewDecFromInt(downtimeBurned).QuoInt64(4),
+				},
+			},
+		},
+		{
+			ValidatorAddress: addr.String(),
+			Height:           ctx.BlockHeight(),
+			Time:             ctx.BlockTime(),
+			InfractionHeight: ctx.BlockHeight(),
+			Infraction:       types.Infraction_INFRACTION_DOUBLE_SIGN,
+			SlashFraction:    app.SlashingKeeper.SlashFractionDoubleSign(ctx),
+			TokensBurned:     doubleSignBurned,
+			TokenizeShareRecords: []types.TokenizeShareRecordSlash{
+				{
+					RecordId:        shareRecord.Id,
+					ShareTokenDenom: shareRecord.GetShareTokenDenom(),
+					TokensSlashed:   sdk.NewDecFromInt(doubleSignBurned).QuoInt64(4),
+				},
+			},
+		},
+	}, records)
+
+	// the slash records are exported and imported with the genesis state
+	genesisState := app.SlashingKeeper.ExportGenesis(ctx)
+	require.Equal(t, records, genesisState.SlashRecords)
+	require.NoError(t, types.ValidateGenesis(*genesisState))
+
+	ctx, _ = ctx.CacheContext()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	iter := sdk.KVStorePrefixIterator(store, types.SlashRecordKeyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+	iter.Close()
+	require.Empty(t, app.SlashingKeeper.GetSlashRecords(ctx, addr))
+
+	app.SlashingKeeper.InitGenesis(ctx, app.StakingKeeper, genesisState)
+	require.Equal(t, records, app.SlashingKeeper.GetSlashRecords(ctx, addr))
+}
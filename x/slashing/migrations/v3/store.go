@@ -0,0 +1,29 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 2 to 3,
+// which moves the module parameters from the x/params subspace to the x/slashing store.
+// The paramstore is expected to already have the current KeyTable registered
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore types.ParamSubspace) error {
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,31 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	v3 "github.com/iqlusioninc/liquidity-staking-module/x/slashing/migrations/v3"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	slashingKey := app.GetKey(types.StoreKey)
+
+	// set the params in the legacy subspace only
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 500
+	params.DowntimeJailDuration = time.Hour
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.SetParamSet(ctx, &params)
+	ctx.KVStore(slashingKey).Delete(types.ParamsKey)
+
+	require.NoError(t, v3.MigrateStore(ctx, slashingKey, app.AppCodec(), subspace))
+
+	require.Equal(t, params, app.SlashingKeeper.GetParams(ctx))
+}
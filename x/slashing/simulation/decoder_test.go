@@ -30,6 +30,14 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	missed := gogotypes.BoolValue{Value: true}
+	record := types.SlashRecord{
+		ValidatorAddress: valAddr1.String(),
+		Height:           10,
+		Time:             time.Now().UTC(),
+		Infraction:       types.Infraction_INFRACTION_DOWNTIME,
+		SlashFraction:    sdk.NewDecWithPrec(1, 2),
+		TokensBurned:     sdk.NewInt(100),
+	}
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
 
@@ -38,6 +46,7 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshal(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.SlashRecordKey(valAddr1, 10, 0), Value: cdc.MustMarshal(&record)},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -50,6 +59,7 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"SlashRecord", fmt.Sprintf("%v\n%v", record, record), false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
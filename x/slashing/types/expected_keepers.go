@@ -8,6 +8,7 @@ import (
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // AccountKeeper expected account keeper
@@ -53,6 +54,9 @@ type StakingKeeper interface {
 
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint32
+
+	// GetTokenizeShareRecordsByValidator returns the tokenize share records of a validator
+	GetTokenizeShareRecordsByValidator(sdk.Context, sdk.ValAddress) []stakingtypes.TokenizeShareRecord
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -1,11 +1,12 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/genesis.proto
+// source: slashing/v1beta1/genesis.proto
 
 package types
 
 import (
 	fmt "fmt"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -33,13 +34,15 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	// slash_records represents the slash history of all validators.
+	SlashRecords []SlashRecord `protobuf:"bytes,4,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records" yaml:"slash_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{0}
+	return fileDescriptor_1d12eeaa856153e6, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,6 +92,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -101,7 +111,7 @@ func (m *SigningInfo) Reset()         { *m = SigningInfo{} }
 func (m *SigningInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningInfo) ProtoMessage()    {}
 func (*SigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{1}
+	return fileDescriptor_1d12eeaa856153e6, []int{1}
 }
 func (m *SigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +167,7 @@ func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
 func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
 func (*ValidatorMissedBlocks) ProtoMessage()    {}
 func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{2}
+	return fileDescriptor_1d12eeaa856153e6, []int{2}
 }
 func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +222,7 @@ func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
 func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
 func (*MissedBlock) ProtoMessage()    {}
 func (*MissedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{3}
+	return fileDescriptor_1d12eeaa856153e6, []int{3}
 }
 func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,39 +272,42 @@ func init() {
 	proto.RegisterType((*MissedBlock)(nil), "liquidstaking.slashing.v1beta1.MissedBlock")
 }
 
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/genesis.proto", fileDescriptor_1923b9188b635394)
-}
+func init() { proto.RegisterFile("slashing/v1beta1/genesis.proto", fileDescriptor_1d12eeaa856153e6) }
 
-var fileDescriptor_1923b9188b635394 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x8e, 0xd3, 0x40,
-	0x14, 0xf6, 0x24, 0x10, 0x60, 0x9c, 0x6d, 0x46, 0x66, 0xb1, 0x56, 0xe0, 0xac, 0x2c, 0x16, 0x6d,
-	0x13, 0x5b, 0xbb, 0x74, 0x20, 0x1a, 0x37, 0x2b, 0x0a, 0x24, 0xe4, 0x95, 0x28, 0x68, 0xa2, 0x71,
-	0x3c, 0x3b, 0x19, 0xc5, 0xf6, 0x04, 0x3f, 0x13, 0x25, 0x57, 0xa0, 0xa2, 0xe6, 0x06, 0xf4, 0x1c,
-	0x22, 0x65, 0x4a, 0xaa, 0x08, 0x25, 0x37, 0xe0, 0x04, 0x28, 0x33, 0x0e, 0x71, 0xa2, 0x98, 0x88,
-	0xca, 0x7e, 0xd2, 0xf7, 0xf7, 0xbe, 0xd1, 0xc3, 0x17, 0x7d, 0x09, 0xa9, 0x04, 0x1f, 0x12, 0x0a,
-	0x03, 0x91, 0x71, 0x7f, 0x7c, 0x15, 0xb1, 0x82, 0x5e, 0xf9, 0x9c, 0x65, 0x0c, 0x04, 0x78, 0xa3,
-	0x5c, 0x16, 0x92, 0x3c, 0xd1, 0x30, 0x6f, 0x03, 0xf3, 0x4a, 0xd8, 0x99, 0xc5, 0x25, 0x97, 0x0a,
-	0xe3, 0xaf, 0xff, 0x34, 0xfc, 0xec, 0x45, 0x9d, 0xea, 0x5f, 0xbe, 0xc2, 0xb9, 0xdf, 0x1b, 0xb8,
-	0x7d, 0xa3, 0x8d, 0x6e, 0x0b, 0x5a, 0x30, 0xf2, 0x06, 0xb7, 0x46, 0x34, 0xa7, 0x29, 0xd8, 0xe8,
-	0x1c, 0x5d, 0x9a, 0xd7, 0x1d, 0xaf, 0xc6, 0xd8, 0x7b, 0xaf, 0x60, 0xc1, 0xbd, 0xd9, 0xa2, 0x63,
-	0x84, 0x25, 0x89, 0x70, 0x7c, 0x02, 0x82, 0x67, 0x22, 0xe3, 0x3d, 0x91, 0xdd, 0x49, 0xb0, 0x1b,
-	0xe7, 0xcd, 0x4b, 0xf3, 0xfa, 0x79, 0xad, 0xca, 0xad, 0x46, 0xbf, 0xcd, 0xee, 0x64, 0xf0, 0x74,
-	0x2d, 0xf5, 0x7b, 0xd1, 0xb1, 0xa6, 0x34, 0x4d, 0x5e, 0xb9, 0x3b, 0x42, 0x6e, 0xd8, 0x86, 0x2d,
-	0x14, 0xc8, 0x27, 0x7c, 0x92, 0x0a, 0x00, 0x16, 0xf7, 0xa2, 0x44, 0xf6, 0x87, 0x60, 0x37, 0x95,
-	0x91, 0x57, 0x6b, 0xf4, 0x81, 0x26, 0x22, 0xa6, 0x85, 0xcc, 0xdf, 0x29, 0x5a, 0xa0, 0x58, 0xfb,
-	0x96, 0x3b, 0x92, 0x6e, 0xd8, 0x4e, 0x2b, 0x58, 0xf7, 0x07, 0xc2, 0x66, 0x25, 0x2e, 0xb1, 0xf1,
-	0x03, 0x1a, 0xc7, 0x39, 0x03, 0xdd, 0xd5, 0xa3, 0x70, 0x33, 0x92, 0x2f, 0x08, 0x9f, 0x8e, 0x37,
-	0x7e, 0xbd, 0xea, 0x1e, 0x76, 0x43, 0xb5, 0xda, 0x3d, 0x1e, 0xb3, 0x5a, 0xcc, 0x45, 0x99, 0xf2,
-	0x99, 0x4e, 0x79, 0x58, 0xda, 0x0d, 0xad, 0xf1, 0x01, 0xb2, 0xfb, 0x0d, 0xe1, 0xc7, 0x07, 0x97,
-	0xff, 0xc7, 0x02, 0x7c, 0xbf, 0xdd, 0x63, 0xcf, 0x58, 0xd1, 0xfd, 0xaf, 0x4e, 0x5f, 0x63, 0xb3,
-	0x42, 0x25, 0x16, 0xbe, 0x2f, 0xb2, 0x98, 0x4d, 0x54, 0x9e, 0x66, 0xa8, 0x07, 0x72, 0x8a, 0x5b,
-	0x9a, 0xa4, 0xda, 0x7b, 0x18, 0x96, 0x53, 0x70, 0x33, 0x5b, 0x3a, 0x68, 0xbe, 0x74, 0xd0, 0xaf,
-	0xa5, 0x83, 0xbe, 0xae, 0x1c, 0x63, 0xbe, 0x72, 0x8c, 0x9f, 0x2b, 0xc7, 0xf8, 0xd8, 0xe5, 0xa2,
-	0x18, 0x7c, 0x8e, 0xbc, 0xbe, 0x4c, 0xfd, 0xf2, 0x12, 0xf4, 0xa7, 0x0b, 0xf1, 0xd0, 0x9f, 0x6c,
-	0xcf, 0xa2, 0x98, 0x8e, 0x18, 0x44, 0x2d, 0x75, 0x0c, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x3c, 0xa8, 0xf6, 0x8c, 0x03, 0x00, 0x00,
+var fileDescriptor_1d12eeaa856153e6 = []byte{
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x75, 0x14, 0x70, 0xbb, 0x4b, 0x14, 0xa6, 0x30, 0x41, 0x3a, 0x45, 0x02, 0x4d,
+	0x42, 0x4d, 0xb4, 0x02, 0x17, 0xb8, 0x40, 0x84, 0x84, 0x38, 0x20, 0xa1, 0x54, 0xe2, 0xb0, 0x4b,
+	0xe4, 0x26, 0x9e, 0x67, 0x2d, 0xb1, 0xbb, 0x7c, 0x4e, 0xd5, 0xbe, 0x05, 0xbc, 0x08, 0x27, 0x6e,
+	0xbc, 0xc0, 0x8e, 0x13, 0x12, 0x12, 0xa7, 0x09, 0xb5, 0x6f, 0xc0, 0x13, 0xa0, 0xd8, 0xe9, 0x96,
+	0x6d, 0x15, 0x2d, 0xdc, 0xe2, 0xf8, 0xff, 0xfb, 0x7f, 0xfe, 0x7f, 0x9f, 0x8d, 0x1c, 0x48, 0x31,
+	0x1c, 0x31, 0x4e, 0xfd, 0xf1, 0xfe, 0x90, 0x48, 0xbc, 0xef, 0x53, 0xc2, 0x09, 0x30, 0xf0, 0x46,
+	0xb9, 0x90, 0xc2, 0x74, 0x52, 0x76, 0x52, 0xb0, 0x04, 0x24, 0x3e, 0x66, 0x9c, 0x7a, 0x0b, 0xb5,
+	0x57, 0xa9, 0x77, 0x2c, 0x2a, 0xa8, 0x50, 0x52, 0xbf, 0xfc, 0xd2, 0xd4, 0x4e, 0xf7, 0x86, 0xeb,
+	0x05, 0xa8, 0x05, 0xf7, 0x63, 0x01, 0x99, 0x80, 0x48, 0x93, 0x7a, 0xa1, 0xb7, 0xdc, 0x2f, 0x4d,
+	0xd4, 0x79, 0xab, 0xcf, 0x30, 0x90, 0x58, 0x12, 0xf3, 0x0d, 0x6a, 0x8d, 0x70, 0x8e, 0x33, 0xb0,
+	0x8d, 0x5d, 0x63, 0xaf, 0xdd, 0x7f, 0xec, 0xfd, 0xfd, 0x4c, 0xde, 0x07, 0xa5, 0x0e, 0x36, 0x4f,
+	0xcf, 0xbb, 0x8d, 0xb0, 0x62, 0x4d, 0x8e, 0xb6, 0x80, 0x51, 0xce, 0x38, 0x8d, 0x18, 0x3f, 0x14,
+	0x60, 0x6f, 0xec, 0x36, 0xf7, 0xda, 0xfd, 0x27, 0xab, 0xcc, 0x06, 0x1a, 0x7a, 0xc7, 0x0f, 0x45,
+	0xf0, 0xa0, 0x74, 0xfc, 0x7d, 0xde, 0xb5, 0xa6, 0x38, 0x4b, 0x5f, 0xb8, 0x57, 0xfc, 0xdc, 0xb0,
+	0x03, 0x97, 0x52, 0x30, 0x27, 0x68, 0x2b, 0x63, 0x00, 0x24, 0x89, 0x86, 0xa9, 0x88, 0x8f, 0xc1,
+	0x6e, 0xaa, 0x7a, 0xcf, 0x57, 0xd5, 0xfb, 0x88, 0x53, 0x96, 0x60, 0x29, 0xf2, 0xf7, 0x8a, 0x0e,
+	0x14, 0x7c, 0xbd, 0xf2, 0x15, 0x67, 0x37, 0xec, 0x64, 0x35, 0xad, 0x4a, 0x5a, 0xba, 0x46, 0x39,
+	0x89, 0x45, 0x9e, 0x80, 0xbd, 0xb9, 0x66, 0xd2, 0xf2, 0x47, 0xa8, 0x98, 0x1b, 0x49, 0xeb, 0x7e,
+	0x65, 0xd2, 0x4b, 0x29, 0xb8, 0x3f, 0x0c, 0xd4, 0xae, 0x75, 0xc9, 0xec, 0xa3, 0xdb, 0x38, 0x49,
+	0x72, 0x02, 0x7a, 0x60, 0x77, 0x03, 0xfb, 0xfb, 0xd7, 0x9e, 0x55, 0xcd, 0xf8, 0xb5, 0xde, 0x19,
+	0xc8, 0x9c, 0x71, 0x1a, 0x2e, 0x84, 0xe6, 0x67, 0x03, 0x6d, 0x8f, 0x17, 0xc9, 0xa3, 0x7a, 0x63,
+	0xed, 0x0d, 0x35, 0xf4, 0x67, 0x6b, 0xf7, 0xad, 0x3e, 0xb0, 0x47, 0x55, 0x8c, 0x87, 0x3a, 0xc6,
+	0xf2, 0x0a, 0x6e, 0x68, 0x8d, 0x97, 0xc0, 0xee, 0x37, 0x03, 0xdd, 0x5b, 0x3a, 0x8d, 0xff, 0x4a,
+	0xc8, 0xaf, 0xdf, 0x87, 0x35, 0xef, 0x5f, 0xad, 0xf0, 0xbf, 0xdc, 0x02, 0xf7, 0x25, 0x6a, 0xd7,
+	0x50, 0xd3, 0x42, 0xb7, 0x18, 0x4f, 0xc8, 0x44, 0x1d, 0xb8, 0x19, 0xea, 0x85, 0xb9, 0x8d, 0x5a,
+	0x1a, 0x52, 0x5d, 0xbe, 0x13, 0x56, 0xab, 0xe0, 0xe0, 0x74, 0xe6, 0x18, 0x67, 0x33, 0xc7, 0xf8,
+	0x35, 0x73, 0x8c, 0x4f, 0x73, 0xa7, 0x71, 0x36, 0x77, 0x1a, 0x3f, 0xe7, 0x4e, 0xe3, 0xe0, 0x15,
+	0x65, 0xf2, 0xa8, 0x18, 0x7a, 0xb1, 0xc8, 0x7c, 0x76, 0x92, 0x16, 0xc0, 0x04, 0x67, 0x3c, 0xf6,
+	0x75, 0x0a, 0x26, 0xa7, 0xbd, 0x2a, 0x49, 0x2f, 0x13, 0x49, 0x91, 0x12, 0x7f, 0x72, 0xf1, 0xf4,
+	0x7d, 0x39, 0x1d, 0x11, 0x18, 0xb6, 0xd4, 0x33, 0x7f, 0xfa, 0x67, 0x00, 0x53, 0x2d, 0x6f, 0x21,
+	0x7a, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -317,6 +330,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -511,6 +538,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -699,6 +732,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -25,15 +25,21 @@ const (
 // Keys for slashing store
 // Items are stored with the following key: values
 //
+// - 0x00: Params
+//
 // - 0x01<consAddrLen (1 Byte)><consAddress_Bytes>: ValidatorSigningInfo
 //
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<valAddrLen (1 Byte)><valAddress_Bytes><height_Bytes><index_Bytes>: SlashRecord
 var (
+	ParamsKey                             = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	SlashRecordKeyPrefix                  = []byte{0x04} // Prefix for slash records
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -67,3 +73,19 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// SlashRecordsPrefixKey - stored by *Operator* address (not consensus address)
+func SlashRecordsPrefixKey(v sdk.ValAddress) []byte {
+	return append(SlashRecordKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// SlashRecordsByHeightPrefixKey gets the prefix of the slash records of a validator at a height
+func SlashRecordsByHeightPrefixKey(v sdk.ValAddress, height int64) []byte {
+	return append(SlashRecordsPrefixKey(v), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// SlashRecordKey gets the key of a slash record, indexed by height and by its order
+// among the slashes of the validator at that height
+func SlashRecordKey(v sdk.ValAddress, height int64, index uint64) []byte {
+	return append(SlashRecordsByHeightPrefixKey(v, height), sdk.Uint64ToBigEndian(index)...)
+}
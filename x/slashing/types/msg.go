@@ -8,11 +8,15 @@ import (
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail       = "unjail"
+	TypeMsgUpdateParams = "update_params"
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var (
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
 
 // NewMsgUnjail creates a new MsgUnjail instance
 //
@@ -43,3 +47,34 @@ func (msg MsgUnjail) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+//
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("authority input address: %s", err)
+	}
+	return msg.Params.Validate()
+}
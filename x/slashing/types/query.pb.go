@@ -1,13 +1,14 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/query.proto
+// source: slashing/v1beta1/query.proto
 
 package types
 
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -38,7 +39,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{0}
+	return fileDescriptor_edfe1dd4e275002d, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +77,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{1}
+	return fileDescriptor_edfe1dd4e275002d, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +124,7 @@ func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest
 func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoRequest) ProtoMessage()    {}
 func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{2}
+	return fileDescriptor_edfe1dd4e275002d, []int{2}
 }
 func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,7 +171,7 @@ func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoRespon
 func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoResponse) ProtoMessage()    {}
 func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{3}
+	return fileDescriptor_edfe1dd4e275002d, []int{3}
 }
 func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,7 +217,7 @@ func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosReque
 func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosRequest) ProtoMessage()    {}
 func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{4}
+	return fileDescriptor_edfe1dd4e275002d, []int{4}
 }
 func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +265,7 @@ func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResp
 func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosResponse) ProtoMessage()    {}
 func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{5}
+	return fileDescriptor_edfe1dd4e275002d, []int{5}
 }
 func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,6 +308,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySlashRecordsRequest is the request type for the Query/SlashRecords RPC
+// method
+type QuerySlashRecordsRequest struct {
+	// validator_address is the operator address of the validator to query the slashes of
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashRecordsRequest) Reset()         { *m = QuerySlashRecordsRequest{} }
+func (m *QuerySlashRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRecordsRequest) ProtoMessage()    {}
+func (*QuerySlashRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{6}
+}
+func (m *QuerySlashRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRecordsRequest.Merge(m, src)
+}
+func (m *QuerySlashRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRecordsRequest proto.InternalMessageInfo
+
+func (m *QuerySlashRecordsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySlashRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySlashRecordsResponse is the response type for the Query/SlashRecords RPC
+// method
+type QuerySlashRecordsResponse struct {
+	// slash_records are the slashes of the validator, oldest first
+	SlashRecords []SlashRecord       `protobuf:"bytes,1,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashRecordsResponse) Reset()         { *m = QuerySlashRecordsResponse{} }
+func (m *QuerySlashRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRecordsResponse) ProtoMessage()    {}
+func (*QuerySlashRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edfe1dd4e275002d, []int{7}
+}
+func (m *QuerySlashRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRecordsResponse.Merge(m, src)
+}
+func (m *QuerySlashRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRecordsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRecordsResponse) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *QuerySlashRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "liquidstaking.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "liquidstaking.slashing.v1beta1.QueryParamsResponse")
@@ -314,47 +425,57 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "liquidstaking.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "liquidstaking.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "liquidstaking.slashing.v1beta1.QuerySigningInfosResponse")
-}
-
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/query.proto", fileDescriptor_791b11d41a861ed0)
-}
-
-var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x6d, 0x0d, 0x38, 0x29, 0x22, 0x63, 0xa1, 0x35, 0xc8, 0xc6, 0xae, 0x90, 0x16,
-	0x35, 0x3b, 0x26, 0x22, 0x5e, 0xec, 0xc1, 0x1e, 0x0c, 0xde, 0x34, 0x8a, 0x07, 0x41, 0xc2, 0x6c,
-	0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0xfb, 0x36, 0xc1, 0x20, 0x5e, 0x3c, 0x7b, 0x10, 0xfc, 0x0c,
-	0x1e, 0x3d, 0xf8, 0x2d, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x09, 0xfc, 0x04, 0x92, 0x99,
-	0x49, 0xb2, 0x25, 0xae, 0xa6, 0x3d, 0xed, 0xf0, 0xe6, 0xfd, 0xdf, 0xff, 0xf7, 0xe6, 0x3d, 0x16,
-	0xdf, 0xe8, 0x68, 0xe8, 0x69, 0xa0, 0x10, 0x31, 0x38, 0x94, 0x4a, 0xd0, 0x41, 0x3d, 0xe4, 0x29,
-	0xab, 0xd3, 0xa3, 0x3e, 0x4f, 0x86, 0x41, 0x9c, 0xe8, 0x54, 0x93, 0x4d, 0x9b, 0x14, 0x4c, 0x93,
-	0x02, 0x97, 0x54, 0xbe, 0xe9, 0xd4, 0x21, 0x03, 0x6e, 0x15, 0x33, 0x7d, 0xcc, 0x84, 0x54, 0x2c,
-	0x95, 0x5a, 0xd9, 0x22, 0xe5, 0x0d, 0xa1, 0x85, 0x36, 0x47, 0x3a, 0x39, 0xb9, 0xe8, 0x35, 0xa1,
-	0xb5, 0x88, 0x38, 0x65, 0xb1, 0xa4, 0x4c, 0x29, 0x9d, 0x1a, 0x09, 0xb8, 0xdb, 0x6a, 0x1e, 0xdd,
-	0x8c, 0xc4, 0xe4, 0xf9, 0x1b, 0x98, 0x3c, 0x9d, 0xb8, 0x3f, 0x61, 0x09, 0xeb, 0x41, 0x8b, 0x1f,
-	0xf5, 0x39, 0xa4, 0xfe, 0x73, 0x7c, 0xe5, 0x54, 0x14, 0x62, 0xad, 0x80, 0x93, 0x3d, 0x5c, 0x8c,
-	0x4d, 0x64, 0x0b, 0x5d, 0x47, 0xbb, 0xa5, 0x46, 0x25, 0xc8, 0x69, 0x2f, 0xb0, 0xc2, 0xfd, 0xb5,
-	0xe3, 0x1f, 0x95, 0x42, 0xcb, 0x89, 0xfc, 0x07, 0x78, 0xd3, 0x54, 0x7d, 0x26, 0x85, 0x92, 0x4a,
-	0x3c, 0x56, 0x07, 0xda, 0x19, 0x92, 0x6d, 0xbc, 0xde, 0xd1, 0x0a, 0xda, 0xac, 0xdb, 0x4d, 0x38,
-	0xd8, 0xfa, 0x17, 0x5b, 0xa5, 0x49, 0xec, 0xa1, 0x0d, 0xf9, 0x43, 0xbc, 0xb5, 0xa8, 0x76, 0x60,
-	0xaf, 0xf0, 0xe5, 0x01, 0x8b, 0xda, 0x60, 0xaf, 0xda, 0x52, 0x1d, 0x68, 0x87, 0x58, 0xcb, 0x45,
-	0x7c, 0xc1, 0x22, 0xd9, 0x65, 0xa9, 0x4e, 0x32, 0x05, 0x1d, 0xf0, 0xa5, 0x01, 0x8b, 0x32, 0x51,
-	0x3f, 0x5c, 0xb4, 0x9e, 0x3e, 0x15, 0x79, 0x84, 0xf1, 0x7c, 0x60, 0xce, 0xb4, 0x3a, 0x35, 0x9d,
-	0x4c, 0x37, 0xb0, 0xfb, 0x30, 0x7f, 0x19, 0xc1, 0x9d, 0xb6, 0x95, 0x51, 0xfa, 0x5f, 0x10, 0xbe,
-	0xfa, 0x17, 0x13, 0xd7, 0x60, 0x13, 0xaf, 0xb9, 0xa6, 0x56, 0xcf, 0xdb, 0x94, 0x29, 0x40, 0x9a,
-	0xa7, 0x70, 0x57, 0x0c, 0xee, 0xce, 0x7f, 0x71, 0x2d, 0x45, 0x96, 0xb7, 0xf1, 0x7b, 0x15, 0x5f,
-	0x30, 0xbc, 0xe4, 0x03, 0xc2, 0x45, 0x3b, 0x6f, 0x72, 0x2b, 0x17, 0x6c, 0x71, 0xc9, 0xca, 0xb7,
-	0x97, 0x4b, 0xb6, 0xde, 0xfe, 0xce, 0xfb, 0x6f, 0xbf, 0x3e, 0xad, 0x6c, 0x93, 0x0a, 0xcd, 0xdb,
-	0x6c, 0xbb, 0x65, 0xe4, 0x2b, 0xc2, 0xa5, 0x4c, 0xf7, 0xe4, 0xce, 0xbf, 0x6d, 0x16, 0x97, 0xb1,
-	0x5c, 0x3f, 0x83, 0xc2, 0xd1, 0xed, 0x19, 0xba, 0xfb, 0xe4, 0x5e, 0x2e, 0x5d, 0x76, 0x37, 0x81,
-	0xbe, 0xcd, 0x6e, 0xfb, 0x3b, 0xf2, 0x19, 0xe1, 0xf5, 0xec, 0xdc, 0xc9, 0xf2, 0x08, 0xb3, 0xe7,
-	0x6c, 0x9c, 0x45, 0xe2, 0xb0, 0x03, 0x83, 0xbd, 0x4b, 0xaa, 0xcb, 0x61, 0xef, 0x37, 0x8f, 0x47,
-	0x1e, 0x3a, 0x19, 0x79, 0xe8, 0xe7, 0xc8, 0x43, 0x1f, 0xc7, 0x5e, 0xe1, 0x64, 0xec, 0x15, 0xbe,
-	0x8f, 0xbd, 0xc2, 0xcb, 0x9a, 0x90, 0xe9, 0x61, 0x3f, 0x0c, 0x3a, 0xba, 0x37, 0xad, 0x65, 0x3f,
-	0x35, 0xe8, 0xbe, 0xa6, 0x6f, 0xe6, 0x85, 0xd3, 0x61, 0xcc, 0x21, 0x2c, 0x9a, 0xbf, 0xcf, 0xdd,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x0f, 0xc0, 0xe6, 0x45, 0x05, 0x00, 0x00,
+	proto.RegisterType((*QuerySlashRecordsRequest)(nil), "liquidstaking.slashing.v1beta1.QuerySlashRecordsRequest")
+	proto.RegisterType((*QuerySlashRecordsResponse)(nil), "liquidstaking.slashing.v1beta1.QuerySlashRecordsResponse")
+}
+
+func init() { proto.RegisterFile("slashing/v1beta1/query.proto", fileDescriptor_edfe1dd4e275002d) }
+
+var fileDescriptor_edfe1dd4e275002d = []byte{
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0x14, 0x3f,
+	0x1c, 0xc6, 0x77, 0xf8, 0x01, 0xc9, 0xaf, 0xa0, 0xc1, 0x4a, 0x22, 0x6c, 0xc8, 0xa0, 0x73, 0x00,
+	0xa2, 0x61, 0x1a, 0x40, 0x83, 0xf8, 0x27, 0xd1, 0x8d, 0x62, 0xbc, 0x18, 0x5d, 0x12, 0x0e, 0x78,
+	0xd8, 0x74, 0x77, 0x4a, 0x69, 0x9c, 0x6d, 0x67, 0xa7, 0x33, 0x1b, 0x09, 0x21, 0x31, 0xbe, 0x02,
+	0x13, 0x6f, 0xbe, 0x03, 0xcf, 0x72, 0x34, 0xf1, 0x28, 0x47, 0xa2, 0x17, 0x4f, 0xc6, 0x80, 0xf1,
+	0x75, 0x98, 0x69, 0xbb, 0xb3, 0xb3, 0x19, 0x70, 0xff, 0xe8, 0x6d, 0xb6, 0xed, 0xf3, 0xed, 0xe7,
+	0x79, 0xda, 0x7e, 0x17, 0xcc, 0x48, 0x1f, 0xcb, 0x1d, 0xc6, 0x29, 0x6a, 0x2e, 0x55, 0x49, 0x84,
+	0x97, 0x50, 0x23, 0x26, 0xe1, 0xae, 0x1b, 0x84, 0x22, 0x12, 0xd0, 0xf6, 0x59, 0x23, 0x66, 0x9e,
+	0x8c, 0xf0, 0x0b, 0xc6, 0xa9, 0xdb, 0x5a, 0xeb, 0x9a, 0xb5, 0xc5, 0xab, 0x35, 0x21, 0xeb, 0x42,
+	0xa2, 0x2a, 0x96, 0x44, 0x0b, 0xd3, 0x32, 0x01, 0xa6, 0x8c, 0xe3, 0x88, 0x09, 0xae, 0x6b, 0x15,
+	0x27, 0xa9, 0xa0, 0x42, 0x7d, 0xa2, 0xe4, 0xcb, 0x8c, 0xce, 0x50, 0x21, 0xa8, 0x4f, 0x10, 0x0e,
+	0x18, 0xc2, 0x9c, 0x8b, 0x48, 0x49, 0xa4, 0x99, 0x9d, 0xcd, 0xd1, 0xa5, 0x08, 0x7a, 0xc1, 0xb4,
+	0x06, 0xa8, 0xe8, 0xba, 0xfa, 0x87, 0x9e, 0x72, 0x26, 0x01, 0x7c, 0x96, 0x10, 0x3d, 0xc5, 0x21,
+	0xae, 0xcb, 0x32, 0x69, 0xc4, 0x44, 0x46, 0xce, 0x73, 0x70, 0xb1, 0x63, 0x54, 0x06, 0x82, 0x4b,
+	0x02, 0x1f, 0x80, 0xd1, 0x40, 0x8d, 0x4c, 0x59, 0x97, 0xad, 0x85, 0xb1, 0xe5, 0x39, 0xf7, 0xcf,
+	0xce, 0x5d, 0xad, 0x2f, 0x0d, 0x1f, 0x7e, 0x9f, 0x2d, 0x94, 0x8d, 0xd6, 0xd9, 0x04, 0x97, 0x54,
+	0xf1, 0x0d, 0x46, 0x39, 0xe3, 0xf4, 0x31, 0xdf, 0x16, 0x66, 0x5f, 0x78, 0x1b, 0x8c, 0xd7, 0x04,
+	0x97, 0x15, 0xec, 0x79, 0x21, 0x91, 0x7a, 0x9b, 0xff, 0x4b, 0x53, 0x5f, 0x0e, 0x16, 0x27, 0x0d,
+	0xf5, 0x7d, 0x3d, 0xb3, 0x11, 0x85, 0x8c, 0xd3, 0xf2, 0x58, 0xb2, 0xda, 0x0c, 0x39, 0xaf, 0x2c,
+	0x30, 0x95, 0x2f, 0x6c, 0xd0, 0x3d, 0x30, 0xd1, 0xc4, 0x7e, 0x45, 0xea, 0xa9, 0x0a, 0xe3, 0xdb,
+	0xc2, 0x98, 0xb8, 0xde, 0xcd, 0xc4, 0x26, 0xf6, 0x99, 0x87, 0x23, 0x11, 0x66, 0xea, 0x1a, 0x4b,
+	0xe7, 0x9b, 0xd8, 0xcf, 0x8c, 0x3a, 0xd5, 0x3c, 0x41, 0x2b, 0x53, 0xb8, 0x0e, 0x40, 0xfb, 0xb4,
+	0xd3, 0x00, 0x8d, 0xad, 0xe4, 0x6a, 0xb8, 0xfa, 0x4e, 0xb5, 0xb3, 0xa3, 0xc4, 0x68, 0xcb, 0x19,
+	0xa5, 0x73, 0x60, 0x81, 0xe9, 0x53, 0x36, 0x31, 0x3e, 0x9f, 0x80, 0x61, 0xe3, 0xed, 0xbf, 0xbf,
+	0xf4, 0xa6, 0xea, 0xc0, 0x47, 0x1d, 0xd4, 0x43, 0x8a, 0x7a, 0xbe, 0x2b, 0xb5, 0x86, 0xe9, 0xc0,
+	0x7e, 0x9f, 0x9e, 0x4e, 0xc2, 0x50, 0x26, 0x35, 0x11, 0x7a, 0x69, 0x36, 0x0f, 0xc1, 0x85, 0x66,
+	0x8b, 0xa4, 0xe7, 0xc3, 0x9f, 0x48, 0x25, 0x66, 0x1c, 0xae, 0x9f, 0x02, 0x3b, 0x48, 0xc4, 0x1f,
+	0xd3, 0x88, 0x3b, 0x58, 0x4d, 0xc4, 0x9b, 0xe0, 0x9c, 0xca, 0xb1, 0x12, 0xea, 0x09, 0x93, 0xf5,
+	0xb5, 0x6e, 0x59, 0x67, 0x8a, 0x99, 0x88, 0xc7, 0x65, 0xa6, 0xfe, 0x3f, 0x8b, 0x7a, 0xf9, 0xd7,
+	0x08, 0x18, 0x51, 0xf8, 0xf0, 0x9d, 0x05, 0x46, 0xf5, 0x1b, 0x84, 0xcb, 0xdd, 0xf0, 0xf2, 0x6d,
+	0xa0, 0xb8, 0xd2, 0x97, 0x46, 0x93, 0x38, 0xf3, 0xaf, 0xbf, 0xfe, 0x7c, 0x3b, 0x74, 0x05, 0xce,
+	0x9a, 0x46, 0x83, 0x72, 0xdd, 0x49, 0xf7, 0x01, 0xf8, 0xc9, 0x02, 0x63, 0x99, 0x6b, 0x07, 0x57,
+	0x7b, 0xda, 0x2d, 0xdf, 0x35, 0x8a, 0x37, 0xfb, 0x17, 0x1a, 0xd6, 0xbb, 0x8a, 0x75, 0x15, 0xde,
+	0x38, 0x93, 0x35, 0xdb, 0x30, 0x24, 0xda, 0xcb, 0x76, 0xa7, 0x7d, 0xf8, 0xc1, 0x02, 0xe3, 0xd9,
+	0x57, 0x08, 0xfb, 0x26, 0x49, 0xa3, 0x5e, 0x1b, 0x40, 0x69, 0x4c, 0xb8, 0xca, 0xc4, 0x02, 0x9c,
+	0xeb, 0xcd, 0x04, 0xfc, 0x9c, 0x50, 0x67, 0x2f, 0x5e, 0x8f, 0xd4, 0xf9, 0x77, 0x5b, 0x5c, 0x1b,
+	0x40, 0x69, 0xa8, 0x4b, 0x8a, 0xfa, 0x0e, 0xbc, 0x75, 0x36, 0x75, 0xf6, 0x91, 0xa1, 0xbd, 0x5c,
+	0x83, 0xd8, 0x2f, 0x6d, 0x1d, 0x1e, 0xdb, 0xd6, 0xd1, 0xb1, 0x6d, 0xfd, 0x38, 0xb6, 0xad, 0x37,
+	0x27, 0x76, 0xe1, 0xe8, 0xc4, 0x2e, 0x7c, 0x3b, 0xb1, 0x0b, 0x5b, 0xf7, 0x28, 0x8b, 0x76, 0xe2,
+	0xaa, 0x5b, 0x13, 0x75, 0xc4, 0x1a, 0x7e, 0x2c, 0x99, 0xe0, 0x8c, 0xd7, 0x90, 0xc6, 0x65, 0xd1,
+	0xee, 0xa2, 0x41, 0x5e, 0xac, 0x0b, 0x2f, 0xf6, 0x09, 0x7a, 0xd9, 0xde, 0x3f, 0xda, 0x0d, 0x88,
+	0xac, 0x8e, 0xaa, 0xff, 0xc7, 0x95, 0xdf, 0x03, 0x00, 0x0a, 0xdf, 0x05, 0x01, 0xfb, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -375,6 +496,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SlashRecords queries the slash history of a validator, with the tokenize share
+	// records that lost tokens to each slash
+	SlashRecords(ctx context.Context, in *QuerySlashRecordsRequest, opts ...grpc.CallOption) (*QuerySlashRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -387,7 +511,7 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/Params", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/liquidstaking.slashing.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -396,7 +520,7 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 
 func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
 	out := new(QuerySigningInfoResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/SigningInfo", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/liquidstaking.slashing.v1beta1.Query/SigningInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -405,7 +529,16 @@ func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoReque
 
 func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
 	out := new(QuerySigningInfosResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/SigningInfos", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/liquidstaking.slashing.v1beta1.Query/SigningInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRecords(ctx context.Context, in *QuerySlashRecordsRequest, opts ...grpc.CallOption) (*QuerySlashRecordsResponse, error) {
+	out := new(QuerySlashRecordsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.slashing.v1beta1.Query/SlashRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -420,6 +553,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SlashRecords queries the slash history of a validator, with the tokenize share
+	// records that lost tokens to each slash
+	SlashRecords(context.Context, *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -435,6 +571,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) SlashRecords(ctx context.Context, req *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -450,7 +589,7 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.slashing.v1beta1.Query/Params",
+		FullMethod: "/liquidstaking.slashing.v1beta1.Query/Params",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
@@ -468,7 +607,7 @@ func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.slashing.v1beta1.Query/SigningInfo",
+		FullMethod: "/liquidstaking.slashing.v1beta1.Query/SigningInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
@@ -486,7 +625,7 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.slashing.v1beta1.Query/SigningInfos",
+		FullMethod: "/liquidstaking.slashing.v1beta1.Query/SigningInfos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
@@ -494,8 +633,26 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.slashing.v1beta1.Query/SlashRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRecords(ctx, req.(*QuerySlashRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.slashing.v1beta1.Query",
+	ServiceName: "liquidstaking.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -510,9 +667,13 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "SlashRecords",
+			Handler:    _Query_SlashRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/slashing/v1beta1/query.proto",
+	Metadata: "slashing/v1beta1/query.proto",
 }
 
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
@@ -718,6 +879,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySlashRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -805,6 +1057,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySlashRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1315,6 +1603,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySlashRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
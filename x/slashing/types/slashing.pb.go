@@ -1,16 +1,16 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/slashing.proto
+// source: slashing/v1beta1/slashing.proto
 
 package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,6 +29,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Infraction is the misbehaviour a validator was slashed for.
+type Infraction int32
+
+const (
+	// INFRACTION_UNSPECIFIED defines an unspecified infraction.
+	Infraction_INFRACTION_UNSPECIFIED Infraction = 0
+	// INFRACTION_DOUBLE_SIGN defines a validator that double-signed a block.
+	Infraction_INFRACTION_DOUBLE_SIGN Infraction = 1
+	// INFRACTION_DOWNTIME defines a validator that missed too many blocks.
+	Infraction_INFRACTION_DOWNTIME Infraction = 2
+)
+
+var Infraction_name = map[int32]string{
+	0: "INFRACTION_UNSPECIFIED",
+	1: "INFRACTION_DOUBLE_SIGN",
+	2: "INFRACTION_DOWNTIME",
+}
+
+var Infraction_value = map[string]int32{
+	"INFRACTION_UNSPECIFIED": 0,
+	"INFRACTION_DOUBLE_SIGN": 1,
+	"INFRACTION_DOWNTIME":    2,
+}
+
+func (x Infraction) String() string {
+	return proto.EnumName(Infraction_name, int32(x))
+}
+
+func (Infraction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -52,7 +84,7 @@ type ValidatorSigningInfo struct {
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
 func (*ValidatorSigningInfo) ProtoMessage() {}
 func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{0}
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{0}
 }
 func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -136,7 +168,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,57 +211,236 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+// SlashRecord records a slash of a validator along with the tokenize share
+// records of the validator that lost tokens to it.
+type SlashRecord struct {
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the block height at which the slash was applied.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the slash was applied.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// infraction_height is the height of the stake distribution that was slashed.
+	InfractionHeight int64 `protobuf:"varint,4,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// infraction is the misbehaviour the validator was slashed for.
+	Infraction Infraction `protobuf:"varint,5,opt,name=infraction,proto3,enum=liquidstaking.slashing.v1beta1.Infraction" json:"infraction,omitempty"`
+	// slash_fraction is the fraction of the stake that was slashed.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// tokens_burned are the tokens burned from the validator, excluding those
+	// burned from its unbonding delegations and redelegations.
+	TokensBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=tokens_burned,json=tokensBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens_burned"`
+	// tokenize_share_records are the tokenize share records of the validator that
+	// lost tokens to the slash.
+	TokenizeShareRecords []TokenizeShareRecordSlash `protobuf:"bytes,8,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{2}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SlashRecord) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetInfraction() Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return Infraction_INFRACTION_UNSPECIFIED
+}
+
+func (m *SlashRecord) GetTokenizeShareRecords() []TokenizeShareRecordSlash {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+// TokenizeShareRecordSlash is the loss of a tokenize share record to a slash.
+type TokenizeShareRecordSlash struct {
+	// record_id is the id of the tokenize share record.
+	RecordId uint64 `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// share_token_denom is the denom of the share tokens of the record.
+	ShareTokenDenom string `protobuf:"bytes,2,opt,name=share_token_denom,json=shareTokenDenom,proto3" json:"share_token_denom,omitempty"`
+	// tokens_slashed are the tokens backing the record that were burned.
+	TokensSlashed github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=tokens_slashed,json=tokensSlashed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokens_slashed"`
+}
+
+func (m *TokenizeShareRecordSlash) Reset()         { *m = TokenizeShareRecordSlash{} }
+func (m *TokenizeShareRecordSlash) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecordSlash) ProtoMessage()    {}
+func (*TokenizeShareRecordSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{3}
+}
+func (m *TokenizeShareRecordSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecordSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecordSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecordSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecordSlash.Merge(m, src)
+}
+func (m *TokenizeShareRecordSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecordSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecordSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecordSlash proto.InternalMessageInfo
+
+func (m *TokenizeShareRecordSlash) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecordSlash) GetShareTokenDenom() string {
+	if m != nil {
+		return m.ShareTokenDenom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("liquidstaking.slashing.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "liquidstaking.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "liquidstaking.slashing.v1beta1.Params")
+	proto.RegisterType((*SlashRecord)(nil), "liquidstaking.slashing.v1beta1.SlashRecord")
+	proto.RegisterType((*TokenizeShareRecordSlash)(nil), "liquidstaking.slashing.v1beta1.TokenizeShareRecordSlash")
 }
 
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/slashing.proto", fileDescriptor_1078e5d96a74cc52)
-}
-
-var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("slashing/v1beta1/slashing.proto", fileDescriptor_2ddc33c0f1f4d4ab) }
+
+var fileDescriptor_2ddc33c0f1f4d4ab = []byte{
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x53, 0xdb, 0x46,
+	0x14, 0xb6, 0x62, 0x97, 0xc0, 0xda, 0x49, 0x61, 0x71, 0x40, 0x21, 0xad, 0xe4, 0xea, 0xd0, 0xa1,
+	0x74, 0xb0, 0x27, 0xf4, 0x92, 0x61, 0x7a, 0x68, 0x84, 0xa1, 0x55, 0xa6, 0x35, 0x54, 0x86, 0x66,
+	0xa6, 0x33, 0x8d, 0x46, 0x96, 0x16, 0xb1, 0x45, 0xda, 0x25, 0xda, 0x55, 0x08, 0xb9, 0xf5, 0xd6,
+	0x23, 0xc7, 0x1c, 0x33, 0x3d, 0xf5, 0x0f, 0xc8, 0x1f, 0xc1, 0x31, 0x93, 0x53, 0xa7, 0x33, 0x75,
+	0x3b, 0x70, 0xe9, 0x99, 0x5b, 0x6f, 0x1d, 0xed, 0xae, 0x7f, 0x00, 0xa6, 0xa9, 0x4f, 0xf6, 0xfb,
+	0xbe, 0x7d, 0xdf, 0xbe, 0xfd, 0xde, 0xdb, 0x1d, 0x01, 0x93, 0xc5, 0x3e, 0xdb, 0xc3, 0x24, 0x6a,
+	0x3c, 0xbb, 0xdf, 0x41, 0xdc, 0xbf, 0xdf, 0xe8, 0x01, 0xf5, 0x83, 0x94, 0x72, 0x0a, 0x8d, 0x18,
+	0x3f, 0xcd, 0x70, 0xc8, 0xb8, 0xbf, 0x9f, 0x83, 0x7d, 0x56, 0x2d, 0x5f, 0xa8, 0x46, 0x34, 0xa2,
+	0x62, 0x69, 0x23, 0xff, 0x27, 0xb3, 0x16, 0x8c, 0x88, 0xd2, 0x28, 0x46, 0x0d, 0x11, 0x75, 0xb2,
+	0xdd, 0x46, 0x98, 0xa5, 0x3e, 0xc7, 0x94, 0x28, 0xde, 0xbc, 0xcc, 0x73, 0x9c, 0x20, 0xc6, 0xfd,
+	0xe4, 0x40, 0x2d, 0xb8, 0x1b, 0x50, 0x96, 0x50, 0xe6, 0x49, 0x65, 0x19, 0x48, 0xca, 0xfa, 0xa5,
+	0x08, 0xaa, 0xdf, 0xf9, 0x31, 0x0e, 0x7d, 0x4e, 0xd3, 0x36, 0x8e, 0x08, 0x26, 0x91, 0x43, 0x76,
+	0x29, 0x5c, 0x01, 0x37, 0xfd, 0x30, 0x4c, 0x11, 0x63, 0xba, 0x56, 0xd3, 0x16, 0xa7, 0x6c, 0xfd,
+	0xed, 0xeb, 0xe5, 0xaa, 0xca, 0x7d, 0x28, 0x99, 0x36, 0x4f, 0x31, 0x89, 0xdc, 0xde, 0x42, 0xb8,
+	0x0a, 0x2a, 0x8c, 0xfb, 0x29, 0xf7, 0xf6, 0x10, 0x8e, 0xf6, 0xb8, 0x7e, 0xa3, 0xa6, 0x2d, 0x16,
+	0xed, 0xf9, 0xf3, 0xae, 0x39, 0x7b, 0xe4, 0x27, 0xf1, 0xaa, 0x35, 0xcc, 0x5a, 0x6e, 0x59, 0x84,
+	0x5f, 0x89, 0x28, 0xcf, 0xc5, 0x24, 0x44, 0xcf, 0x3d, 0xba, 0xbb, 0xcb, 0x10, 0xd7, 0x8b, 0x97,
+	0x73, 0x87, 0x59, 0xcb, 0x2d, 0x8b, 0x70, 0x53, 0x44, 0xf0, 0x09, 0xa8, 0xfc, 0xe8, 0xe3, 0x18,
+	0x85, 0x5e, 0x46, 0x38, 0x8e, 0xf5, 0x52, 0x4d, 0x5b, 0x2c, 0xaf, 0x2c, 0xd4, 0xa5, 0x2f, 0xf5,
+	0x9e, 0x2f, 0xf5, 0xed, 0x9e, 0x2f, 0xb6, 0x79, 0xd2, 0x35, 0x0b, 0x03, 0xed, 0xe1, 0x6c, 0xeb,
+	0xf8, 0x4f, 0x53, 0x73, 0xcb, 0x12, 0xda, 0xc9, 0x11, 0x68, 0x00, 0xc0, 0x69, 0xd2, 0x61, 0x9c,
+	0x12, 0x14, 0xea, 0xef, 0xd5, 0xb4, 0xc5, 0x49, 0x77, 0x08, 0x81, 0xdb, 0xe0, 0x4e, 0x82, 0x19,
+	0x43, 0xa1, 0xd7, 0x89, 0x69, 0xb0, 0xcf, 0xbc, 0x80, 0x66, 0x84, 0xa3, 0x54, 0x9f, 0x10, 0x87,
+	0xa8, 0x9d, 0x77, 0xcd, 0x0f, 0xe4, 0x46, 0x23, 0x97, 0x59, 0xee, 0xac, 0xc4, 0x6d, 0x01, 0xaf,
+	0x49, 0x74, 0x75, 0xf2, 0xe5, 0x2b, 0xb3, 0xf0, 0xf7, 0x2b, 0x53, 0xb3, 0xfe, 0x29, 0x81, 0x89,
+	0x2d, 0x3f, 0xf5, 0x13, 0x06, 0xbf, 0x05, 0x55, 0x86, 0x23, 0x32, 0xd0, 0x38, 0xc4, 0x24, 0xa4,
+	0x87, 0xa2, 0x47, 0x45, 0xdb, 0x3c, 0xef, 0x9a, 0xf7, 0x94, 0xd5, 0x23, 0x56, 0x59, 0x2e, 0x94,
+	0xb0, 0xdc, 0xe8, 0xb1, 0x00, 0xe1, 0x4f, 0x5a, 0x5e, 0x3e, 0xf1, 0x54, 0xc6, 0x01, 0x4a, 0x7b,
+	0xa2, 0x79, 0xff, 0x2a, 0x76, 0x2b, 0xf7, 0xea, 0xf7, 0xae, 0xf9, 0x71, 0x84, 0xf9, 0x5e, 0xd6,
+	0xa9, 0x07, 0x34, 0x51, 0x33, 0xa4, 0x7e, 0x96, 0x59, 0xb8, 0xdf, 0xe0, 0x47, 0x07, 0x88, 0xd5,
+	0x9b, 0x28, 0x18, 0x3e, 0xec, 0x08, 0x51, 0xcb, 0x85, 0x09, 0x26, 0x6d, 0x01, 0x6f, 0xa1, 0x54,
+	0xd5, 0xf0, 0x02, 0xcc, 0x85, 0xf4, 0x90, 0xe4, 0x83, 0xeb, 0xe5, 0xce, 0x7b, 0xbd, 0x11, 0x17,
+	0x73, 0x50, 0x5e, 0xb9, 0x7b, 0xa5, 0x97, 0x4d, 0xb5, 0xc0, 0xfe, 0x44, 0xb5, 0xf2, 0x43, 0xb9,
+	0xe9, 0x68, 0x19, 0xeb, 0x65, 0xde, 0xd4, 0x6a, 0x8f, 0x7c, 0xe4, 0xe3, 0xb8, 0x27, 0x00, 0x8f,
+	0x35, 0xb0, 0x20, 0x6e, 0xa2, 0xb7, 0x9b, 0xfa, 0x41, 0x0e, 0x79, 0x21, 0xcd, 0x3a, 0x31, 0x12,
+	0xc5, 0x8b, 0x61, 0xaa, 0xd8, 0xed, 0xb1, 0x4d, 0xf8, 0x48, 0xf5, 0xe1, 0x5a, 0x65, 0xcb, 0x9d,
+	0x17, 0xe4, 0x86, 0xe2, 0x9a, 0x82, 0xca, 0x9d, 0x81, 0x3f, 0x6b, 0x60, 0xfe, 0x4a, 0xa2, 0x2c,
+	0x5d, 0x8c, 0x5f, 0xc5, 0xde, 0x1a, 0xbb, 0x1e, 0xe3, 0x9a, 0x7a, 0xa4, 0xac, 0xe5, 0xde, 0xb9,
+	0x54, 0x8c, 0xc2, 0xff, 0x28, 0x81, 0x72, 0x3b, 0x67, 0x5c, 0x14, 0xd0, 0x34, 0x84, 0xeb, 0x60,
+	0xe6, 0x59, 0xef, 0xbd, 0xf0, 0xfe, 0xef, 0x0b, 0x31, 0xdd, 0x4f, 0x51, 0x38, 0x9c, 0x03, 0x13,
+	0xc3, 0x8f, 0x84, 0xab, 0x22, 0xf8, 0x00, 0x94, 0xc4, 0x29, 0x8b, 0xef, 0xbc, 0xc2, 0x93, 0xb9,
+	0x03, 0xe2, 0xae, 0x8a, 0x0c, 0xf8, 0x29, 0x98, 0xc1, 0xa4, 0x7f, 0x2e, 0x25, 0x5e, 0x12, 0xe2,
+	0xd3, 0x03, 0x42, 0xbd, 0x36, 0x8f, 0x00, 0x18, 0x60, 0xc2, 0xd2, 0xdb, 0x2b, 0x4b, 0xf5, 0xff,
+	0x7e, 0x9d, 0xeb, 0x4e, 0x3f, 0xc3, 0x1d, 0xca, 0x86, 0x01, 0xb8, 0x7d, 0xd1, 0x54, 0x71, 0xed,
+	0xa7, 0xec, 0xcf, 0xc7, 0x6b, 0xd1, 0xdb, 0xd7, 0xcb, 0x40, 0x99, 0xd7, 0x44, 0x81, 0x7b, 0xeb,
+	0x42, 0x3b, 0xa0, 0x0f, 0x6e, 0x71, 0xba, 0x8f, 0x08, 0xf3, 0x3a, 0x59, 0x9a, 0xbf, 0x42, 0x37,
+	0xc7, 0xde, 0xc3, 0x21, 0x7c, 0x68, 0x0f, 0x87, 0x70, 0xb7, 0x22, 0x25, 0x6d, 0xa1, 0x08, 0x39,
+	0x98, 0x13, 0x31, 0x7e, 0x81, 0x3c, 0xb6, 0xe7, 0xa7, 0xc8, 0x4b, 0x45, 0xcb, 0x99, 0x3e, 0x59,
+	0x2b, 0x2e, 0x96, 0x57, 0x1e, 0xbc, 0xcb, 0x9f, 0x6d, 0x95, 0xdd, 0xce, 0x93, 0xe5, 0xb8, 0x88,
+	0xc9, 0xb1, 0x4b, 0x79, 0x95, 0x6e, 0x95, 0x5f, 0xe5, 0x99, 0x75, 0xa2, 0x01, 0xfd, 0xba, 0x44,
+	0x78, 0x0f, 0x4c, 0xc9, 0x1a, 0x3c, 0x1c, 0x8a, 0x21, 0x2b, 0xb9, 0x93, 0x12, 0x70, 0x42, 0xb8,
+	0x04, 0x66, 0x64, 0x99, 0x42, 0xd7, 0x0b, 0x11, 0xa1, 0x89, 0x98, 0xa6, 0x29, 0xf7, 0x7d, 0x41,
+	0x08, 0xd9, 0x66, 0x0e, 0xe7, 0x3d, 0x52, 0xf6, 0x89, 0xaa, 0x51, 0xa8, 0x17, 0xc7, 0xf6, 0x6f,
+	0x44, 0x8f, 0xa4, 0x66, 0x5b, 0x4a, 0x2e, 0xfd, 0x00, 0xc0, 0x60, 0x44, 0xe0, 0x02, 0x98, 0x73,
+	0x5a, 0x1b, 0xee, 0xc3, 0xb5, 0x6d, 0x67, 0xb3, 0xe5, 0xed, 0xb4, 0xda, 0x5b, 0xeb, 0x6b, 0xce,
+	0x86, 0xb3, 0xde, 0x9c, 0x2e, 0x5c, 0xe2, 0x9a, 0x9b, 0x3b, 0xf6, 0xd7, 0xeb, 0x5e, 0xdb, 0xf9,
+	0xb2, 0x35, 0xad, 0xc1, 0x79, 0x30, 0x7b, 0x81, 0x7b, 0xdc, 0xda, 0x76, 0xbe, 0x59, 0x9f, 0xbe,
+	0x61, 0x3f, 0xf9, 0xf5, 0xd4, 0xd0, 0x4e, 0x4e, 0x0d, 0xed, 0xcd, 0xa9, 0xa1, 0xfd, 0x75, 0x6a,
+	0x68, 0xc7, 0x67, 0x46, 0xe1, 0xcd, 0x99, 0x51, 0xf8, 0xed, 0xcc, 0x28, 0x7c, 0xff, 0xc5, 0xd0,
+	0x09, 0xf0, 0xd3, 0x38, 0x63, 0x98, 0x12, 0x4c, 0x82, 0x86, 0xec, 0x19, 0xe6, 0x47, 0xcb, 0xaa,
+	0x6f, 0xcb, 0x09, 0x0d, 0xb3, 0x18, 0x35, 0x9e, 0xf7, 0x3f, 0x4e, 0xe4, 0xf9, 0x3a, 0x13, 0xe2,
+	0x92, 0x7d, 0xf6, 0xef, 0x00, 0x06, 0xb4, 0x2c, 0x1f, 0xc6, 0x08, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -307,6 +518,86 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SlashRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashRecord)
+	if !ok {
+		that2, ok := that.(SlashRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.TokensBurned.Equal(that1.TokensBurned) {
+		return false
+	}
+	if len(this.TokenizeShareRecords) != len(that1.TokenizeShareRecords) {
+		return false
+	}
+	for i := range this.TokenizeShareRecords {
+		if !this.TokenizeShareRecords[i].Equal(&that1.TokenizeShareRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TokenizeShareRecordSlash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecordSlash)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecordSlash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if this.ShareTokenDenom != that1.ShareTokenDenom {
+		return false
+	}
+	if !this.TokensSlashed.Equal(that1.TokensSlashed) {
+		return false
+	}
+	return true
+}
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -436,6 +727,138 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.TokensBurned.Size()
+		i -= size
+		if _, err := m.TokensBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecordSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecordSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecordSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokensSlashed.Size()
+		i -= size
+		if _, err := m.TokensSlashed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ShareTokenDenom) > 0 {
+		i -= len(m.ShareTokenDenom)
+		copy(dAtA[i:], m.ShareTokenDenom)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ShareTokenDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -494,22 +917,74 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSlashing
-			}
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.InfractionHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionHeight))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.TokensBurned.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareRecordSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		n += 1 + sovSlashing(uint64(m.RecordId))
+	}
+	l = len(m.ShareTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.TokensSlashed.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -893,6 +1368,415 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecordSlash{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecordSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecordSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecordSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareTokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareTokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensSlashed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSlashing(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,6 +14,11 @@ const (
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
 	FlagSharesFraction      = "shares-fraction"
+	FlagOwner               = "owner"
+	FlagSplitRewards        = "split-rewards"
+	FlagDisabled            = "disabled"
+	FlagAllowedOwners       = "allowed-owners"
+	FlagValidatorBond       = "validator-bond"
 
 	FlagMoniker         = "moniker"
 	FlagEditMoniker     = "new-moniker"
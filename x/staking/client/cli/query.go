@@ -45,6 +45,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAllTokenizeShareRecords(),
 		GetCmdQueryLastTokenizeShareRecordId(),
 		GetCmdQueryTotalTokenizeSharedAssets(),
+		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryValidatorLiquidStaking(),
+		GetCmdQueryTokenizedValueOwned(),
+		GetCmdQueryValidatorBondFactor(),
+		GetCmdQueryValidatorBondFactorOverrides(),
+		GetCmdQueryValidatorLiquidTokensSlashed(),
+		GetCmdQueryShareTokenExchangeRate(),
 	)
 
 	return stakingQueryCmd
@@ -843,8 +850,10 @@ func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
 
 Example:
 $ %s query staking tokenize-share-records-owned [owner]
+$ %s query staking tokenize-share-records-owned [owner] --validator=[validator] --limit=10
 `,
 				version.AppName,
+				version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -859,8 +868,20 @@ $ %s query staking tokenize-share-records-owned [owner]
 				return err
 			}
 
+			validator, err := cmd.Flags().GetString(FlagAddressValidator)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{
-				Owner: owner.String(),
+				Owner:      owner.String(),
+				Validator:  validator,
+				Pagination: pageReq,
 			})
 			if err != nil {
 				return err
@@ -870,7 +891,9 @@ $ %s query staking tokenize-share-records-owned [owner]
 		},
 	}
 
+	cmd.Flags().String(FlagAddressValidator, "", "Only return the records of this validator")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
 
 	return cmd
 }
@@ -886,8 +909,10 @@ func GetCmdQueryAllTokenizeShareRecords() *cobra.Command {
 
 Example:
 $ %s query staking all-tokenize-share-records
+$ %s query staking all-tokenize-share-records --validator=[validator] --owner=[owner] --limit=10
 `,
 				version.AppName,
+				version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -897,7 +922,26 @@ $ %s query staking all-tokenize-share-records
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AllTokenizeShareRecords(cmd.Context(), &types.QueryAllTokenizeShareRecordsRequest{})
+			validator, err := cmd.Flags().GetString(FlagAddressValidator)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllTokenizeShareRecords(cmd.Context(), &types.QueryAllTokenizeShareRecordsRequest{
+				Validator:  validator,
+				Owner:      owner,
+				Pagination: pageReq,
+			})
 			if err != nil {
 				return err
 			}
@@ -906,7 +950,10 @@ $ %s query staking all-tokenize-share-records
 		},
 	}
 
+	cmd.Flags().String(FlagAddressValidator, "", "Only return the records of this validator")
+	cmd.Flags().String(FlagOwner, "", "Only return the records owned by this address")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
 
 	return cmd
 }
@@ -982,3 +1029,325 @@ $ %s query staking total-tokenize-share-assets
 
 	return cmd
 }
+
+// GetCmdQueryTotalLiquidStaked implements the query for total liquid staked tokens
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query for total liquid staked tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for total number of liquid staked tokens.
+Liquid staked tokens are identified as either a tokenized delegation,
+or tokens owned by a module account (e.g. an interchain account).
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorLiquidStaking implements the query for the liquid staking totals of a validator
+func GetCmdQueryValidatorLiquidStaking() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-liquid-staking [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the liquid staking totals of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the liquid and validator bond shares of a validator, their value in tokens,
+the remaining liquid staking capacity under the validator bond factor and the validator bond delegators.
+
+Example:
+$ %s query staking validator-liquid-staking %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorLiquidStaking(cmd.Context(), &types.QueryValidatorLiquidStakingRequest{
+				ValidatorAddr: valAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizedValueOwned implements the query for the value of the tokenize share records of an owner
+func GetCmdQueryTokenizedValueOwned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenized-value-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the value of the tokenize share records of an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the value in tokens of the delegations backing all the tokenize share records of an owner.
+
+Example:
+$ %s query staking tokenized-value-owned [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizedValueOwned(cmd.Context(), &types.QueryTokenizedValueOwnedRequest{
+				Owner: owner.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorBondFactor implements the query for the validator bond factor applied to a validator
+func GetCmdQueryValidatorBondFactor() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-bond-factor [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validator bond factor applied to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator bond factor applied to a validator, which is its override
+if governance set one and the validator bond factor param otherwise.
+
+Example:
+$ %s query staking validator-bond-factor %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorBondFactor(cmd.Context(), &types.QueryValidatorBondFactorRequest{
+				ValidatorAddr: valAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorBondFactorOverrides implements the query for all the validator bond factor overrides
+func GetCmdQueryValidatorBondFactorOverrides() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-bond-factor-overrides",
+		Args:  cobra.NoArgs,
+		Short: "Query all the per validator overrides of the validator bond factor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the per validator overrides of the validator bond factor.
+
+Example:
+$ %s query staking validator-bond-factor-overrides
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorBondFactorOverrides(cmd.Context(), &types.QueryValidatorBondFactorOverridesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator bond factor overrides")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorLiquidTokensSlashed implements the query for the cumulative liquid tokens slashed of a validator
+func GetCmdQueryValidatorLiquidTokensSlashed() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-liquid-tokens-slashed [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the liquid tokens burned by the slashes of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative amount of tokens backing the liquid shares of a validator
+that were burned by its slashes.
+
+Example:
+$ %s query staking validator-liquid-tokens-slashed %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorLiquidTokensSlashed(cmd.Context(), &types.QueryValidatorLiquidTokensSlashedRequest{
+				ValidatorAddr: valAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryShareTokenExchangeRate implements the query of the exchange rate of share tokens
+func GetCmdQueryShareTokenExchangeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share-token-exchange-rate [share-tokens]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Query the tokens that share tokens would be redeemed for",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bond denom tokens that share tokens would be redeemed for at the current
+exchange rate of their tokenize share record, along with the underlying delegation shares and the
+status of the validator. Several share tokens can be queried at once.
+
+Example:
+$ %s query staking share-token-exchange-rate 1000%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			shareTokens := make([]sdk.Coin, len(args))
+			for i, arg := range args {
+				shareTokens[i], err = sdk.ParseCoinNormalized(arg)
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(shareTokens) == 1 {
+				res, err := queryClient.ShareTokenExchangeRate(cmd.Context(), &types.QueryShareTokenExchangeRateRequest{
+					ShareTokens: shareTokens[0],
+				})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.ShareTokenExchangeRates(cmd.Context(), &types.QueryShareTokenExchangeRatesRequest{
+				ShareTokens: shareTokens,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -45,9 +45,17 @@ func NewTxCmd() *cobra.Command {
 		NewUnbondCmd(),
 		NewUnbondValidatorCmd(),
 		NewTokenizeSharesCmd(),
+		NewTokenizeSharesBatchCmd(),
+		NewTokenizeUnbondingDelegationCmd(),
 		NewRedeemTokensCmd(),
+		NewRedeemTokensAndRedelegateCmd(),
 		NewTransferTokenizeShareRecordCmd(),
-		NewExemptDelegationCmd(),
+		NewEnableTokenizeShareRecordSplitRewardsCmd(),
+		NewSetTokenizeShareRecordCompoundRewardsCmd(),
+		NewMergeTokenizeShareRecordsCmd(),
+		NewValidatorBondCmd(),
+		NewRevokeValidatorBondCmd(),
+		NewSetTokenizeSharesPolicyCmd(),
 	)
 
 	return stakingTxCmd
@@ -81,6 +89,7 @@ func NewCreateValidatorCmd() *cobra.Command {
 
 	cmd.Flags().String(FlagIP, "", fmt.Sprintf("The node's public IP. It takes effect only when used in combination with --%s", flags.FlagGenerateOnly))
 	cmd.Flags().String(FlagNodeID, "", "The node's ID")
+	cmd.Flags().Bool(FlagValidatorBond, false, "Mark the initial self-delegation as a validator bond")
 	flags.AddTxFlagsToCmd(cmd)
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
@@ -344,6 +353,7 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 	if err != nil {
 		return txf, nil, err
 	}
+	msg.ValidatorBond, _ = fs.GetBool(FlagValidatorBond)
 	if err := msg.ValidateBasic(); err != nil {
 		return txf, nil, err
 	}
@@ -575,17 +585,158 @@ $ %s tx staking tokenize-share %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stak
 				return err
 			}
 
+			splitRewards, err := cmd.Flags().GetBool(FlagSplitRewards)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgTokenizeShares{
 				DelegatorAddress:    delAddr.String(),
 				ValidatorAddress:    valAddr.String(),
 				Amount:              amount,
 				TokenizedShareOwner: rewardOwner.String(),
+				SplitRewards:        splitRewards,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagSplitRewards, false, "Split the rewards of the tokenize share record between the share token holders")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTokenizeSharesBatchCmd defines a command for tokenizing shares from multiple validators.
+func NewTokenizeSharesBatchCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-batch [rewardOwner] [validator-addr:amount]...",
+		Short: "Tokenize delegations to multiple validators to share tokens",
+		Args:  cobra.MinimumNArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tokenize delegations to multiple validators to share tokens.
+Each delegation is tokenized to its own tokenize share record.
+
+Example:
+$ %s tx staking tokenize-share-batch %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj:100stake %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm:200stake --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+
+			rewardOwner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			entries := make([]types.TokenizeSharesBatchEntry, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				valAddrStr, amountStr, ok := strings.Cut(arg, ":")
+				if !ok {
+					return fmt.Errorf("invalid entry %s, expected [validator-addr]:[amount]", arg)
+				}
+
+				valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+				if err != nil {
+					return err
+				}
+
+				amount, err := sdk.ParseCoinNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+
+				entries = append(entries, types.TokenizeSharesBatchEntry{
+					ValidatorAddress: valAddr.String(),
+					Amount:           amount,
+				})
+			}
+
+			splitRewards, err := cmd.Flags().GetBool(FlagSplitRewards)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeSharesBatch(delAddr, entries, rewardOwner)
+			msg.SplitRewards = splitRewards
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagSplitRewards, false, "Split the rewards of the tokenize share records between the share token holders")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTokenizeUnbondingDelegationCmd defines a command for tokenizing the balance of an unbonding delegation entry.
+func NewTokenizeUnbondingDelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-unbonding-delegation [validator-addr] [amount] [creation-height] [rewardOwner]",
+		Short: "Tokenize the balance of an unbonding delegation entry to share tokens",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an unbonding delegation entry and tokenize the delegation to share tokens.
+
+Example:
+$ %s tx staking tokenize-unbonding-delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake 123456 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			creationHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			rewardOwner, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+
+			splitRewards, err := cmd.Flags().GetBool(FlagSplitRewards)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeUnbondingDelegation(delAddr, valAddr, amount, creationHeight, rewardOwner)
+			msg.SplitRewards = splitRewards
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagSplitRewards, false, "Split the rewards of the tokenize share record between the share token holders")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -632,6 +783,69 @@ $ %s tx staking redeem-tokens 100sharetoken --from mykey
 	return cmd
 }
 
+// NewRedeemTokensAndRedelegateCmd defines a command for redeeming share tokens and redelegating
+// the redeemed delegation to another validator.
+func NewRedeemTokensAndRedelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens-and-redelegate [amount] [dst-validator-addr]",
+		Short: "Redeem share tokens and redelegate the delegation to another validator",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem share tokens and redelegate the delegation to another validator.
+The redelegated delegation is tokenized again if an owner is given.
+
+Example:
+$ %s tx staking redeem-tokens-and-redelegate 100sharetoken %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --from mykey
+$ %s tx staking redeem-tokens-and-redelegate 100sharetoken %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --owner %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			valDstAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			splitRewards, err := cmd.Flags().GetBool(FlagSplitRewards)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensAndRedelegate(delAddr, amount, valDstAddr)
+			msg.TokenizedShareOwner = owner
+			msg.SplitRewards = splitRewards
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagOwner, "", "Tokenize the redelegated delegation to a tokenize share record owned by this address")
+	cmd.Flags().Bool(FlagSplitRewards, false, "Split the rewards of the new tokenize share record between the share token holders")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewTransferTokenizeShareRecordCmd defines a command to transfer ownership of TokenizeShareRecord
 func NewTransferTokenizeShareRecordCmd() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -680,17 +894,19 @@ $ %s tx staking transfer-tokenize-share-record 1 %s1gghjut3ccd8ay0zduzj64hwre2fx
 	return cmd
 }
 
-// NewExemptDelegationCmd defines a command to make delegation to a validator as exempt delegation
-func NewExemptDelegationCmd() *cobra.Command {
+// NewEnableTokenizeShareRecordSplitRewardsCmd defines a command to split the rewards of a tokenize share record
+// between its share token holders.
+func NewEnableTokenizeShareRecordSplitRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "exempt-delegation [validator]",
-		Short: "Make delegation to a validator as exempt delegation",
+		Use:   "enable-tokenize-share-record-split-rewards [record-id]",
+		Short: "Split the rewards of a TokenizeShareRecord between its share token holders",
 		Args:  cobra.ExactArgs(1),
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Make delegation to a validator as exempt delegation.
+			fmt.Sprintf(`Split the rewards of a TokenizeShareRecord between its share token holders.
+The rewards accrued so far are withdrawn to the owner. This cannot be undone.
 
 Example:
-$ %s tx staking exempt-delegation cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62jkqv3h --from mykey
+$ %s tx staking enable-tokenize-share-record-split-rewards 1 --from mykey
 `,
 				version.AppName,
 			),
@@ -701,7 +917,168 @@ $ %s tx staking exempt-delegation cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62
 				return err
 			}
 
-			msg := &types.MsgExemptDelegation{
+			recordId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnableTokenizeShareRecordSplitRewards(clientCtx.GetFromAddress(), uint64(recordId))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetTokenizeShareRecordCompoundRewardsCmd defines a command to enable or disable the restaking of
+// the bond denom rewards of a tokenize share record.
+func NewSetTokenizeShareRecordCompoundRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-tokenize-share-record-compound-rewards [record-id] [enabled]",
+		Short: "Enable or disable the restaking of the bond denom rewards of a TokenizeShareRecord",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the restaking of the bond denom rewards of a TokenizeShareRecord to its validator,
+which increases the tokens behind each share token. The rewards in other denoms are still paid to the owner,
+and the rewards accrued so far are settled under the current mode first.
+
+Example:
+$ %s tx staking set-tokenize-share-record-compound-rewards 1 true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTokenizeShareRecordCompoundRewards(clientCtx.GetFromAddress(), uint64(recordId), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMergeTokenizeShareRecordsCmd defines a command to merge tokenize share records into a surviving record
+func NewMergeTokenizeShareRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-tokenize-share-records [record-id] [merged-record-id]...",
+		Short: "Merge TokenizeShareRecords with the same validator into a surviving record",
+		Args:  cobra.MinimumNArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Merge TokenizeShareRecords with the same validator into the first record given.
+All the share tokens of the merged records must be held by the owner, and are exchanged
+for share tokens of the surviving record. The merged records are deleted.
+
+Example:
+$ %s tx staking merge-tokenize-share-records 1 2 3 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordIds := make([]uint64, len(args))
+			for i, arg := range args {
+				recordIds[i], err = strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgMergeTokenizeShareRecords(clientCtx.GetFromAddress(), recordIds[0], recordIds[1:])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewValidatorBondCmd defines a command to mark a delegation as a validator self-bond
+func NewValidatorBondCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validator-bond [validator]",
+		Aliases: []string{"exempt-delegation"},
+		Short:   "Mark a delegation as a validator self-bond",
+		Args:    cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mark a delegation as a validator self-bond.
+
+Example:
+$ %s tx staking validator-bond cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62jkqv3h --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgValidatorBond{
+				DelegatorAddress: clientCtx.GetFromAddress().String(),
+				ValidatorAddress: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRevokeValidatorBondCmd defines a command to remove the validator self-bond flag from a delegation
+func NewRevokeValidatorBondCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-validator-bond [validator]",
+		Short: "Remove the validator self-bond flag from a delegation",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the validator self-bond flag from a delegation.
+The remaining validator bond shares must still cover the validator's liquid shares.
+
+Example:
+$ %s tx staking revoke-validator-bond cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62jkqv3h --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeValidatorBond{
 				DelegatorAddress: clientCtx.GetFromAddress().String(),
 				ValidatorAddress: args[0],
 			}
@@ -714,3 +1091,56 @@ $ %s tx staking exempt-delegation cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62
 
 	return cmd
 }
+
+// NewSetTokenizeSharesPolicyCmd defines a command for a validator operator to disable or restrict
+// new tokenizations of delegations to the validator.
+func NewSetTokenizeSharesPolicyCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-tokenize-shares-policy",
+		Short: "Disable or restrict tokenization of delegations to your validator",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable or restrict tokenization of delegations to your validator.
+Without flags, any share owner is allowed. Existing tokenize share records are not affected.
+
+Example:
+$ %s tx staking set-tokenize-shares-policy --disabled --from mykey
+$ %s tx staking set-tokenize-shares-policy --allowed-owners %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			disabled, err := cmd.Flags().GetBool(FlagDisabled)
+			if err != nil {
+				return err
+			}
+
+			allowedOwners, err := cmd.Flags().GetStringSlice(FlagAllowedOwners)
+			if err != nil {
+				return err
+			}
+
+			policy := types.TokenizeSharesPolicy{
+				Disabled:      disabled,
+				AllowedOwners: allowedOwners,
+			}
+			msg := types.NewMsgSetTokenizeSharesPolicy(sdk.ValAddress(clientCtx.GetFromAddress()), policy)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDisabled, false, "Disable new tokenizations of delegations to the validator")
+	cmd.Flags().StringSlice(FlagAllowedOwners, nil, "Comma separated share owner addresses allowed to tokenize delegations to the validator")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
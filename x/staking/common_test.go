@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -47,8 +48,9 @@ func getBaseSimappWithCustomKeeper(t *testing.T) (*codec.LegacyAmino, *simapp.Si
 		appCodec,
 		app.GetKey(types.StoreKey),
 		app.AccountKeeper,
-		app.BankKeeper,
+		simapp.NewStakingBankKeeper(app.BankKeeper, app.GetKey(banktypes.StoreKey)),
 		app.GetSubspace(types.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 	app.StakingKeeper.SetParams(ctx, types.DefaultParams())
 
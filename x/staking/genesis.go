@@ -44,9 +44,64 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateValidatorBondFactorOverrides(data.ValidatorBondFactorOverrides); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateValidatorLiquidTokensSlashed(data.ValidatorLiquidTokensSlashed); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateValidatorBondFactorOverrides(overrides []types.ValidatorBondFactorOverride) error {
+	addrMap := make(map[string]bool, len(overrides))
+
+	for _, override := range overrides {
+		if _, err := sdk.ValAddressFromBech32(override.ValidatorAddress); err != nil {
+			return err
+		}
+
+		if addrMap[override.ValidatorAddress] {
+			return fmt.Errorf("duplicate validator bond factor override in genesis state: validator %s", override.ValidatorAddress)
+		}
+
+		if override.ValidatorBondFactor.IsNil() ||
+			(override.ValidatorBondFactor.IsNegative() && !override.ValidatorBondFactor.Equal(sdk.NewDec(-1))) {
+			return fmt.Errorf("invalid validator bond factor override in genesis state: validator %s, factor %s",
+				override.ValidatorAddress, override.ValidatorBondFactor)
+		}
+
+		addrMap[override.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateValidatorLiquidTokensSlashed(slashes []types.ValidatorLiquidTokensSlashed) error {
+	addrMap := make(map[string]bool, len(slashes))
+
+	for _, slashed := range slashes {
+		if _, err := sdk.ValAddressFromBech32(slashed.ValidatorAddress); err != nil {
+			return err
+		}
+
+		if addrMap[slashed.ValidatorAddress] {
+			return fmt.Errorf("duplicate validator liquid tokens slashed in genesis state: validator %s", slashed.ValidatorAddress)
+		}
+
+		if slashed.LiquidTokensSlashed.IsNil() || slashed.LiquidTokensSlashed.IsNegative() {
+			return fmt.Errorf("invalid validator liquid tokens slashed in genesis state: validator %s, tokens %s",
+				slashed.ValidatorAddress, slashed.LiquidTokensSlashed)
+		}
+
+		addrMap[slashed.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
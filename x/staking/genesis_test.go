@@ -48,6 +48,36 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = sdkstaking.Bonded
 		}, true},
+		// validate validator bond factor overrides
+		{"validator bond factor override", func(data *types.GenesisState) {
+			data.ValidatorBondFactorOverrides = []types.ValidatorBondFactorOverride{
+				{ValidatorAddress: genValidators1[0].OperatorAddress, ValidatorBondFactor: sdk.NewDec(5)},
+			}
+		}, false},
+		{"duplicate validator bond factor override", func(data *types.GenesisState) {
+			override := types.ValidatorBondFactorOverride{ValidatorAddress: genValidators1[0].OperatorAddress, ValidatorBondFactor: sdk.NewDec(5)}
+			data.ValidatorBondFactorOverrides = []types.ValidatorBondFactorOverride{override, override}
+		}, true},
+		{"invalid validator bond factor override", func(data *types.GenesisState) {
+			data.ValidatorBondFactorOverrides = []types.ValidatorBondFactorOverride{
+				{ValidatorAddress: genValidators1[0].OperatorAddress, ValidatorBondFactor: sdk.NewDec(-2)},
+			}
+		}, true},
+		// validate validator liquid tokens slashed
+		{"validator liquid tokens slashed", func(data *types.GenesisState) {
+			data.ValidatorLiquidTokensSlashed = []types.ValidatorLiquidTokensSlashed{
+				{ValidatorAddress: genValidators1[0].OperatorAddress, LiquidTokensSlashed: sdk.NewDec(5)},
+			}
+		}, false},
+		{"duplicate validator liquid tokens slashed", func(data *types.GenesisState) {
+			slashed := types.ValidatorLiquidTokensSlashed{ValidatorAddress: genValidators1[0].OperatorAddress, LiquidTokensSlashed: sdk.NewDec(5)}
+			data.ValidatorLiquidTokensSlashed = []types.ValidatorLiquidTokensSlashed{slashed, slashed}
+		}, true},
+		{"negative validator liquid tokens slashed", func(data *types.GenesisState) {
+			data.ValidatorLiquidTokensSlashed = []types.ValidatorLiquidTokensSlashed{
+				{ValidatorAddress: genValidators1[0].OperatorAddress, LiquidTokensSlashed: sdk.NewDec(-1)},
+			}
+		}, true},
 	}
 
 	for _, tt := range tests {
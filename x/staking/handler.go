@@ -44,13 +44,62 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgTokenizeSharesBatch:
+			res, err := msgServer.TokenizeSharesBatch(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgTokenizeUnbondingDelegation:
+			res, err := msgServer.TokenizeUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgRedeemTokensforShares:
 			res, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgRedeemTokensAndRedelegate:
+			res, err := msgServer.RedeemTokensAndRedelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgTransferTokenizeShareRecord:
 			res, err := msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgEnableTokenizeShareRecordSplitRewards:
+			res, err := msgServer.EnableTokenizeShareRecordSplitRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetTokenizeShareRecordCompoundRewards:
+			res, err := msgServer.SetTokenizeShareRecordCompoundRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgMergeTokenizeShareRecords:
+			res, err := msgServer.MergeTokenizeShareRecords(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgValidatorBond:
+			res, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevokeValidatorBond:
+			res, err := msgServer.RevokeValidatorBond(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetTokenizeSharesPolicy:
+			res, err := msgServer.SetTokenizeSharesPolicy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUpdateParams:
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetValidatorBondFactorOverride:
+			res, err := msgServer.SetValidatorBondFactorOverride(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRemoveValidatorBondFactorOverride:
+			res, err := msgServer.RemoveValidatorBondFactorOverride(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgExemptDelegation:
+			res, err := msgServer.ExemptDelegation(sdk.WrapSDKContext(ctx), msg) //nolint:staticcheck // kept for one release
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
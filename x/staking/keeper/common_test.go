@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
@@ -31,8 +32,9 @@ func createTestInput(t *testing.T) (*codec.LegacyAmino, *simapp.SimApp, sdk.Cont
 		app.AppCodec(),
 		app.GetKey(types.StoreKey),
 		app.AccountKeeper,
-		app.BankKeeper,
+		simapp.NewStakingBankKeeper(app.BankKeeper, app.GetKey(banktypes.StoreKey)),
 		app.GetSubspace(types.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 	return app.LegacyAmino(), app, ctx
 }
@@ -114,6 +114,10 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetLiquidDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+
+	if delegation.ValidatorBond {
+		store.Set(types.GetValidatorBondDelegationByValidatorKey(delegation.GetValidatorAddr(), delegatorAddress), []byte{})
+	}
 }
 
 // RemoveDelegation removes a delegation
@@ -127,9 +131,36 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetLiquidDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+
+	if delegation.ValidatorBond {
+		k.RemoveValidatorBondDelegationIndex(ctx, delegation.GetValidatorAddr(), delegatorAddress)
+	}
 	return nil
 }
 
+// RemoveValidatorBondDelegationIndex removes a delegation from the validator bond delegation index of
+// its validator, which SetDelegation maintains for validator bond delegations
+func (k Keeper) RemoveValidatorBondDelegationIndex(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorBondDelegationByValidatorKey(valAddr, delAddr))
+}
+
+// GetValidatorBondDelegators returns the delegators of the validator bond delegations of a validator
+func (k Keeper) GetValidatorBondDelegators(ctx sdk.Context, valAddr sdk.ValAddress) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetValidatorBondDelegationsByValidatorPrefix(valAddr)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	delegators := []sdk.AccAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		// the key ends with the length prefixed delegator address
+		delegators = append(delegators, sdk.AccAddress(iterator.Key()[len(prefix)+1:]))
+	}
+	return delegators
+}
+
 // GetUnbondingDelegations returns a given amount of all the delegator unbonding-delegations.
 func (k Keeper) GetUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) (unbondingDelegations []types.UnbondingDelegation) {
 	unbondingDelegations = make([]types.UnbondingDelegation, maxRetrieve)
@@ -700,12 +731,19 @@ func (k Keeper) Delegate(
 		}
 	}
 
-	_, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
 
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
 
+	// If the delegation is a validator bond, the new shares (from either a delegation
+	// or a redelegation) are added to the validator's total validator bond shares
+	if delegation.ValidatorBond {
+		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+	}
+
 	// Call the after-modification hook
 	if err := k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
 		return newShares, err
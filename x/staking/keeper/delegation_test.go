@@ -483,7 +483,7 @@ func TestRedelegationMaxEntries(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func TestExemptDelegationUndelegate(t *testing.T) {
+func TestValidatorBondUndelegate(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 
 	addrDels := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
@@ -501,18 +501,18 @@ func TestExemptDelegationUndelegate(t *testing.T) {
 	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
 	app.StakingKeeper.SetValidator(ctx, validator)
 
-	// set exemption factor
+	// set validator bond factor
 	params := app.StakingKeeper.GetParams(ctx)
-	params.ExemptionFactor = sdk.NewDec(1)
+	params.ValidatorBondFactor = sdk.NewDec(1)
 	app.StakingKeeper.SetParams(ctx, params)
 
-	// convert to exempt delegation
+	// convert to validator bond delegation
 	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
 
 	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
 	err := delegateCoinsFromAccount(ctx, app, addrDels[0], startTokens, validator)
 	require.NoError(t, err)
-	_, err = msgServer.ExemptDelegation(sdk.WrapSDKContext(ctx), &types.MsgExemptDelegation{
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
 		DelegatorAddress: addrDels[0].String(),
 		ValidatorAddress: addrVals[0].String(),
 	})
@@ -557,10 +557,10 @@ func TestExemptDelegationUndelegate(t *testing.T) {
 	require.NoError(t, err)
 
 	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
-	require.Equal(t, validator.TotalExemptShares, sdk.ZeroDec())
+	require.Equal(t, validator.TotalValidatorBondShares, sdk.ZeroDec())
 }
 
-func TestExemptDelegationRedelegate(t *testing.T) {
+func TestValidatorBondRedelegate(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 
 	addrDels := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
@@ -580,18 +580,18 @@ func TestExemptDelegationRedelegate(t *testing.T) {
 	validator2 := teststaking.NewValidator(t, addrVals[1], PKs[1])
 	app.StakingKeeper.SetValidator(ctx, validator2)
 
-	// set exemption factor
+	// set validator bond factor
 	params := app.StakingKeeper.GetParams(ctx)
-	params.ExemptionFactor = sdk.NewDec(1)
+	params.ValidatorBondFactor = sdk.NewDec(1)
 	app.StakingKeeper.SetParams(ctx, params)
 
-	// convert to exempt delegation
+	// convert to validator bond delegation
 	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
 
 	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
 	err := delegateCoinsFromAccount(ctx, app, addrDels[0], startTokens, validator)
 	require.NoError(t, err)
-	_, err = msgServer.ExemptDelegation(sdk.WrapSDKContext(ctx), &types.MsgExemptDelegation{
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
 		DelegatorAddress: addrDels[0].String(),
 		ValidatorAddress: addrVals[0].String(),
 	})
@@ -638,5 +638,5 @@ func TestExemptDelegationRedelegate(t *testing.T) {
 	require.NoError(t, err)
 
 	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
-	require.Equal(t, validator.TotalExemptShares, sdk.ZeroDec())
+	require.Equal(t, validator.TotalValidatorBondShares, sdk.ZeroDec())
 }
@@ -26,8 +26,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	// genesis.json are in block 0.
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 
-	k.SetParams(ctx, data.Params)
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
+	k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
 
 	for _, validator := range data.Validators {
 		k.SetValidator(ctx, validator)
@@ -101,6 +104,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, override := range data.ValidatorBondFactorOverrides {
+		valAddr, err := sdk.ValAddressFromBech32(override.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorBondFactorOverride(ctx, valAddr, override.ValidatorBondFactor)
+	}
+
+	for _, slashed := range data.ValidatorLiquidTokensSlashed {
+		valAddr, err := sdk.ValAddressFromBech32(slashed.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorLiquidTokensSlashed(ctx, valAddr, slashed.LiquidTokensSlashed)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -194,13 +213,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                       k.GetParams(ctx),
+		LastTotalPower:               k.GetLastTotalPower(ctx),
+		LastValidatorPowers:          lastValidatorPowers,
+		Validators:                   k.GetAllValidators(ctx),
+		Delegations:                  k.GetAllDelegations(ctx),
+		UnbondingDelegations:         unbondingDelegations,
+		Redelegations:                redelegations,
+		Exported:                     true,
+		TotalLiquidStakedTokens:      k.GetTotalLiquidStakedTokens(ctx),
+		ValidatorBondFactorOverrides: k.GetAllValidatorBondFactorOverrides(ctx),
+		ValidatorLiquidTokensSlashed: k.GetAllValidatorLiquidTokensSlashed(ctx),
 	}
 }
@@ -7,10 +7,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -584,26 +586,118 @@ func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTo
 	if err != nil {
 		return nil, err
 	}
-	records := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+
+	records, pageRes, err := k.paginateTokenizeShareRecordIndex(
+		ctx, types.GetTokenizeShareRecordIdsByOwnerPrefix(owner), req.Pagination, req.Validator,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryTokenizeShareRecordsOwnedResponse{
-		Records: records,
+		Records:    records,
+		Pagination: pageRes,
 	}, nil
 }
 
-// Query for all tokenize share records
+// Query for all tokenize share records, optionally filtered by validator and owner
 func (k Querier) AllTokenizeShareRecords(c context.Context, req *types.QueryAllTokenizeShareRecordsRequest) (*types.QueryAllTokenizeShareRecordsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	records := k.GetAllTokenizeShareRecords(ctx)
+
+	if req.Owner != "" {
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, err
+		}
+
+		records, pageRes, err := k.paginateTokenizeShareRecordIndex(
+			ctx, types.GetTokenizeShareRecordIdsByOwnerPrefix(owner), req.Pagination, req.Validator,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryAllTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+	}
+
+	if req.Validator != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		records, pageRes, err := k.paginateTokenizeShareRecordIndex(
+			ctx, types.GetTokenizeShareRecordIdsByValidatorPrefix(valAddr), req.Pagination, "",
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryAllTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+	}
+
+	var records []types.TokenizeShareRecord
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, types.TokenizeShareRecordPrefix)
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryAllTokenizeShareRecordsResponse{
-		Records: records,
+		Records:    records,
+		Pagination: pageRes,
 	}, nil
 }
 
+// paginateTokenizeShareRecordIndex pages through the tokenize share record ids stored under
+// the given index prefix, skipping the records that are not for the validator when one is set
+func (k Querier) paginateTokenizeShareRecordIndex(
+	ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest, validator string,
+) ([]types.TokenizeShareRecord, *query.PageResponse, error) {
+	if validator != "" {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return nil, nil, err
+		}
+		validator = valAddr.String()
+	}
+
+	var records []types.TokenizeShareRecord
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+	pageRes, err := query.FilteredPaginate(indexStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var id gogotypes.UInt64Value
+		if err := k.cdc.Unmarshal(value, &id); err != nil {
+			return false, err
+		}
+
+		record, err := k.GetTokenizeShareRecord(ctx, id.Value)
+		if err != nil || (validator != "" && record.Validator != validator) {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return records, pageRes, nil
+}
+
 // Query for last tokenize share record id
 func (k Querier) LastTokenizeShareRecordId(c context.Context, req *types.QueryLastTokenizeShareRecordIdRequest) (*types.QueryLastTokenizeShareRecordIdResponse, error) {
 	if req == nil {
@@ -626,26 +720,297 @@ func (k Querier) TotalTokenizeSharedAssets(c context.Context, req *types.QueryTo
 	totalTokenizeShared := sdk.ZeroInt()
 
 	for _, record := range records {
-		moduleAcc := record.GetModuleAddress()
-		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		tokens, err := k.getTokenizeShareRecordTokens(ctx, record)
 		if err != nil {
 			return nil, err
 		}
+		totalTokenizeShared = totalTokenizeShared.Add(tokens.RoundInt())
+	}
+	return &types.QueryTotalTokenizeSharedAssetsResponse{
+		Value: sdk.NewCoin(k.BondDenom(ctx), totalTokenizeShared),
+	}, nil
+}
 
-		validator, found := k.GetLiquidValidator(ctx, valAddr)
-		if !found {
-			return nil, sdkstaking.ErrNoValidatorFound
+// Query for total liquid staked tokens
+func (k Querier) TotalLiquidStaked(c context.Context, req *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalLiquidStakedResponse{
+		Tokens: k.GetTotalLiquidStakedTokens(ctx),
+	}, nil
+}
+
+// Query for the liquid staking totals and the remaining liquid staking capacity of a validator
+func (k Querier) ValidatorLiquidStaking(c context.Context, req *types.QueryValidatorLiquidStakingRequest) (*types.QueryValidatorLiquidStakingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	// a validator without shares has no tokens to convert them to
+	tokensFromShares := func(shares sdk.Dec) sdk.Dec {
+		if validator.DelegatorShares.IsZero() {
+			return sdk.ZeroDec()
 		}
+		return validator.TokensFromShares(shares)
+	}
 
-		delegation, found := k.GetLiquidDelegation(ctx, moduleAcc, valAddr)
-		if !found {
-			return nil, sdkstaking.ErrNoDelegation
+	validatorBondFactor := k.GetValidatorBondFactor(ctx, valAddr)
+	capEnabled := !validatorBondFactor.IsNegative()
+
+	remainingLiquidShares := sdk.ZeroDec()
+	if capEnabled {
+		maxLiquidShares := validator.TotalValidatorBondShares.Mul(validatorBondFactor)
+		if maxLiquidShares.GT(validator.TotalLiquidShares) {
+			remainingLiquidShares = maxLiquidShares.Sub(validator.TotalLiquidShares)
 		}
+	}
 
-		tokens := validator.TokensFromShares(delegation.Shares)
-		totalTokenizeShared = totalTokenizeShared.Add(tokens.RoundInt())
+	validatorBondDelegators := []string{}
+	for _, delAddr := range k.GetValidatorBondDelegators(ctx, valAddr) {
+		validatorBondDelegators = append(validatorBondDelegators, delAddr.String())
 	}
-	return &types.QueryTotalTokenizeSharedAssetsResponse{
-		Value: sdk.NewCoin(k.BondDenom(ctx), totalTokenizeShared),
+
+	return &types.QueryValidatorLiquidStakingResponse{
+		LiquidShares:            validator.TotalLiquidShares,
+		LiquidTokens:            tokensFromShares(validator.TotalLiquidShares),
+		ValidatorBondShares:     validator.TotalValidatorBondShares,
+		ValidatorBondTokens:     tokensFromShares(validator.TotalValidatorBondShares),
+		ValidatorBondCapEnabled: capEnabled,
+		RemainingLiquidShares:   remainingLiquidShares,
+		RemainingLiquidTokens:   tokensFromShares(remainingLiquidShares),
+		ValidatorBondDelegators: validatorBondDelegators,
+		ValidatorBondFactor:     validatorBondFactor,
+	}, nil
+}
+
+// Query for the value of the delegations backing the tokenize share records of an owner
+func (k Querier) TokenizedValueOwned(c context.Context, req *types.QueryTokenizedValueOwnedRequest) (*types.QueryTokenizedValueOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	totalTokens := sdk.ZeroDec()
+	for _, record := range k.GetTokenizeShareRecordsByOwner(ctx, owner) {
+		tokens, err := k.getTokenizeShareRecordTokens(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+		totalTokens = totalTokens.Add(tokens)
+	}
+
+	return &types.QueryTokenizedValueOwnedResponse{
+		Value: sdk.NewCoin(k.BondDenom(ctx), totalTokens.TruncateInt()),
 	}, nil
 }
+
+// ValidatorBondFactor queries the validator bond factor applied to a validator
+func (k Querier) ValidatorBondFactor(c context.Context, req *types.QueryValidatorBondFactorRequest) (*types.QueryValidatorBondFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorBondFactor, overridden := k.GetValidatorBondFactorOverride(ctx, valAddr)
+	if !overridden {
+		validatorBondFactor = k.Keeper.ValidatorBondFactor(ctx)
+	}
+
+	return &types.QueryValidatorBondFactorResponse{
+		ValidatorBondFactor: validatorBondFactor,
+		Overridden:          overridden,
+	}, nil
+}
+
+// ValidatorBondFactorOverrides queries all the validator bond factor overrides
+func (k Querier) ValidatorBondFactorOverrides(c context.Context, req *types.QueryValidatorBondFactorOverridesRequest) (*types.QueryValidatorBondFactorOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var overrides []types.ValidatorBondFactorOverride
+	store := ctx.KVStore(k.storeKey)
+	overrideStore := prefix.NewStore(store, types.ValidatorBondFactorOverridePrefix)
+	pageRes, err := query.Paginate(overrideStore, req.Pagination, func(key []byte, value []byte) error {
+		var override types.ValidatorBondFactorOverride
+		if err := k.cdc.Unmarshal(value, &override); err != nil {
+			return err
+		}
+
+		overrides = append(overrides, override)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorBondFactorOverridesResponse{
+		Overrides:  overrides,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ValidatorLiquidTokensSlashed queries the cumulative liquid tokens slashed of a validator
+func (k Querier) ValidatorLiquidTokensSlashed(c context.Context, req *types.QueryValidatorLiquidTokensSlashedRequest) (*types.QueryValidatorLiquidTokensSlashedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorLiquidTokensSlashedResponse{
+		LiquidTokensSlashed: k.GetValidatorLiquidTokensSlashed(ctx, valAddr),
+	}, nil
+}
+
+// ShareTokenExchangeRate queries the bond denom tokens an amount of share tokens would be redeemed for
+func (k Querier) ShareTokenExchangeRate(c context.Context, req *types.QueryShareTokenExchangeRateRequest) (*types.QueryShareTokenExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRate, err := k.getShareTokenExchangeRate(ctx, req.ShareTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryShareTokenExchangeRateResponse{
+		ExchangeRate: exchangeRate,
+	}, nil
+}
+
+// ShareTokenExchangeRates queries the exchange rates of several share tokens
+func (k Querier) ShareTokenExchangeRates(c context.Context, req *types.QueryShareTokenExchangeRatesRequest) (*types.QueryShareTokenExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRates := make([]types.ShareTokenExchangeRate, 0, len(req.ShareTokens))
+	for _, shareTokens := range req.ShareTokens {
+		exchangeRate, err := k.getShareTokenExchangeRate(ctx, shareTokens)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "denom %s", shareTokens.Denom)
+		}
+		exchangeRates = append(exchangeRates, exchangeRate)
+	}
+
+	return &types.QueryShareTokenExchangeRatesResponse{
+		ExchangeRates: exchangeRates,
+	}, nil
+}
+
+// getShareTokenExchangeRate returns the tokens that share tokens would be redeemed for, computed as
+// in RedeemTokens: the shares are moduleAccountDelegationShares * amount / shareTokenSupply and are
+// converted at the validator's exchange rate, with the last shares of the validator getting its
+// remaining tokens
+func (k Querier) getShareTokenExchangeRate(ctx sdk.Context, shareTokens sdk.Coin) (types.ShareTokenExchangeRate, error) {
+	if err := shareTokens.Validate(); err != nil {
+		return types.ShareTokenExchangeRate{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	if err != nil {
+		return types.ShareTokenExchangeRate{}, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, shareTokens.Denom)
+	if shareTokens.Amount.GT(supply.Amount) {
+		return types.ShareTokenExchangeRate{}, status.Errorf(codes.InvalidArgument, "amount exceeds the share token supply %s", supply)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return types.ShareTokenExchangeRate{}, err
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return types.ShareTokenExchangeRate{}, sdkstaking.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return types.ShareTokenExchangeRate{}, sdkstaking.ErrNoDelegation
+	}
+
+	shares := sdk.ZeroDec()
+	tokens := sdk.ZeroInt()
+	if supply.Amount.IsPositive() {
+		shares = delegation.Shares.MulInt(shareTokens.Amount).QuoInt(supply.Amount)
+		_, tokens = validator.RemoveDelShares(shares)
+	}
+
+	return types.ShareTokenExchangeRate{
+		ShareTokens:      shareTokens,
+		Tokens:           sdk.NewCoin(k.BondDenom(ctx), tokens),
+		Shares:           shares,
+		RecordId:         record.Id,
+		ValidatorAddress: record.Validator,
+		ValidatorStatus:  types.BondStatus(validator.GetStatus()),
+		ValidatorJailed:  validator.IsJailed(),
+	}, nil
+}
+
+// getTokenizeShareRecordTokens returns the tokens of the delegation held by the module account
+// of a tokenize share record, at the validator's current exchange rate
+func (k Querier) getTokenizeShareRecordTokens(ctx sdk.Context, record types.TokenizeShareRecord) (sdk.Dec, error) {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, sdkstaking.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return sdk.Dec{}, sdkstaking.ErrNoDelegation
+	}
+
+	return validator.TokensFromShares(delegation.Shares), nil
+}
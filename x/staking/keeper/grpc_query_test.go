@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
@@ -795,8 +796,9 @@ func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers
 		cdc,
 		app.GetKey(types.StoreKey),
 		app.AccountKeeper,
-		app.BankKeeper,
+		simapp.NewStakingBankKeeper(app.BankKeeper, app.GetKey(banktypes.StoreKey)),
 		app.GetSubspace(types.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 
 	val1 := teststaking.NewValidator(t, valAddrs[0], pks[0])
@@ -820,3 +822,292 @@ func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers
 
 	return addrs, valAddrs, vals
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizeShareRecords() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	owner1, owner2 := addrs[0], addrs[1]
+	val1, val2 := vals[0].GetOperator(), vals[1].GetOperator()
+
+	records := []types.TokenizeShareRecord{
+		{Id: 1, Owner: owner1.String(), ModuleAccount: "tokenizeshare_1", Validator: val1.String()},
+		{Id: 2, Owner: owner1.String(), ModuleAccount: "tokenizeshare_2", Validator: val2.String()},
+		{Id: 3, Owner: owner2.String(), ModuleAccount: "tokenizeshare_3", Validator: val1.String()},
+		{Id: 4, Owner: owner1.String(), ModuleAccount: "tokenizeshare_4", Validator: val1.String()},
+	}
+	for _, record := range records {
+		suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	}
+
+	// all records, paginated
+	allRes, err := queryClient.AllTokenizeShareRecords(gocontext.Background(), &types.QueryAllTokenizeShareRecordsRequest{
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[:3], allRes.Records)
+	suite.Require().Equal(uint64(4), allRes.Pagination.Total)
+
+	allRes, err = queryClient.AllTokenizeShareRecords(gocontext.Background(), &types.QueryAllTokenizeShareRecordsRequest{
+		Pagination: &query.PageRequest{Key: allRes.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[3:], allRes.Records)
+	suite.Require().Nil(allRes.Pagination.NextKey)
+
+	// filtered by validator
+	allRes, err = queryClient.AllTokenizeShareRecords(gocontext.Background(), &types.QueryAllTokenizeShareRecordsRequest{
+		Validator:  val1.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenizeShareRecord{records[0], records[2], records[3]}, allRes.Records)
+	suite.Require().Equal(uint64(3), allRes.Pagination.Total)
+
+	// filtered by validator and owner
+	allRes, err = queryClient.AllTokenizeShareRecords(gocontext.Background(), &types.QueryAllTokenizeShareRecordsRequest{
+		Validator:  val1.String(),
+		Owner:      owner1.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenizeShareRecord{records[0], records[3]}, allRes.Records)
+	suite.Require().Equal(uint64(2), allRes.Pagination.Total)
+
+	_, err = queryClient.AllTokenizeShareRecords(gocontext.Background(), &types.QueryAllTokenizeShareRecordsRequest{
+		Validator: "invalid",
+	})
+	suite.Require().Error(err)
+
+	// owned records, paginated and filtered by validator
+	ownedRes, err := queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{
+		Owner:      owner1.String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[:2], ownedRes.Records)
+	suite.Require().Equal(uint64(3), ownedRes.Pagination.Total)
+
+	ownedRes, err = queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{
+		Owner:     owner1.String(),
+		Validator: val2.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenizeShareRecord{records[1]}, ownedRes.Records)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorLiquidStaking() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	valAddr := vals[0].GetOperator()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// addrs[0] already delegates to the validator
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(addrs[0], valAddr))
+	suite.Require().NoError(err)
+	bondDelegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrs[0], valAddr)
+	suite.Require().True(found)
+
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[1], valAddr, sdk.NewCoin(bondDenom, tokenizeAmount)))
+	suite.Require().NoError(err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    addrs[1].String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              sdk.NewCoin(bondDenom, tokenizeAmount),
+		TokenizedShareOwner: addrs[2].String(),
+	})
+	suite.Require().NoError(err)
+
+	res, err := queryClient.ValidatorLiquidStaking(gocontext.Background(), &types.QueryValidatorLiquidStakingRequest{
+		ValidatorAddr: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+
+	liquidTokens := sdk.NewDecFromInt(tokenizeAmount)
+	remainingTokens := bondDelegation.Shares.MulInt64(10).Sub(liquidTokens)
+	suite.Require().Equal(liquidTokens, res.LiquidShares)
+	suite.Require().Equal(liquidTokens, res.LiquidTokens)
+	suite.Require().Equal(bondDelegation.Shares, res.ValidatorBondShares)
+	suite.Require().Equal(bondDelegation.Shares, res.ValidatorBondTokens)
+	suite.Require().True(res.ValidatorBondCapEnabled)
+	suite.Require().Equal(remainingTokens, res.RemainingLiquidShares)
+	suite.Require().Equal(remainingTokens, res.RemainingLiquidTokens)
+	suite.Require().Equal([]string{addrs[0].String()}, res.ValidatorBondDelegators)
+	suite.Require().Equal(sdk.NewDec(10), res.ValidatorBondFactor)
+
+	// the remaining capacity is not reported when the validator bond cap is disabled
+	params.ValidatorBondFactor = sdk.NewDec(-1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	res, err = queryClient.ValidatorLiquidStaking(gocontext.Background(), &types.QueryValidatorLiquidStakingRequest{
+		ValidatorAddr: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.ValidatorBondCapEnabled)
+	suite.Require().True(res.RemainingLiquidShares.IsZero())
+
+	// the validator bond delegators are listed from an index, which is updated on revocation
+	_, err = msgServer.RevokeValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgRevokeValidatorBond(addrs[0], valAddr))
+	suite.Require().NoError(err)
+	res, err = queryClient.ValidatorLiquidStaking(gocontext.Background(), &types.QueryValidatorLiquidStakingRequest{
+		ValidatorAddr: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.ValidatorBondDelegators)
+
+	_, err = queryClient.ValidatorLiquidStaking(gocontext.Background(), &types.QueryValidatorLiquidStakingRequest{
+		ValidatorAddr: sdk.ValAddress(addrs[4]).String(),
+	})
+	suite.Require().Error(err)
+
+	// the tokenized value is reported for the record owner
+	valueRes, err := queryClient.TokenizedValueOwned(gocontext.Background(), &types.QueryTokenizedValueOwnedRequest{
+		Owner: addrs[2].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(bondDenom, tokenizeAmount), valueRes.Value)
+
+	valueRes, err = queryClient.TokenizedValueOwned(gocontext.Background(), &types.QueryTokenizedValueOwnedRequest{
+		Owner: addrs[1].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(valueRes.Value.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryShareTokenExchangeRate() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	valAddr1, valAddr2 := vals[0].GetOperator(), vals[1].GetOperator()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	var shareDenoms []string
+	for _, valAddr := range []sdk.ValAddress{valAddr1, valAddr2} {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[1], valAddr, sdk.NewCoin(bondDenom, tokenizeAmount)))
+		suite.Require().NoError(err)
+		res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    addrs[1].String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(bondDenom, tokenizeAmount),
+			TokenizedShareOwner: addrs[1].String(),
+		})
+		suite.Require().NoError(err)
+		shareDenoms = append(shareDenoms, res.Amount.Denom)
+	}
+
+	// a third of the tokens of the first validator are slashed
+	validator, _ := app.StakingKeeper.GetLiquidValidator(ctx, valAddr1)
+	app.StakingKeeper.RemoveValidatorTokens(ctx, validator, validator.Tokens.QuoRaw(3))
+
+	shareTokens := sdk.NewCoin(shareDenoms[0], tokenizeAmount.QuoRaw(2))
+	res, err := queryClient.ShareTokenExchangeRate(gocontext.Background(), &types.QueryShareTokenExchangeRateRequest{
+		ShareTokens: shareTokens,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(shareTokens, res.ExchangeRate.ShareTokens)
+	suite.Require().Equal(uint64(1), res.ExchangeRate.RecordId)
+	suite.Require().Equal(valAddr1.String(), res.ExchangeRate.ValidatorAddress)
+	suite.Require().Equal(types.Bonded, res.ExchangeRate.ValidatorStatus)
+	suite.Require().False(res.ExchangeRate.ValidatorJailed)
+	suite.Require().True(res.ExchangeRate.Tokens.Amount.LT(shareTokens.Amount))
+
+	// the share tokens are redeemed for the queried tokens
+	cacheCtx, _ := ctx.CacheContext()
+	redeemRes, err := msgServer.RedeemTokens(sdk.WrapSDKContext(cacheCtx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: addrs[1].String(),
+		Amount:           shareTokens,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(redeemRes.Amount, res.ExchangeRate.Tokens)
+
+	batchRes, err := queryClient.ShareTokenExchangeRates(gocontext.Background(), &types.QueryShareTokenExchangeRatesRequest{
+		ShareTokens: []sdk.Coin{shareTokens, sdk.NewCoin(shareDenoms[1], tokenizeAmount)},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(batchRes.ExchangeRates, 2)
+	suite.Require().Equal(res.ExchangeRate, batchRes.ExchangeRates[0])
+	suite.Require().Equal(sdk.NewCoin(bondDenom, tokenizeAmount), batchRes.ExchangeRates[1].Tokens)
+	suite.Require().Equal(sdk.NewDecFromInt(tokenizeAmount), batchRes.ExchangeRates[1].Shares)
+
+	// unknown denoms and amounts above the supply are rejected
+	_, err = queryClient.ShareTokenExchangeRate(gocontext.Background(), &types.QueryShareTokenExchangeRateRequest{
+		ShareTokens: sdk.NewCoin(bondDenom, tokenizeAmount),
+	})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ShareTokenExchangeRates(gocontext.Background(), &types.QueryShareTokenExchangeRatesRequest{
+		ShareTokens: []sdk.Coin{sdk.NewCoin(shareDenoms[1], tokenizeAmount.AddRaw(1))},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorBondFactor() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+	valAddr1, valAddr2 := vals[0].GetOperator(), vals[1].GetOperator()
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// without an override the param is reported
+	res, err := queryClient.ValidatorBondFactor(gocontext.Background(), &types.QueryValidatorBondFactorRequest{
+		ValidatorAddr: valAddr1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(10), res.ValidatorBondFactor)
+	suite.Require().False(res.Overridden)
+
+	app.StakingKeeper.SetValidatorBondFactorOverride(ctx, valAddr1, sdk.NewDec(3))
+	app.StakingKeeper.SetValidatorBondFactorOverride(ctx, valAddr2, sdk.NewDec(-1))
+
+	res, err = queryClient.ValidatorBondFactor(gocontext.Background(), &types.QueryValidatorBondFactorRequest{
+		ValidatorAddr: valAddr1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(3), res.ValidatorBondFactor)
+	suite.Require().True(res.Overridden)
+
+	_, err = queryClient.ValidatorBondFactor(gocontext.Background(), &types.QueryValidatorBondFactorRequest{})
+	suite.Require().Error(err)
+
+	overridesRes, err := queryClient.ValidatorBondFactorOverrides(gocontext.Background(), &types.QueryValidatorBondFactorOverridesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(overridesRes.Overrides, 1)
+	suite.Require().Equal(uint64(2), overridesRes.Pagination.Total)
+
+	overridesRes, err = queryClient.ValidatorBondFactorOverrides(gocontext.Background(), &types.QueryValidatorBondFactorOverridesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.ValidatorBondFactorOverride{
+		{ValidatorAddress: valAddr1.String(), ValidatorBondFactor: sdk.NewDec(3)},
+		{ValidatorAddress: valAddr2.String(), ValidatorBondFactor: sdk.NewDec(-1)},
+	}, overridesRes.Overrides)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorLiquidTokensSlashed() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+	valAddr1, valAddr2 := vals[0].GetOperator(), vals[1].GetOperator()
+
+	app.StakingKeeper.IncreaseValidatorLiquidTokensSlashed(ctx, valAddr1, sdk.NewDec(10))
+	app.StakingKeeper.IncreaseValidatorLiquidTokensSlashed(ctx, valAddr1, sdk.NewDecWithPrec(25, 1))
+
+	res, err := queryClient.ValidatorLiquidTokensSlashed(gocontext.Background(), &types.QueryValidatorLiquidTokensSlashedRequest{
+		ValidatorAddr: valAddr1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(125, 1), res.LiquidTokensSlashed)
+
+	// a validator that was never slashed reports zero
+	res, err = queryClient.ValidatorLiquidTokensSlashed(gocontext.Background(), &types.QueryValidatorLiquidTokensSlashedRequest{
+		ValidatorAddr: valAddr2.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroDec(), res.LiquidTokensSlashed)
+
+	_, err = queryClient.ValidatorLiquidTokensSlashed(gocontext.Background(), &types.QueryValidatorLiquidTokensSlashedRequest{})
+	suite.Require().Error(err)
+}
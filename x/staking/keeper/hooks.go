@@ -39,6 +39,22 @@ func (k Keeper) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordId uint6
 	return nil
 }
 
+// BeforeTokenizeShareRecordModified - call hook if registered
+func (k Keeper) BeforeTokenizeShareRecordModified(ctx sdk.Context, recordId uint64) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeTokenizeShareRecordModified(ctx, recordId)
+	}
+	return nil
+}
+
+// BeforeTokenizeShareRecordRedeemed - call hook if registered
+func (k Keeper) BeforeTokenizeShareRecordRedeemed(ctx sdk.Context, recordId uint64, redeemer sdk.AccAddress, fraction sdk.Dec) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeTokenizeShareRecordRedeemed(ctx, recordId, redeemer, fraction)
+	}
+	return nil
+}
+
 // AfterValidatorBonded - call hook if registered
 func (k Keeper) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	if k.hooks != nil {
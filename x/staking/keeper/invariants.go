@@ -19,6 +19,10 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-bond-shares",
+		ValidatorBondSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "liquid-shares",
+		LiquidSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -39,7 +43,17 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ValidatorBondSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return LiquidSharesInvariant(k)(ctx)
 	}
 }
 
@@ -195,3 +209,87 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// ValidatorBondSharesInvariant checks whether the shares of all validator bond
+// delegations add up to the total validator bond shares stored in each validator.
+func ValidatorBondSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validators := k.GetAllValidators(ctx)
+		validatorBondShares := map[string]sdk.Dec{}
+
+		for _, validator := range validators {
+			validatorBondShares[validator.GetOperator().String()] = sdk.ZeroDec()
+		}
+
+		for _, delegation := range k.GetAllDelegations(ctx) {
+			if !delegation.ValidatorBond {
+				continue
+			}
+			valAddr := delegation.GetValidatorAddr().String()
+			validatorBondShares[valAddr] = validatorBondShares[valAddr].Add(delegation.Shares)
+		}
+
+		for _, validator := range validators {
+			calculatedShares := validatorBondShares[validator.GetOperator().String()]
+			if !calculatedShares.Equal(validator.TotalValidatorBondShares) {
+				broken = true
+				msg += fmt.Sprintf("broken validator bond shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator.TotalValidatorBondShares: %v\n"+
+					"\tsum of validator bond Delegation.Shares: %v\n",
+					validator.GetOperator(), validator.TotalValidatorBondShares, calculatedShares)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator bond shares", msg), broken
+	}
+}
+
+// LiquidSharesInvariant checks whether the shares held by tokenize share record
+// module accounts and liquid staking providers add up to the total liquid shares
+// stored in each validator.
+func LiquidSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validators := k.GetAllValidators(ctx)
+		liquidShares := map[string]sdk.Dec{}
+
+		for _, validator := range validators {
+			liquidShares[validator.GetOperator().String()] = sdk.ZeroDec()
+		}
+
+		for _, delegation := range k.GetAllDelegations(ctx) {
+			delAddr := delegation.GetDelegatorAddr()
+			_, err := k.GetTokenizeShareRecordByModuleAccount(ctx, delAddr)
+			isTokenizeShareCustodian := err == nil
+			if !isTokenizeShareCustodian && !k.AccountIsLiquidStakingProvider(ctx, delAddr) {
+				continue
+			}
+			valAddr := delegation.GetValidatorAddr().String()
+			liquidShares[valAddr] = liquidShares[valAddr].Add(delegation.Shares)
+		}
+
+		for _, validator := range validators {
+			calculatedShares := liquidShares[validator.GetOperator().String()]
+			if !calculatedShares.Equal(validator.TotalLiquidShares) {
+				broken = true
+				msg += fmt.Sprintf("broken liquid shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator.TotalLiquidShares: %v\n"+
+					"\tsum of tokenized and liquid staking provider Delegation.Shares: %v\n",
+					validator.GetOperator(), validator.TotalLiquidShares, calculatedShares)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "liquid shares", msg), broken
+	}
+}
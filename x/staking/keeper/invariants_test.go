@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func requireLiquidInvariants(t *testing.T, ctx sdk.Context, k keeper.Keeper) {
+	msg, broken := keeper.ValidatorBondSharesInvariant(k)(ctx)
+	require.False(t, broken, msg)
+	msg, broken = keeper.LiquidSharesInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestValidatorBondSharesInvariant(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	ctx = ctx.WithBlockHeight(1)
+	addrs := simapp.AddTestAddrs(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrVal1, addrVal2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0])
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	pubKeys := simapp.CreateTestPubKeys(2)
+	for i, valAddr := range []sdk.ValAddress{addrVal1, addrVal2} {
+		validator := teststaking.NewValidator(t, valAddr, pubKeys[i])
+		app.StakingKeeper.SetValidator(ctx, validator)
+		app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+	}
+
+	// validator bond delegations on both validators
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	for _, valAddr := range []sdk.ValAddress{addrVal1, addrVal2} {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[0], valAddr, sdk.NewCoin(bondDenom, delTokens)))
+		require.NoError(t, err)
+		_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(addrs[0], valAddr))
+		require.NoError(t, err)
+	}
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	requireValidatorBondShares := func(valAddr sdk.ValAddress) {
+		delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrs[0], valAddr)
+		require.True(t, found)
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		require.True(t, found)
+		require.Equal(t, delegation.Shares, validator.TotalValidatorBondShares)
+	}
+
+	// delegating more into a validator bond delegation increases the total
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[0], addrVal1, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	requireValidatorBondShares(addrVal1)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	// redelegating into a validator bond delegation moves the shares between totals
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegate(addrs[0], addrVal1, addrVal2, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	requireValidatorBondShares(addrVal1)
+	requireValidatorBondShares(addrVal2)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	// undelegating and cancelling the unbonding keeps the total in sync
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(addrs[0], addrVal2, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	requireValidatorBondShares(addrVal2)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), types.NewMsgCancelUnbondingDelegation(addrs[0], addrVal2, ctx.BlockHeight(), sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	requireValidatorBondShares(addrVal2)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	// a total that drifted from the delegations breaks the invariant
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+	require.True(t, found)
+	validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(sdk.OneDec())
+	app.StakingKeeper.SetValidator(ctx, validator)
+	_, broken := keeper.ValidatorBondSharesInvariant(app.StakingKeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestLiquidSharesInvariant(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	ctx = ctx.WithBlockHeight(1)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrVal := sdk.ValAddress(addrs[0])
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	validator := teststaking.NewValidator(t, addrVal, simapp.CreateTestPubKeys(1)[0])
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+
+	// bond the validator so that the liquid stake stays under the global cap
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[0], addrVal, sdk.NewCoin(bondDenom, delTokens.MulRaw(10))))
+	require.NoError(t, err)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	// tokenized shares
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[1], addrVal, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    addrs[1].String(),
+		ValidatorAddress:    addrVal.String(),
+		Amount:              sdk.NewCoin(bondDenom, delTokens.QuoRaw(2)),
+		TokenizedShareOwner: addrs[1].String(),
+	})
+	require.NoError(t, err)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	// delegation from a liquid staking provider
+	icaAddress := sdk.AccAddress(address.Module("interchain-account", []byte("owner")))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, icaAddress, sdk.NewCoins(sdk.NewCoin(bondDenom, delTokens))))
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(icaAddress, addrVal, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	// the provider's liquid shares are restored when it cancels an unbonding
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(icaAddress, addrVal, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), types.NewMsgCancelUnbondingDelegation(icaAddress, addrVal, ctx.BlockHeight(), sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.QuoRaw(2).Add(delTokens)), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// a total that drifted from the delegations breaks the invariant
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal)
+	require.True(t, found)
+	validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(sdk.OneDec())
+	app.StakingKeeper.SetValidator(ctx, validator)
+	_, broken := keeper.LiquidSharesInvariant(app.StakingKeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestLiquidSharesInvariantAfterSlash(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	ctx = ctx.WithBlockHeight(1)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrVal := sdk.ValAddress(addrs[0])
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validator := teststaking.NewValidator(t, addrVal, simapp.CreateTestPubKeys(1)[0])
+	validator.Status = sdkstaking.Bonded
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	selfBond := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[0], addrVal, sdk.NewCoin(bondDenom, selfBond)))
+	require.NoError(t, err)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	// tokenize an amount whose shares do not divide evenly after the slash
+	tokenizeAmount := sdk.NewInt(3333331)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[1], addrVal, sdk.NewCoin(bondDenom, tokenizeAmount)))
+	require.NoError(t, err)
+	tokenizeRes, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    addrs[1].String(),
+		ValidatorAddress:    addrVal.String(),
+		Amount:              sdk.NewCoin(bondDenom, tokenizeAmount),
+		TokenizedShareOwner: addrs[1].String(),
+	})
+	require.NoError(t, err)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	// slash the validator by a third
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	power := app.StakingKeeper.TokensToConsensusPower(ctx, selfBond.Add(tokenizeAmount))
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, sdk.NewDecWithPrec(1, 0).QuoInt64(3))
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	// a liquid staking provider redeems the share tokens, which swaps the record delegation
+	// for a delegation with different shares
+	providerAddress := sdk.AccAddress(address.Module("interchain-account", []byte("provider")))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], providerAddress, sdk.NewCoins(tokenizeRes.Amount)))
+	redeemRes, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: providerAddress.String(),
+		Amount:           tokenizeRes.Amount,
+	})
+	require.NoError(t, err)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	// the liquid staking provider tokenizes its delegation again
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    providerAddress.String(),
+		ValidatorAddress:    addrVal.String(),
+		Amount:              sdk.NewCoin(bondDenom, redeemRes.Amount.Amount.QuoRaw(2)),
+		TokenizedShareOwner: providerAddress.String(),
+	})
+	require.NoError(t, err)
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+}
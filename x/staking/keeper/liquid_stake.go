@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// SetTotalLiquidStakedTokens stores the total outstanding tokens owned by a liquid staking provider
+// or held in tokenized shares
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetTotalLiquidStakedTokens returns the total outstanding tokens owned by a liquid staking provider
+// or held in tokenized shares
+// Returns zero if the total liquid stake amount has not been initialized
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+
+	return dp.Dec
+}
+
+// IncreaseTotalLiquidStakedTokens increments the total liquid staked tokens
+func (k Keeper) IncreaseTotalLiquidStakedTokens(ctx sdk.Context, amount sdk.Dec) {
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(amount))
+}
+
+// DecreaseTotalLiquidStakedTokens decrements the total liquid staked tokens
+// The total is floored at zero since liquid stake that was created before the
+// total was tracked is not included in it
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, amount sdk.Dec) {
+	totalLiquidStake := k.GetTotalLiquidStakedTokens(ctx)
+	if amount.GT(totalLiquidStake) {
+		amount = totalLiquidStake
+	}
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStake.Sub(amount))
+}
+
+// ExceedsGlobalLiquidStakingCap checks if a liquid delegation would cause the
+// global liquid staking cap to be exceeded
+// A liquid delegation is defined as either tokenized shares, or a delegation
+// from a module account
+// The total stake is determined by the balance of the bonded pool
+// If the delegation's shares are already bonded (e.g. in the event of a tokenized share)
+// the tokens are already included in the bonded pool
+// If the delegation's shares are not already bonded (e.g. normal delegation),
+// we need to add the tokens to the current bonded pool balance to get the total staked
+func (k Keeper) ExceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens math.Int, sharesAlreadyBonded bool) bool {
+	liquidStakingCap := k.GlobalLiquidStakingCap(ctx)
+	liquidStakedAmount := k.GetTotalLiquidStakedTokens(ctx)
+
+	// Determine the total stake from the balance of the bonded pool
+	// If this is not a tokenization of shares that are already bonded, add the tokens to the total
+	totalStakedAmount := sdk.NewDecFromInt(k.TotalBondedTokens(ctx))
+	if !sharesAlreadyBonded {
+		totalStakedAmount = totalStakedAmount.Add(sdk.NewDecFromInt(tokens))
+	}
+
+	// Calculate the percentage of stake that is liquid
+	updatedLiquidStaked := liquidStakedAmount.Add(sdk.NewDecFromInt(tokens))
+	if !totalStakedAmount.IsPositive() {
+		return updatedLiquidStaked.IsPositive() && liquidStakingCap.LT(sdk.OneDec())
+	}
+	liquidStakePercent := updatedLiquidStaked.Quo(totalStakedAmount)
+
+	return liquidStakePercent.GT(liquidStakingCap)
+}
+
+// AccountIsLiquidStakingProvider returns true if the provided account is a liquid staking provider
+// A liquid staking provider is identified as a module account, either by a 32-byte address
+// (e.g. an interchain account) or by the ModuleAccount type
+// Tokenize share record module accounts are excluded since their shares are already
+// accounted for at the time of tokenization
+func (k Keeper) AccountIsLiquidStakingProvider(ctx sdk.Context, address sdk.AccAddress) bool {
+	if _, err := k.GetTokenizeShareRecordByModuleAccount(ctx, address); err == nil {
+		return false
+	}
+
+	if len(address) == 32 {
+		return true
+	}
+
+	account := k.authKeeper.GetAccount(ctx, address)
+	_, isModuleAccount := account.(authtypes.ModuleAccountI)
+	return isModuleAccount
+}
+
+// SetValidatorBondFactorOverride stores the validator bond factor override of a validator
+func (k Keeper) SetValidatorBondFactorOverride(ctx sdk.Context, valAddr sdk.ValAddress, validatorBondFactor sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	override := types.ValidatorBondFactorOverride{
+		ValidatorAddress:    valAddr.String(),
+		ValidatorBondFactor: validatorBondFactor,
+	}
+	store.Set(types.GetValidatorBondFactorOverrideKey(valAddr), k.cdc.MustMarshal(&override))
+}
+
+// GetValidatorBondFactorOverride returns the validator bond factor override of a validator
+func (k Keeper) GetValidatorBondFactorOverride(ctx sdk.Context, valAddr sdk.ValAddress) (validatorBondFactor sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorBondFactorOverrideKey(valAddr))
+	if bz == nil {
+		return validatorBondFactor, false
+	}
+
+	var override types.ValidatorBondFactorOverride
+	k.cdc.MustUnmarshal(bz, &override)
+	return override.ValidatorBondFactor, true
+}
+
+// DeleteValidatorBondFactorOverride removes the validator bond factor override of a validator
+func (k Keeper) DeleteValidatorBondFactorOverride(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorBondFactorOverrideKey(valAddr))
+}
+
+// GetAllValidatorBondFactorOverrides returns the validator bond factor overrides of all the validators
+func (k Keeper) GetAllValidatorBondFactorOverrides(ctx sdk.Context) (overrides []types.ValidatorBondFactorOverride) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorBondFactorOverridePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var override types.ValidatorBondFactorOverride
+		k.cdc.MustUnmarshal(iterator.Value(), &override)
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+// GetValidatorBondFactor returns the validator bond factor applied to a validator,
+// its override if one is set and the validator bond factor param otherwise
+func (k Keeper) GetValidatorBondFactor(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	if validatorBondFactor, found := k.GetValidatorBondFactorOverride(ctx, valAddr); found {
+		return validatorBondFactor
+	}
+	return k.ValidatorBondFactor(ctx)
+}
+
+// SetValidatorLiquidTokensSlashed stores the cumulative liquid tokens slashed of a validator
+func (k Keeper) SetValidatorLiquidTokensSlashed(ctx sdk.Context, valAddr sdk.ValAddress, tokens sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	slashed := types.ValidatorLiquidTokensSlashed{
+		ValidatorAddress:    valAddr.String(),
+		LiquidTokensSlashed: tokens,
+	}
+	store.Set(types.GetValidatorLiquidTokensSlashedKey(valAddr), k.cdc.MustMarshal(&slashed))
+}
+
+// GetValidatorLiquidTokensSlashed returns the cumulative liquid tokens slashed of a validator
+// Returns zero if the validator's liquid shares were never slashed
+func (k Keeper) GetValidatorLiquidTokensSlashed(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidTokensSlashedKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var slashed types.ValidatorLiquidTokensSlashed
+	k.cdc.MustUnmarshal(bz, &slashed)
+	return slashed.LiquidTokensSlashed
+}
+
+// IncreaseValidatorLiquidTokensSlashed increments the cumulative liquid tokens slashed of a validator
+func (k Keeper) IncreaseValidatorLiquidTokensSlashed(ctx sdk.Context, valAddr sdk.ValAddress, amount sdk.Dec) {
+	k.SetValidatorLiquidTokensSlashed(ctx, valAddr, k.GetValidatorLiquidTokensSlashed(ctx, valAddr).Add(amount))
+}
+
+// GetAllValidatorLiquidTokensSlashed returns the cumulative liquid tokens slashed of all the validators
+func (k Keeper) GetAllValidatorLiquidTokensSlashed(ctx sdk.Context) (slashes []types.ValidatorLiquidTokensSlashed) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidTokensSlashedPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var slashed types.ValidatorLiquidTokensSlashed
+		k.cdc.MustUnmarshal(iterator.Value(), &slashed)
+		slashes = append(slashes, slashed)
+	}
+
+	return slashes
+}
+
+// ExceedsValidatorBondCap checks if a liquid delegation to a validator would cause
+// the validator's liquid shares to exceed its validator bond shares times the validator bond factor
+// The check is disabled when the validator bond factor is negative
+func (k Keeper) ExceedsValidatorBondCap(ctx sdk.Context, validator types.Validator, shares sdk.Dec) bool {
+	validatorBondFactor := k.GetValidatorBondFactor(ctx, validator.GetOperator())
+	if validatorBondFactor.IsNegative() {
+		return false
+	}
+
+	maxValTotalShare := validator.TotalValidatorBondShares.Mul(validatorBondFactor)
+	return validator.TotalLiquidShares.Add(shares).GT(maxValTotalShare)
+}
+
+// MeetsMinValidatorSelfBond checks if the self-delegation of the validator operator is a
+// validator bond worth at least the min validator self bond param
+// The check is disabled when the min validator self bond is zero
+func (k Keeper) MeetsMinValidatorSelfBond(ctx sdk.Context, validator types.Validator) bool {
+	minValidatorSelfBond := k.MinValidatorSelfBond(ctx)
+	if !minValidatorSelfBond.IsPositive() {
+		return true
+	}
+
+	selfDelegation, found := k.GetLiquidDelegation(ctx, sdk.AccAddress(validator.GetOperator()), validator.GetOperator())
+	if !found || !selfDelegation.ValidatorBond {
+		return false
+	}
+
+	return validator.TokensFromShares(selfDelegation.Shares).GTE(sdk.NewDecFromInt(minValidatorSelfBond))
+}
+
+// Restake delegates tokens from the balance of a delegator to a validator with the liquid staking
+// checks of a MsgDelegate, for the delegation of rewards that were withdrawn on the delegator's behalf.
+// Unlike a MsgDelegate, the delegation of a tokenize share record module account is liquid, so the
+// new shares are added to the record and the share tokens of the record grow in value.
+func (k Keeper) Restake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) (newShares sdk.Dec, err error) {
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return newShares, sdkstaking.ErrNoValidatorFound
+	}
+
+	_, err = k.GetTokenizeShareRecordByModuleAccount(ctx, delAddr)
+	isTokenizeShareCustodian := err == nil
+	isLiquid := isTokenizeShareCustodian || k.AccountIsLiquidStakingProvider(ctx, delAddr)
+	if isLiquid && k.ExceedsGlobalLiquidStakingCap(ctx, amount, false) {
+		return newShares, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	newShares, err = k.Delegate(ctx, delAddr, amount, sdkstaking.Unbonded, validator, true)
+	if err != nil {
+		return newShares, err
+	}
+
+	if isLiquid {
+		if k.ExceedsValidatorBondCap(ctx, validator, newShares) {
+			return newShares, types.ErrInsufficientValidatorBondShares
+		}
+
+		validator, _ = k.GetLiquidValidator(ctx, valAddr)
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+
+		k.IncreaseTotalLiquidStakedTokens(ctx, sdk.NewDecFromInt(amount))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.BondDenom(ctx), amount).String()),
+			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+		),
+	)
+
+	return newShares, nil
+}
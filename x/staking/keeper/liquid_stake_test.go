@@ -0,0 +1,560 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotalLiquidStakedTokens(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	// zero when not initialized
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewDec(10))
+	require.Equal(t, sdk.NewDec(10), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	app.StakingKeeper.IncreaseTotalLiquidStakedTokens(ctx, sdk.NewDec(5))
+	require.Equal(t, sdk.NewDec(15), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	app.StakingKeeper.DecreaseTotalLiquidStakedTokens(ctx, sdk.NewDec(3))
+	require.Equal(t, sdk.NewDec(12), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the total can not go below zero
+	app.StakingKeeper.DecreaseTotalLiquidStakedTokens(ctx, sdk.NewDec(20))
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestExceedsGlobalLiquidStakingCap(t *testing.T) {
+	testCases := []struct {
+		name                string
+		globalLiquidCap     sdk.Dec
+		totalLiquidStake    int64
+		totalStake          int64
+		newLiquidStake      int64
+		sharesAlreadyBonded bool
+		expectedExceeds     bool
+	}{
+		{
+			// Cap: 10% - Delegation Below Threshold
+			// Total Liquid Stake: 5, Total Stake: 95, New Liquid Stake: 1
+			// => Total Liquid Stake: 5+1=6, Total Stake: 95+1=96 => 6/96 = 6% < 10% cap
+			name:             "10 percent cap _ delegation below cap",
+			globalLiquidCap:  sdk.MustNewDecFromStr("0.1"),
+			totalLiquidStake: 5,
+			totalStake:       95,
+			newLiquidStake:   1,
+			expectedExceeds:  false,
+		},
+		{
+			// Cap: 10% - Delegation At Threshold
+			// Total Liquid Stake: 5, Total Stake: 95, New Liquid Stake: 5
+			// => Total Liquid Stake: 5+5=10, Total Stake: 95+5=100 => 10/100 = 10% == 10% cap
+			name:             "10 percent cap _ delegation equals cap",
+			globalLiquidCap:  sdk.MustNewDecFromStr("0.1"),
+			totalLiquidStake: 5,
+			totalStake:       95,
+			newLiquidStake:   5,
+			expectedExceeds:  false,
+		},
+		{
+			// Cap: 10% - Delegation Exceeds Threshold
+			// Total Liquid Stake: 5, Total Stake: 95, New Liquid Stake: 6
+			// => Total Liquid Stake: 5+6=11, Total Stake: 95+6=101 => 11/101 = 11% > 10% cap
+			name:             "10 percent cap _ delegation exceeds cap",
+			globalLiquidCap:  sdk.MustNewDecFromStr("0.1"),
+			totalLiquidStake: 5,
+			totalStake:       95,
+			newLiquidStake:   6,
+			expectedExceeds:  true,
+		},
+		{
+			// Cap: 10% - Tokenize Below Threshold
+			// Total Liquid Stake: 5, Total Stake: 100, New Liquid Stake: 4
+			// => Total Liquid Stake: 5+4=9, Total Stake: 100 => 9/100 = 9% < 10% cap
+			name:                "10 percent cap _ tokenize below cap",
+			globalLiquidCap:     sdk.MustNewDecFromStr("0.1"),
+			totalLiquidStake:    5,
+			totalStake:          100,
+			newLiquidStake:      4,
+			sharesAlreadyBonded: true,
+			expectedExceeds:     false,
+		},
+		{
+			// Cap: 10% - Tokenize Exceeds Threshold
+			// Total Liquid Stake: 5, Total Stake: 100, New Liquid Stake: 6
+			// => Total Liquid Stake: 5+6=11, Total Stake: 100 => 11/100 = 11% > 10% cap
+			name:                "10 percent cap _ tokenize exceeds cap",
+			globalLiquidCap:     sdk.MustNewDecFromStr("0.1"),
+			totalLiquidStake:    5,
+			totalStake:          100,
+			newLiquidStake:      6,
+			sharesAlreadyBonded: true,
+			expectedExceeds:     true,
+		},
+		{
+			// Cap: 100% - All stake can be liquid
+			// Total Liquid Stake: 90, Total Stake: 90, New Liquid Stake: 10
+			// => Total Liquid Stake: 90+10=100, Total Stake: 90+10=100 => 100/100 = 100% == 100% cap
+			name:             "100 percent cap",
+			globalLiquidCap:  sdk.OneDec(),
+			totalLiquidStake: 90,
+			totalStake:       90,
+			newLiquidStake:   10,
+			expectedExceeds:  false,
+		},
+		{
+			// Cap: 0% - Any liquid stake exceeds the cap
+			name:             "0 percent cap",
+			globalLiquidCap:  sdk.ZeroDec(),
+			totalLiquidStake: 0,
+			totalStake:       100,
+			newLiquidStake:   1,
+			expectedExceeds:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, app, ctx := createTestInput(t)
+
+			// Update the global liquid staking cap
+			params := app.StakingKeeper.GetParams(ctx)
+			params.GlobalLiquidStakingCap = tc.globalLiquidCap
+			app.StakingKeeper.SetParams(ctx, params)
+
+			// Update the total liquid tokens
+			app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewDec(tc.totalLiquidStake))
+
+			// Replace the bonded pool balance with the total stake
+			bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+			bondDenom := app.StakingKeeper.BondDenom(ctx)
+			bondedBalance := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+			require.NoError(t, app.BankKeeper.BurnCoins(ctx, types.BondedPoolName, bondedBalance))
+			totalStake := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, tc.totalStake))
+			require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.BondedPoolName, totalStake))
+
+			// Check if the new tokens would exceed the global cap
+			actualExceeds := app.StakingKeeper.ExceedsGlobalLiquidStakingCap(ctx, sdk.NewInt(tc.newLiquidStake), tc.sharesAlreadyBonded)
+			require.Equal(t, tc.expectedExceeds, actualExceeds)
+		})
+	}
+}
+
+func TestTokenizeSharesGlobalLiquidStakingCap(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrAcc := addrs[0]
+	addrVal := sdk.ValAddress(addrAcc)
+
+	pubKeys := simapp.CreateTestPubKeys(1)
+	val := teststaking.NewValidator(t, addrVal, pubKeys[0])
+	val.Status = sdkstaking.Bonded
+	app.StakingKeeper.SetValidator(ctx, val)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, val)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 20)
+	require.NoError(t, delegateCoinsFromAccount(ctx, app, addrAcc, delTokens, val))
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	// set the cap just below the portion of stake that would be tokenized
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	totalBonded := app.StakingKeeper.TotalBondedTokens(ctx)
+	liquidPortion := sdk.NewDecFromInt(tokenizeAmount).QuoInt(totalBonded)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = liquidPortion.Sub(sdk.SmallestDec())
+	app.StakingKeeper.SetParams(ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	msg := &types.MsgTokenizeShares{
+		DelegatorAddress:    addrAcc.String(),
+		ValidatorAddress:    addrVal.String(),
+		Amount:              sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokenizeAmount),
+		TokenizedShareOwner: addrAcc.String(),
+	}
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// once the cap is raised, the tokenization counts towards the total liquid stake
+	params.GlobalLiquidStakingCap = liquidPortion
+	app.StakingKeeper.SetParams(ctx, params)
+
+	resp, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeAmount), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeeming to a non-provider account removes the tokens from the total liquid stake
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: addrAcc.String(),
+		Amount:           resp.Amount,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestDelegateFromModuleAccountGlobalLiquidStakingCap(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrVal := sdk.ValAddress(addrs[0])
+
+	pubKeys := simapp.CreateTestPubKeys(1)
+	val := teststaking.NewValidator(t, addrVal, pubKeys[0])
+	app.StakingKeeper.SetValidator(ctx, val)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val)
+
+	// create and fund a module account that acts as a liquid staking provider
+	moduleAccount := authtypes.NewEmptyModuleAccount("liquid-staking-provider")
+	app.AccountKeeper.SetModuleAccount(ctx, moduleAccount)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, moduleAccount.GetAddress(),
+		sdk.NewCoins(sdk.NewCoin(bondDenom, delTokens.MulRaw(2)))))
+
+	// disallow any liquid stake
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.ZeroDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	msg := &types.MsgDelegate{
+		DelegatorAddress: moduleAccount.GetAddress().String(),
+		ValidatorAddress: addrVal.String(),
+		Amount:           sdk.NewCoin(bondDenom, delTokens),
+	}
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// a delegation from a regular account is not restricted by the cap
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+		DelegatorAddress: addrs[0].String(),
+		ValidatorAddress: addrVal.String(),
+		Amount:           sdk.NewCoin(bondDenom, delTokens),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// with the cap disabled, the module account delegation counts towards the total
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecFromInt(delTokens), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// undelegating from the module account removes it from the total
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), &types.MsgUndelegate{
+		DelegatorAddress: moduleAccount.GetAddress().String(),
+		ValidatorAddress: addrVal.String(),
+		Amount:           sdk.NewCoin(bondDenom, delTokens),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestSlashDecreasesTotalLiquidStakedTokens(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrAcc := addrs[0]
+	addrVal := sdk.ValAddress(addrAcc)
+
+	pubKeys := simapp.CreateTestPubKeys(1)
+	val := teststaking.NewValidator(t, addrVal, pubKeys[0])
+	val.Status = sdkstaking.Bonded
+	app.StakingKeeper.SetValidator(ctx, val)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, val)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 20)
+	require.NoError(t, delegateCoinsFromAccount(ctx, app, addrAcc, delTokens, val))
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	// tokenize half of the delegation
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    addrAcc.String(),
+		ValidatorAddress:    addrVal.String(),
+		Amount:              sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokenizeAmount),
+		TokenizedShareOwner: addrAcc.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeAmount), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// slash the validator by 10%
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	power := app.StakingKeeper.TokensToConsensusPower(ctx, delTokens)
+	slashed := app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, sdk.NewDecWithPrec(1, 1))
+	require.True(t, slashed.IsPositive())
+
+	// half of the slashed tokens were liquid
+	expectedLiquidStaked := sdk.NewDecFromInt(tokenizeAmount).Sub(sdk.NewDecFromInt(slashed).QuoInt64(2))
+	require.Equal(t, expectedLiquidStaked, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestSlashTracksLiquidTokensSlashed(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrAcc, addrBond := addrs[0], addrs[1]
+	addrVal := sdk.ValAddress(addrAcc)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	pubKeys := simapp.CreateTestPubKeys(1)
+	val := teststaking.NewValidator(t, addrVal, pubKeys[0])
+	val.Status = sdkstaking.Bonded
+	app.StakingKeeper.SetValidator(ctx, val)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, val)
+
+	// a third of the stake is tokenized and another third is a validator bond
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 20)
+	require.NoError(t, delegateCoinsFromAccount(ctx, app, addrAcc, delTokens, val))
+	bondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	val, _ = app.StakingKeeper.GetLiquidValidator(ctx, addrVal)
+	require.NoError(t, delegateCoinsFromAccount(ctx, app, addrBond, bondTokens, val))
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: addrBond.String(),
+		ValidatorAddress: addrVal.String(),
+	})
+	require.NoError(t, err)
+
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    addrAcc.String(),
+		ValidatorAddress:    addrVal.String(),
+		Amount:              sdk.NewCoin(bondDenom, tokenizeAmount),
+		TokenizedShareOwner: addrAcc.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetValidatorLiquidTokensSlashed(ctx, addrVal))
+
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	power := app.StakingKeeper.TokensToConsensusPower(ctx, delTokens.Add(bondTokens))
+
+	// slash the validator by 10% twice, the counter accumulates the liquid portions
+	expectedLiquidSlashed := sdk.ZeroDec()
+	for i := 0; i < 2; i++ {
+		val, _ = app.StakingKeeper.GetLiquidValidator(ctx, addrVal)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		slashed := app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, sdk.NewDecWithPrec(1, 1))
+		require.True(t, slashed.IsPositive())
+
+		// a third of the slashed tokens were liquid and a third were validator bond
+		require.Equal(t, val.TotalLiquidShares, val.TotalValidatorBondShares)
+		liquidSlashed := val.TotalLiquidShares.Quo(val.DelegatorShares).MulInt(slashed)
+		expectedLiquidSlashed = expectedLiquidSlashed.Add(liquidSlashed)
+		require.Equal(t, expectedLiquidSlashed, app.StakingKeeper.GetValidatorLiquidTokensSlashed(ctx, addrVal))
+
+		var found bool
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeSlashLiquidTokens {
+				continue
+			}
+			found = true
+			attributes := map[string]string{}
+			for _, attribute := range event.Attributes {
+				attributes[string(attribute.Key)] = string(attribute.Value)
+			}
+			require.Equal(t, addrVal.String(), attributes[types.AttributeKeyValidator])
+			require.Equal(t, slashed.String(), attributes[types.AttributeKeyBurnedTokens])
+			require.Equal(t, liquidSlashed.String(), attributes[types.AttributeKeyLiquidTokens])
+			require.Equal(t, liquidSlashed.String(), attributes[types.AttributeKeyBondTokens])
+		}
+		require.True(t, found)
+	}
+
+	require.Equal(t, []types.ValidatorLiquidTokensSlashed{
+		{ValidatorAddress: addrVal.String(), LiquidTokensSlashed: expectedLiquidSlashed},
+	}, app.StakingKeeper.GetAllValidatorLiquidTokensSlashed(ctx))
+}
+
+func TestAccountIsLiquidStakingProvider(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(0))
+
+	// regular account
+	require.False(t, app.StakingKeeper.AccountIsLiquidStakingProvider(ctx, addrs[0]))
+
+	// 32-byte address (e.g. interchain account)
+	icaAddress := sdk.AccAddress(address.Module("interchain-account", []byte("owner")))
+	require.Len(t, icaAddress, 32)
+	require.True(t, app.StakingKeeper.AccountIsLiquidStakingProvider(ctx, icaAddress))
+
+	// module account type with a 20-byte address
+	moduleAccount := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())), "provider")
+	app.AccountKeeper.SetAccount(ctx, moduleAccount)
+	require.True(t, app.StakingKeeper.AccountIsLiquidStakingProvider(ctx, moduleAccount.GetAddress()))
+
+	// tokenize share record module account
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         addrs[0].String(),
+		ModuleAccount: "tokenizeshare_1",
+		Validator:     sdk.ValAddress(addrs[0]).String(),
+	}
+	require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	require.False(t, app.StakingKeeper.AccountIsLiquidStakingProvider(ctx, record.GetModuleAddress()))
+}
+
+func TestLiquidStakingProviderValidatorBondCap(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrVal1, addrVal2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	pubKeys := simapp.CreateTestPubKeys(2)
+	val1 := teststaking.NewValidator(t, addrVal1, pubKeys[0])
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val1)
+	val2 := teststaking.NewValidator(t, addrVal2, pubKeys[1])
+	app.StakingKeeper.SetValidator(ctx, val2)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val2)
+
+	// validator bond factor of 2 - up to twice the validator bond shares can be liquid
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// validator bond self delegation on each validator
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	validatorBondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	for i, valAddr := range []sdk.ValAddress{addrVal1, addrVal2} {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+			DelegatorAddress: addrs[i].String(),
+			ValidatorAddress: valAddr.String(),
+			Amount:           sdk.NewCoin(bondDenom, validatorBondTokens),
+		})
+		require.NoError(t, err)
+		_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+			DelegatorAddress: addrs[i].String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		require.NoError(t, err)
+	}
+
+	// fund an interchain account style provider
+	icaAddress := sdk.AccAddress(address.Module("interchain-account", []byte("owner")))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, icaAddress,
+		sdk.NewCoins(sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 100)))))
+
+	// delegation above the validator bond cap fails
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(cacheCtx), &types.MsgDelegate{
+		DelegatorAddress: icaAddress.String(),
+		ValidatorAddress: addrVal1.String(),
+		Amount:           sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 21)),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	// delegation within the validator bond cap counts towards the tokenized shares
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 15)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+		DelegatorAddress: icaAddress.String(),
+		ValidatorAddress: addrVal1.String(),
+		Amount:           sdk.NewCoin(bondDenom, delTokens),
+	})
+	require.NoError(t, err)
+
+	val1, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens), val1.TotalLiquidShares)
+
+	// redelegating moves the tokenized shares to the destination validator
+	redelegateTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), &types.MsgBeginRedelegate{
+		DelegatorAddress:    icaAddress.String(),
+		ValidatorSrcAddress: addrVal1.String(),
+		ValidatorDstAddress: addrVal2.String(),
+		Amount:              sdk.NewCoin(bondDenom, redelegateTokens),
+	})
+	require.NoError(t, err)
+
+	val1, found = app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.Sub(redelegateTokens)), val1.TotalLiquidShares)
+	val2, found = app.StakingKeeper.GetLiquidValidator(ctx, addrVal2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(redelegateTokens), val2.TotalLiquidShares)
+
+	// redelegating above the destination's validator bond cap fails
+	params.ValidatorBondFactor = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), &types.MsgBeginRedelegate{
+		DelegatorAddress:    icaAddress.String(),
+		ValidatorSrcAddress: addrVal1.String(),
+		ValidatorDstAddress: addrVal2.String(),
+		Amount:              sdk.NewCoin(bondDenom, delTokens.Sub(redelegateTokens)),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+}
+
+func TestValidatorBondFactorOverride(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrVal1, addrVal2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+
+	pubKeys := simapp.CreateTestPubKeys(2)
+	val1 := teststaking.NewValidator(t, addrVal1, pubKeys[0])
+	val1.TotalValidatorBondShares = sdk.NewDec(100)
+	val1.TotalLiquidShares = sdk.NewDec(150)
+	app.StakingKeeper.SetValidator(ctx, val1)
+	val2 := teststaking.NewValidator(t, addrVal2, pubKeys[1])
+	val2.TotalValidatorBondShares = sdk.NewDec(100)
+	val2.TotalLiquidShares = sdk.NewDec(150)
+	app.StakingKeeper.SetValidator(ctx, val2)
+
+	// validator bond factor of 2 - up to 200 liquid shares for both validators
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	require.Equal(t, sdk.NewDec(2), app.StakingKeeper.GetValidatorBondFactor(ctx, addrVal1))
+	require.False(t, app.StakingKeeper.ExceedsValidatorBondCap(ctx, val1, sdk.NewDec(50)))
+	require.True(t, app.StakingKeeper.ExceedsValidatorBondCap(ctx, val1, sdk.NewDec(51)))
+
+	// a tighter override only caps the first validator
+	app.StakingKeeper.SetValidatorBondFactorOverride(ctx, addrVal1, sdk.NewDecWithPrec(15, 1))
+	require.Equal(t, sdk.NewDecWithPrec(15, 1), app.StakingKeeper.GetValidatorBondFactor(ctx, addrVal1))
+	require.Equal(t, sdk.NewDec(2), app.StakingKeeper.GetValidatorBondFactor(ctx, addrVal2))
+	require.True(t, app.StakingKeeper.ExceedsValidatorBondCap(ctx, val1, sdk.NewDec(1)))
+	require.False(t, app.StakingKeeper.ExceedsValidatorBondCap(ctx, val2, sdk.NewDec(50)))
+
+	// a negative override disables the cap for the second validator
+	app.StakingKeeper.SetValidatorBondFactorOverride(ctx, addrVal2, sdk.NewDec(-1))
+	require.False(t, app.StakingKeeper.ExceedsValidatorBondCap(ctx, val2, sdk.NewDec(1000)))
+
+	require.ElementsMatch(t, []types.ValidatorBondFactorOverride{
+		{ValidatorAddress: addrVal1.String(), ValidatorBondFactor: sdk.NewDecWithPrec(15, 1)},
+		{ValidatorAddress: addrVal2.String(), ValidatorBondFactor: sdk.NewDec(-1)},
+	}, app.StakingKeeper.GetAllValidatorBondFactorOverrides(ctx))
+
+	// the override takes precedence over a change of the param
+	params.ValidatorBondFactor = sdk.NewDec(-1)
+	app.StakingKeeper.SetParams(ctx, params)
+	require.True(t, app.StakingKeeper.ExceedsValidatorBondCap(ctx, val1, sdk.NewDec(1)))
+
+	// the param applies again once the override is removed
+	app.StakingKeeper.DeleteValidatorBondFactorOverride(ctx, addrVal1)
+	_, found := app.StakingKeeper.GetValidatorBondFactorOverride(ctx, addrVal1)
+	require.False(t, found)
+	require.False(t, app.StakingKeeper.ExceedsValidatorBondCap(ctx, val1, sdk.NewDec(1000)))
+}
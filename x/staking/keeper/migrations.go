@@ -1 +1,34 @@
 package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v4 "github.com/iqlusioninc/liquidity-staking-module/x/staking/migrations/v4"
+	v5 "github.com/iqlusioninc/liquidity-staking-module/x/staking/migrations/v5"
+	v6 "github.com/iqlusioninc/liquidity-staking-module/x/staking/migrations/v6"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate3to4 migrates x/staking state from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore, m.keeper.authKeeper)
+}
+
+// Migrate4to5 migrates x/staking state from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore)
+}
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.bankKeeper)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -17,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -122,11 +124,36 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
 	// NOTE source will always be from a wallet which are unbonded
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Value.Amount, sdkstaking.Unbonded, validator, true)
+	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Value.Amount, sdkstaking.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
 
+	// mark the initial self-delegation as a validator bond if requested
+	if msg.ValidatorBond {
+		delegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valAddr)
+		if !found {
+			return nil, sdkstaking.ErrNoDelegation
+		}
+		validator, found = k.GetLiquidValidator(ctx, valAddr)
+		if !found {
+			return nil, sdkstaking.ErrNoValidatorFound
+		}
+
+		delegation.ValidatorBond = true
+		k.SetDelegation(ctx, delegation)
+		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorBond,
+				sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			),
+		)
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateValidator,
@@ -220,12 +247,33 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		)
 	}
 
+	// if this delegation is from a liquid staking provider (identified if the delegator
+	// is a module account), it must not exceed the global liquid staking cap
+	isLiquidStakingProvider := k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+	if isLiquidStakingProvider && k.ExceedsGlobalLiquidStakingCap(ctx, msg.Amount.Amount, false) {
+		return nil, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
 	// NOTE: source funds are always unbonded
 	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, sdkstaking.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
 
+	// delegations from a liquid staking provider count towards the validator's
+	// tokenized shares and must not exceed the validator bond cap
+	if isLiquidStakingProvider {
+		if k.ExceedsValidatorBondCap(ctx, validator, newShares) {
+			return nil, types.ErrInsufficientValidatorBondShares
+		}
+
+		validator, _ = k.GetLiquidValidator(ctx, valAddr)
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+
+		k.IncreaseTotalLiquidStakedTokens(ctx, sdk.NewDecFromInt(msg.Amount.Amount))
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "delegate")
@@ -282,21 +330,23 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
-	// tokenize share vs exempt delegation check if exempt delegation
-	exemptionFactor := k.ExemptionFactor(ctx)
-	if delegation.Exempt && !exemptionFactor.IsNegative() {
+	// liquid shares vs validator bond check if validator bond delegation
+	if delegation.ValidatorBond {
 		validator, found := k.GetLiquidValidator(ctx, valSrcAddr)
 		if !found {
 			return nil, sdkstaking.ErrNoValidatorFound
 		}
 
-		maxTokenizeShareAfter := validator.TotalExemptShares.Sub(shares).Mul(exemptionFactor)
-		if maxTokenizeShareAfter.LT(validator.TotalTokenizedShares) {
-			return nil, types.ErrInsufficientExemptShares
+		validatorBondFactor := k.GetValidatorBondFactor(ctx, valSrcAddr)
+		if !validatorBondFactor.IsNegative() {
+			maxTokenizeShareAfter := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
+			if maxTokenizeShareAfter.LT(validator.TotalLiquidShares) {
+				return nil, types.ErrInsufficientValidatorBondShares
+			}
 		}
 
-		// reduce exempt delegation on redelegation
-		validator.TotalExemptShares = validator.TotalExemptShares.Sub(shares)
+		// reduce validator bond shares on redelegation
+		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(shares)
 		k.SetValidator(ctx, validator)
 	}
 
@@ -312,6 +362,14 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// keep track of the destination delegation shares to determine the shares
+	// created by the redelegation
+	isLiquidStakingProvider := k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+	dstSharesBefore := sdk.ZeroDec()
+	if dstDelegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valDstAddr); found {
+		dstSharesBefore = dstDelegation.Shares
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -319,6 +377,28 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// redelegations from a liquid staking provider move the tokenized shares from
+	// the source to the destination validator, subject to the destination's validator bond cap
+	if isLiquidStakingProvider {
+		dstDelegation, _ := k.GetLiquidDelegation(ctx, delegatorAddress, valDstAddr)
+		dstShares := dstDelegation.Shares.Sub(dstSharesBefore)
+
+		dstValidator, found := k.GetLiquidValidator(ctx, valDstAddr)
+		if !found {
+			return nil, sdkstaking.ErrBadRedelegationDst
+		}
+		if k.ExceedsValidatorBondCap(ctx, dstValidator, dstShares) {
+			return nil, types.ErrInsufficientValidatorBondShares
+		}
+		dstValidator.TotalLiquidShares = dstValidator.TotalLiquidShares.Add(dstShares)
+		k.SetValidator(ctx, dstValidator)
+
+		if srcValidator, found := k.GetLiquidValidator(ctx, valSrcAddr); found {
+			srcValidator.TotalLiquidShares = srcValidator.TotalLiquidShares.Sub(shares)
+			k.SetValidator(ctx, srcValidator)
+		}
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "redelegate")
@@ -383,16 +463,18 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		)
 	}
 
-	// tokenize share vs exempt delegation check if exempt delegation
-	exemptionFactor := k.ExemptionFactor(ctx)
-	if delegation.Exempt && !exemptionFactor.IsNegative() {
-		maxTokenizeShareAfter := validator.TotalExemptShares.Sub(shares).Mul(exemptionFactor)
-		if maxTokenizeShareAfter.LT(validator.TotalTokenizedShares) {
-			return nil, types.ErrInsufficientExemptShares
+	// liquid shares vs validator bond check if validator bond delegation
+	if delegation.ValidatorBond {
+		validatorBondFactor := k.GetValidatorBondFactor(ctx, addr)
+		if !validatorBondFactor.IsNegative() {
+			maxTokenizeShareAfter := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
+			if maxTokenizeShareAfter.LT(validator.TotalLiquidShares) {
+				return nil, types.ErrInsufficientValidatorBondShares
+			}
 		}
 
-		// reduce total exempt delegation on unbond
-		validator.TotalExemptShares = validator.TotalExemptShares.Sub(shares)
+		// reduce total validator bond shares on unbond
+		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(shares)
 		k.SetValidator(ctx, validator)
 	}
 
@@ -408,6 +490,17 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		return nil, err
 	}
 
+	// if this undelegation was from a liquid staking provider, decrement the total liquid staked
+	// and the validator's tokenized shares
+	if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
+		if validator, found := k.GetLiquidValidator(ctx, addr); found {
+			validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(shares)
+			k.SetValidator(ctx, validator)
+		}
+
+		k.DecreaseTotalLiquidStakedTokens(ctx, sdk.NewDecFromInt(msg.Amount.Amount))
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "undelegate")
@@ -509,12 +602,31 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
 	}
 
+	// the liquid shares were removed from the caps when the liquid staking provider
+	// undelegated, so they must fit under the caps again when delegated back
+	isLiquidStakingProvider := k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+	if isLiquidStakingProvider && k.ExceedsGlobalLiquidStakingCap(ctx, msg.Amount.Amount, false) {
+		return nil, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
 	// delegate back the unbonding delegation amount to the validator
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, sdkstaking.Unbonding, validator, false)
+	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, sdkstaking.Unbonding, validator, false)
 	if err != nil {
 		return nil, err
 	}
 
+	if isLiquidStakingProvider {
+		if k.ExceedsValidatorBondCap(ctx, validator, newShares) {
+			return nil, types.ErrInsufficientValidatorBondShares
+		}
+
+		validator, _ = k.GetLiquidValidator(ctx, valAddr)
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+
+		k.IncreaseTotalLiquidStakedTokens(ctx, sdk.NewDecFromInt(msg.Amount.Amount))
+	}
+
 	amount := unbondEntry.Balance.Sub(msg.Amount.Amount)
 	if amount.IsZero() {
 		ubd.RemoveEntry(unbondEntryIndex)
@@ -586,8 +698,16 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, sdkstaking.ErrNoDelegatorForAddress
 	}
 
-	if delegation.Exempt {
-		return nil, types.ErrExemptDelegationNotAllowedForTokenizeShare
+	if delegation.ValidatorBond {
+		return nil, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	if err := validator.TokenizeSharesPolicy.ValidateShareOwner(msg.TokenizedShareOwner); err != nil {
+		return nil, err
+	}
+
+	if !k.MeetsMinValidatorSelfBond(ctx, validator) {
+		return nil, types.ErrInsufficientValidatorSelfBond
 	}
 
 	if msg.Amount.Denom != k.BondDenom(ctx) {
@@ -621,12 +741,17 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, err
 	}
 
-	// exempt shares check before tokenize operation
-	exemptionFactor := k.ExemptionFactor(ctx)
-	if !exemptionFactor.IsNegative() {
-		maxValTotalShare := validator.TotalExemptShares.Mul(exemptionFactor)
-		if validator.TotalTokenizedShares.Add(shares).GT(maxValTotalShare) {
-			return nil, types.ErrInsufficientExemptShares
+	// validator bond and global liquid staking cap check before tokenize operation
+	// if the delegator is a liquid staking provider, the shares were already
+	// counted towards both caps when they were delegated
+	isLiquidStakingProvider := k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+	if !isLiquidStakingProvider {
+		if k.ExceedsValidatorBondCap(ctx, validator, shares) {
+			return nil, types.ErrInsufficientValidatorBondShares
+		}
+
+		if k.ExceedsGlobalLiquidStakingCap(ctx, msg.Amount.Amount, validator.IsBonded()) {
+			return nil, types.ErrGlobalLiquidStakingCapExceeded
 		}
 	}
 
@@ -638,6 +763,17 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		Owner:         msg.TokenizedShareOwner,
 		ModuleAccount: fmt.Sprintf("tokenizeshare_%d", recordId),
 		Validator:     msg.ValidatorAddress,
+		SplitRewards:  msg.SplitRewards,
+	}
+
+	// create reward ownership record before the share tokens are minted, so that the rewards of the
+	// first holder are checkpointed when they are sent if the rewards of the record are split
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetTokenizeShareRecordDenomMetadata(ctx, record); err != nil {
+		return nil, err
 	}
 
 	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), msg.Amount.Amount)
@@ -667,9 +803,6 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, err
 	}
 
-	// create reward ownership record
-	k.AddTokenizeShareRecord(ctx, record)
-
 	// send coins to module account
 	err = k.bankKeeper.SendCoins(ctx, delegatorAddress, record.GetModuleAddress(), sdk.Coins{msg.Amount})
 	if err != nil {
@@ -683,13 +816,20 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 	}
 
 	// delegate from module account
-	_, err = k.Keeper.Delegate(ctx, record.GetModuleAddress(), msg.Amount.Amount, sdkstaking.Unbonded, validator, true)
+	newShares, err := k.Keeper.Delegate(ctx, record.GetModuleAddress(), msg.Amount.Amount, sdkstaking.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
 
+	// the shares of the record delegation may differ from the unbonded shares once the validator
+	// has been slashed; the shares of a liquid staking provider were already liquid
 	validator, _ = k.GetLiquidValidator(ctx, valAddr)
-	validator.TotalTokenizedShares = validator.TotalTokenizedShares.Add(shares)
+	if isLiquidStakingProvider {
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(newShares).Sub(shares)
+	} else {
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(newShares)
+		k.IncreaseTotalLiquidStakedTokens(ctx, sdk.NewDecFromInt(msg.Amount.Amount))
+	}
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvent(
@@ -700,6 +840,7 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
 			sdk.NewAttribute(types.AttributeKeyShareRecordId, fmt.Sprintf("%d", record.Id)),
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySplitRewards, strconv.FormatBool(msg.SplitRewards)),
 		),
 	)
 
@@ -708,6 +849,91 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 	}, nil
 }
 
+// TokenizeSharesBatch defines a method for tokenizing shares from multiple validators in a single
+// message. Each entry is tokenized with the checks of TokenizeShares to its own tokenize share record,
+// and the message fails as a whole if any entry fails
+func (k msgServer) TokenizeSharesBatch(goCtx context.Context, msg *types.MsgTokenizeSharesBatch) (*types.MsgTokenizeSharesBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := &types.MsgTokenizeSharesBatchResponse{}
+	for _, entry := range msg.Entries {
+		tokenizeRes, err := k.TokenizeShares(goCtx, &types.MsgTokenizeShares{
+			DelegatorAddress:    msg.DelegatorAddress,
+			ValidatorAddress:    entry.ValidatorAddress,
+			Amount:              entry.Amount,
+			TokenizedShareOwner: msg.TokenizedShareOwner,
+			SplitRewards:        msg.SplitRewards,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "validator %s", entry.ValidatorAddress)
+		}
+
+		res.Amounts = append(res.Amounts, tokenizeRes.Amount)
+		res.RecordIds = append(res.RecordIds, k.GetLastTokenizeShareRecordId(ctx))
+	}
+
+	return res, nil
+}
+
+// TokenizeUnbondingDelegation defines a method for tokenizing the balance of an unbonding delegation
+// entry in a single step. The entry is canceled with the checks of CancelUnbondingDelegation and the
+// shares delegated back to the validator are tokenized with the checks of TokenizeShares
+func (k msgServer) TokenizeUnbondingDelegation(goCtx context.Context, msg *types.MsgTokenizeUnbondingDelegation) (*types.MsgTokenizeUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep track of the delegation shares to determine the shares delegated back
+	sharesBefore := sdk.ZeroDec()
+	if delegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valAddr); found {
+		sharesBefore = delegation.Shares
+	}
+
+	_, err = k.CancelUnbondingDelegation(goCtx, &types.MsgCancelUnbondingDelegation{
+		DelegatorAddress: msg.DelegatorAddress,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           msg.Amount,
+		CreationHeight:   msg.CreationHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+	delegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoDelegation
+	}
+
+	// tokenize the tokens of the shares delegated back, which may be rounded down
+	tokens := validator.TokensFromShares(delegation.Shares.Sub(sharesBefore)).TruncateInt()
+	tokenizeRes, err := k.TokenizeShares(goCtx, &types.MsgTokenizeShares{
+		DelegatorAddress:    msg.DelegatorAddress,
+		ValidatorAddress:    msg.ValidatorAddress,
+		Amount:              sdk.NewCoin(msg.Amount.Denom, tokens),
+		TokenizedShareOwner: msg.TokenizedShareOwner,
+		SplitRewards:        msg.SplitRewards,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTokenizeUnbondingDelegationResponse{
+		Amount: tokenizeRes.Amount,
+	}, nil
+}
+
 func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemTokensforShares) (*types.MsgRedeemTokensforSharesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -736,10 +962,20 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 		return nil, sdkstaking.ErrNoValidatorFound
 	}
 
+	// settle the rewards of the redeemed fraction of the share tokens before the delegation of the
+	// record is reduced; the rewards of a compounding record are restaked, which increases its delegation
+	shareDenomSupply := k.bankKeeper.GetSupply(ctx, msg.Amount.Denom)
+	fraction := sdk.NewDecFromInt(msg.Amount.Amount).QuoInt(shareDenomSupply.Amount)
+	if err := k.BeforeTokenizeShareRecordRedeemed(ctx, record.Id, delegatorAddress, fraction); err != nil {
+		return nil, err
+	}
+
 	// calculate the ratio between shares and redeem amount
 	// moduleAccountTotalDelegation * redeemAmount / totalIssue
 	delegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
-	shareDenomSupply := k.bankKeeper.GetSupply(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, sdkstaking.ErrNoDelegation
+	}
 	shares := delegation.Shares.Mul(sdk.NewDecFromInt(msg.Amount.Amount)).QuoInt(shareDenomSupply.Amount)
 
 	returnAmount, err := k.Unbond(ctx, record.GetModuleAddress(), valAddr, shares)
@@ -789,13 +1025,21 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 
 	// convert the share tokens to delegated status
 	// Note: Delegate(substractAccount => true) -> DelegateCoinsFromAccountToModule -> TrackDelegation for vesting account
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, sdkstaking.Unbonded, validator, true)
+	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, sdkstaking.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
 
+	// if the shares are redeemed to a liquid staking provider, they remain liquid and the totals
+	// only account for the difference between the redeemed shares and the delegated shares;
+	// otherwise they are no longer liquid staked
 	validator, _ = k.GetLiquidValidator(ctx, valAddr)
-	validator.TotalTokenizedShares = validator.TotalTokenizedShares.Sub(shares)
+	if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(newShares).Sub(shares)
+	} else {
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(shares)
+		k.DecreaseTotalLiquidStakedTokens(ctx, sdk.NewDecFromInt(returnAmount))
+	}
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvent(
@@ -812,6 +1056,91 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 	}, nil
 }
 
+// RedeemTokensAndRedelegate defines a method for redeeming share tokens and redelegating the
+// redeemed delegation to a destination validator in a single step. The redelegation is subject
+// to the same rules as a BeginRedelegate, and the destination delegation can be tokenized again
+func (k msgServer) RedeemTokensAndRedelegate(goCtx context.Context, msg *types.MsgRedeemTokensAndRedelegate) (*types.MsgRedeemTokensAndRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	valSrcAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if valSrcAddr.Equals(valDstAddr) {
+		return nil, sdkstaking.ErrSelfRedelegation
+	}
+
+	redeemRes, err := k.RedeemTokens(goCtx, &types.MsgRedeemTokensforShares{
+		DelegatorAddress: msg.DelegatorAddress,
+		Amount:           msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// keep track of the destination delegation shares to determine the shares
+	// created by the redelegation
+	dstSharesBefore := sdk.ZeroDec()
+	if dstDelegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valDstAddr); found {
+		dstSharesBefore = dstDelegation.Shares
+	}
+
+	redelegateRes, err := k.BeginRedelegate(goCtx, types.NewMsgBeginRedelegate(delegatorAddress, valSrcAddr, valDstAddr, redeemRes.Amount))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgRedeemTokensAndRedelegateResponse{
+		Amount:          redeemRes.Amount,
+		CompletionTime:  redelegateRes.CompletionTime,
+		TokenizedShares: sdk.NewCoin(redeemRes.Amount.Denom, sdk.ZeroInt()),
+	}
+
+	if msg.TokenizedShareOwner != "" {
+		dstValidator, found := k.GetLiquidValidator(ctx, valDstAddr)
+		if !found {
+			return nil, sdkstaking.ErrBadRedelegationDst
+		}
+		dstDelegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valDstAddr)
+		if !found {
+			return nil, sdkstaking.ErrNoDelegation
+		}
+
+		// tokenize the tokens of the redelegated shares, which may be rounded down
+		tokens := dstValidator.TokensFromShares(dstDelegation.Shares.Sub(dstSharesBefore)).TruncateInt()
+		tokenizeRes, err := k.TokenizeShares(goCtx, &types.MsgTokenizeShares{
+			DelegatorAddress:    msg.DelegatorAddress,
+			ValidatorAddress:    msg.ValidatorDstAddress,
+			Amount:              sdk.NewCoin(redeemRes.Amount.Denom, tokens),
+			TokenizedShareOwner: msg.TokenizedShareOwner,
+			SplitRewards:        msg.SplitRewards,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		res.TokenizedShares = tokenizeRes.Amount
+	}
+
+	return res, nil
+}
+
 func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -824,11 +1153,29 @@ func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types
 		return nil, types.ErrNotTokenizeShareRecordOwner
 	}
 
+	// the new owner must be allowed by the validator of the record, but transfers of existing
+	// records keep working when the validator disables tokenization
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+	if validator, found := k.GetLiquidValidator(ctx, valAddr); found {
+		if err := validator.TokenizeSharesPolicy.ValidateNewShareOwner(msg.NewOwner); err != nil {
+			return nil, err
+		}
+	}
+
 	// Remove old account reference
 	oldOwner, err := sdk.AccAddressFromBech32(record.Owner)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress
 	}
+
+	// settle the rewards accrued so far to the current owner
+	if err := k.BeforeTokenizeShareRecordModified(ctx, record.Id); err != nil {
+		return nil, err
+	}
+
 	k.deleteTokenizeShareRecordWithOwner(ctx, oldOwner, record.Id)
 
 	record.Owner = msg.NewOwner
@@ -853,7 +1200,244 @@ func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types
 	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
 }
 
-func (k msgServer) ExemptDelegation(goCtx context.Context, msg *types.MsgExemptDelegation) (*types.MsgExemptDelegationResponse, error) {
+// EnableTokenizeShareRecordSplitRewards defines a method for the owner of a tokenize share record
+// to split its rewards between the share token holders
+// Once enabled, the rewards can no longer be paid to the owner, so that the share token holders
+// can rely on them
+func (k msgServer) EnableTokenizeShareRecordSplitRewards(goCtx context.Context, msg *types.MsgEnableTokenizeShareRecordSplitRewards) (*types.MsgEnableTokenizeShareRecordSplitRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.GetTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	if err != nil {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	if record.Owner != msg.Sender {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	if record.SplitRewards {
+		return nil, types.ErrTokenizeShareRecordSplitRewardsEnabled
+	}
+
+	if record.CompoundRewards {
+		return nil, types.ErrTokenizeShareRecordCompoundRewards
+	}
+
+	// settle the rewards accrued so far to the owner
+	if err := k.BeforeTokenizeShareRecordModified(ctx, record.Id); err != nil {
+		return nil, err
+	}
+
+	record.SplitRewards = true
+	k.setTokenizeShareRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEnableSplitRewards,
+			sdk.NewAttribute(types.AttributeKeyShareRecordId, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgEnableTokenizeShareRecordSplitRewardsResponse{}, nil
+}
+
+// SetTokenizeShareRecordCompoundRewards defines a method for the owner of a tokenize share record
+// to restake its bond denom rewards to the validator instead of receiving them, which increases the
+// tokens behind each share token. The rewards in other denoms are still paid to the owner
+func (k msgServer) SetTokenizeShareRecordCompoundRewards(goCtx context.Context, msg *types.MsgSetTokenizeShareRecordCompoundRewards) (*types.MsgSetTokenizeShareRecordCompoundRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.GetTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	if err != nil {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	if record.Owner != msg.Sender {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	// the rewards of a split record belong to the share token holders
+	if msg.Enabled && record.SplitRewards {
+		return nil, types.ErrTokenizeShareRecordSplitRewardsEnabled
+	}
+
+	if record.CompoundRewards != msg.Enabled {
+		// settle the rewards accrued so far under the current mode
+		if err := k.BeforeTokenizeShareRecordModified(ctx, record.Id); err != nil {
+			return nil, err
+		}
+
+		record.CompoundRewards = msg.Enabled
+		k.setTokenizeShareRecord(ctx, record)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCompoundRewards,
+			sdk.NewAttribute(types.AttributeKeyShareRecordId, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(types.AttributeKeyCompoundRewards, strconv.FormatBool(msg.Enabled)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgSetTokenizeShareRecordCompoundRewardsResponse{}, nil
+}
+
+// MergeTokenizeShareRecords defines a method for the owner of tokenize share records with the same
+// validator to merge them into a surviving record. The owner must hold all the share tokens of the
+// merged records, which are exchanged for share tokens of the surviving record at its exchange rate,
+// and the delegation of the merged records is moved to the surviving record before they are deleted
+func (k msgServer) MergeTokenizeShareRecords(goCtx context.Context, msg *types.MsgMergeTokenizeShareRecords) (*types.MsgMergeTokenizeShareRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	if err != nil {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	if record.Owner != msg.Sender {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	// the share tokens minted for the surviving record would dilute the split rewards of its holders
+	// and the compounded rewards behind its share tokens
+	if record.SplitRewards || record.CompoundRewards {
+		return nil, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotMergeable, "record %d", record.Id)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedRecords := make([]types.TokenizeShareRecord, 0, len(msg.MergedRecordIds))
+	for _, recordId := range msg.MergedRecordIds {
+		mergedRecord, err := k.GetTokenizeShareRecord(ctx, recordId)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "record %d", recordId)
+		}
+
+		if mergedRecord.Owner != msg.Sender {
+			return nil, errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "record %d", recordId)
+		}
+
+		if mergedRecord.Validator != record.Validator {
+			return nil, errorsmod.Wrapf(types.ErrTokenizeShareRecordValidatorMismatch, "record %d", recordId)
+		}
+
+		if mergedRecord.SplitRewards || mergedRecord.CompoundRewards {
+			return nil, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotMergeable, "record %d", recordId)
+		}
+
+		// share tokens held by others could no longer be redeemed once the record is deleted
+		denom := mergedRecord.GetShareTokenDenom()
+		if k.bankKeeper.GetBalance(ctx, sender, denom).Amount.LT(k.bankKeeper.GetSupply(ctx, denom).Amount) {
+			return nil, errorsmod.Wrapf(types.ErrNotAllShareTokensHeld, "record %d", recordId)
+		}
+
+		mergedRecords = append(mergedRecords, mergedRecord)
+	}
+
+	// settle the rewards accrued so far by the surviving record before its delegation is modified
+	if err := k.BeforeTokenizeShareRecordModified(ctx, record.Id); err != nil {
+		return nil, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), sdk.ZeroInt())
+	for _, mergedRecord := range mergedRecords {
+		delegation, found := k.GetLiquidDelegation(ctx, mergedRecord.GetModuleAddress(), valAddr)
+		if !found {
+			return nil, errorsmod.Wrapf(sdkstaking.ErrNoDelegation, "record %d", mergedRecord.Id)
+		}
+		recordDelegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			return nil, errorsmod.Wrapf(sdkstaking.ErrNoDelegation, "record %d", record.Id)
+		}
+
+		// settle the rewards of the merged record while its delegation still exists
+		if err := k.BeforeTokenizeShareRecordRemoved(ctx, mergedRecord.Id); err != nil {
+			return nil, err
+		}
+
+		// convert the merged delegation at the exchange rate between the shares and the share tokens
+		// of the surviving record: recordShareTokenSupply * mergedShares / recordShares
+		recordSupply := k.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).Amount
+		shareToken := sdk.NewCoin(record.GetShareTokenDenom(), delegation.Shares.MulInt(recordSupply).Quo(recordDelegation.Shares).TruncateInt())
+
+		// move the delegation shares to the module account of the surviving record, which leaves the
+		// tokens of the validator and the liquid staked totals unchanged
+		if err := k.BeforeDelegationSharesModified(ctx, mergedRecord.GetModuleAddress(), valAddr); err != nil {
+			return nil, err
+		}
+		if err := k.RemoveDelegation(ctx, delegation); err != nil {
+			return nil, err
+		}
+
+		if err := k.BeforeDelegationSharesModified(ctx, record.GetModuleAddress(), valAddr); err != nil {
+			return nil, err
+		}
+		recordDelegation.Shares = recordDelegation.Shares.Add(delegation.Shares)
+		k.SetDelegation(ctx, recordDelegation)
+		if err := k.AfterDelegationModified(ctx, record.GetModuleAddress(), valAddr); err != nil {
+			return nil, err
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, mergedRecord.Id); err != nil {
+			return nil, err
+		}
+
+		// send the share tokens of the merged record to NotBondedPool and burn
+		mergedShareTokens := k.bankKeeper.GetBalance(ctx, sender, mergedRecord.GetShareTokenDenom())
+		if mergedShareTokens.IsPositive() {
+			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.NotBondedPoolName, sdk.Coins{mergedShareTokens})
+			if err != nil {
+				return nil, err
+			}
+			err = k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, sdk.Coins{mergedShareTokens})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// mint the share tokens of the surviving record in exchange
+		if shareToken.IsPositive() {
+			err = k.bankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.Coins{shareToken})
+			if err != nil {
+				return nil, err
+			}
+
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, sdk.Coins{shareToken})
+			if err != nil {
+				return nil, err
+			}
+		}
+		shareTokens = shareTokens.Add(shareToken)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMergeTokenizeShareRecords,
+				sdk.NewAttribute(types.AttributeKeyShareRecordId, fmt.Sprintf("%d", record.Id)),
+				sdk.NewAttribute(types.AttributeKeyMergedRecordId, fmt.Sprintf("%d", mergedRecord.Id)),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyAmount, mergedShareTokens.String()),
+			),
+		)
+	}
+
+	return &types.MsgMergeTokenizeShareRecordsResponse{
+		Amount: shareTokens,
+	}, nil
+}
+
+// ValidatorBond defines a method for performing a validator self-bond
+func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
@@ -876,20 +1460,191 @@ func (k msgServer) ExemptDelegation(goCtx context.Context, msg *types.MsgExemptD
 		return nil, sdkstaking.ErrNoDelegation
 	}
 
-	if !delegation.Exempt {
-		delegation.Exempt = true
+	if !delegation.ValidatorBond {
+		delegation.ValidatorBond = true
 		k.SetDelegation(ctx, delegation)
-		validator.TotalExemptShares = validator.TotalExemptShares.Add(delegation.Shares)
+		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(delegation.Shares)
 		k.SetValidator(ctx, validator)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
-				types.EventTypeExemptDelegation,
+				types.EventTypeValidatorBond,
 				sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
 				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
 			),
 		)
 	}
 
+	return &types.MsgValidatorBondResponse{}, nil
+}
+
+// RevokeValidatorBond defines a method for removing the validator self-bond flag from a delegation
+func (k msgServer) RevokeValidatorBond(goCtx context.Context, msg *types.MsgRevokeValidatorBond) (*types.MsgRevokeValidatorBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetLiquidDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoDelegation
+	}
+
+	if !delegation.ValidatorBond {
+		return nil, types.ErrDelegationNotValidatorBond
+	}
+
+	// the remaining validator bond shares must still cover the validator's liquid shares
+	validatorBondFactor := k.GetValidatorBondFactor(ctx, valAddr)
+	if !validatorBondFactor.IsNegative() {
+		maxLiquidSharesAfter := validator.TotalValidatorBondShares.Sub(delegation.Shares).Mul(validatorBondFactor)
+		if maxLiquidSharesAfter.LT(validator.TotalLiquidShares) {
+			return nil, types.ErrInsufficientValidatorBondShares
+		}
+	}
+
+	delegation.ValidatorBond = false
+	k.SetDelegation(ctx, delegation)
+	k.RemoveValidatorBondDelegationIndex(ctx, valAddr, delAddr)
+	validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(delegation.Shares)
+	k.SetValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeValidatorBond,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+	)
+
+	return &types.MsgRevokeValidatorBondResponse{}, nil
+}
+
+// SetTokenizeSharesPolicy defines a method for a validator operator to disable or restrict
+// new tokenizations of delegations to the validator
+func (k msgServer) SetTokenizeSharesPolicy(goCtx context.Context, msg *types.MsgSetTokenizeSharesPolicy) (*types.MsgSetTokenizeSharesPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+
+	validator.TokenizeSharesPolicy = msg.TokenizeSharesPolicy
+	k.SetValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetTokenizeSharesPolicy,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDisabled, strconv.FormatBool(msg.TokenizeSharesPolicy.Disabled)),
+			sdk.NewAttribute(types.AttributeKeyAllowedOwners, strings.Join(msg.TokenizeSharesPolicy.AllowedOwners, ",")),
+		),
+	)
+
+	return &types.MsgSetTokenizeSharesPolicyResponse{}, nil
+}
+
+// UpdateParams defines a method to update the module parameters through a governance proposal
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetValidatorBondFactorOverride defines a method to replace the validator bond factor param
+// of a single validator through a governance proposal
+func (k msgServer) SetValidatorBondFactorOverride(goCtx context.Context, msg *types.MsgSetValidatorBondFactorOverride) (*types.MsgSetValidatorBondFactorOverrideResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetLiquidValidator(ctx, valAddr); !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+
+	k.Keeper.SetValidatorBondFactorOverride(ctx, valAddr, msg.ValidatorBondFactor)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetValidatorBondFactorOverride,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyBondFactor, msg.ValidatorBondFactor.String()),
+		),
+	)
+
+	return &types.MsgSetValidatorBondFactorOverrideResponse{}, nil
+}
+
+// RemoveValidatorBondFactorOverride defines a method to remove the validator bond factor override
+// of a validator through a governance proposal, the validator bond factor param applies to it again
+func (k msgServer) RemoveValidatorBondFactorOverride(goCtx context.Context, msg *types.MsgRemoveValidatorBondFactorOverride) (*types.MsgRemoveValidatorBondFactorOverrideResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetValidatorBondFactorOverride(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorBondFactorOverride
+	}
+
+	k.DeleteValidatorBondFactorOverride(ctx, valAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveValidatorBondFactorOverride,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+	)
+
+	return &types.MsgRemoveValidatorBondFactorOverrideResponse{}, nil
+}
+
+// ExemptDelegation is the ADR-001 name of ValidatorBond, kept so that transactions
+// built with the old type URL are still accepted
+//
+// Deprecated: use ValidatorBond, ExemptDelegation will be removed in the next release
+func (k msgServer) ExemptDelegation(goCtx context.Context, msg *types.MsgExemptDelegation) (*types.MsgExemptDelegationResponse, error) {
+	if _, err := k.ValidatorBond(goCtx, &types.MsgValidatorBond{
+		DelegatorAddress: msg.DelegatorAddress,
+		ValidatorAddress: msg.ValidatorAddress,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgExemptDelegationResponse{}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -29,9 +30,9 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 		targetVestingDelAfterShare    math.Int
 		targetVestingDelAfterRedeem   math.Int
 		slashFactor                   sdk.Dec
-		exemptionFactor               sdk.Dec
-		exemptDelegate                bool
-		exemptDelegatorIndex          int
+		validatorBondFactor           sdk.Dec
+		validatorBondDelegation       bool
+		validatorBondDelegatorIndex   int
 		expTokenizeErr                bool
 		expRedeemErr                  bool
 		prevAccountDelegationExists   bool
@@ -44,8 +45,8 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			tokenizeShareAmount:           app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
 			redeemAmount:                  app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
 			slashFactor:                   sdk.ZeroDec(),
-			exemptionFactor:               sdk.NewDec(-1),
-			exemptDelegate:                false,
+			validatorBondFactor:           sdk.NewDec(-1),
+			validatorBondDelegation:       false,
 			expTokenizeErr:                false,
 			expRedeemErr:                  false,
 			prevAccountDelegationExists:   false,
@@ -58,8 +59,8 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			tokenizeShareAmount:           app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
 			redeemAmount:                  app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			slashFactor:                   sdk.NewDecWithPrec(10, 2),
-			exemptionFactor:               sdk.NewDec(-1),
-			exemptDelegate:                false,
+			validatorBondFactor:           sdk.NewDec(-1),
+			validatorBondDelegation:       false,
 			expTokenizeErr:                false,
 			expRedeemErr:                  false,
 			prevAccountDelegationExists:   false,
@@ -72,36 +73,36 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			tokenizeShareAmount:           app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			redeemAmount:                  app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			slashFactor:                   sdk.ZeroDec(),
-			exemptionFactor:               sdk.NewDec(-1),
-			exemptDelegate:                false,
+			validatorBondFactor:           sdk.NewDec(-1),
+			validatorBondDelegation:       false,
 			expTokenizeErr:                false,
 			expRedeemErr:                  false,
 			prevAccountDelegationExists:   true,
 			recordAccountDelegationExists: false,
 		},
 		{
-			name:                "over tokenize",
-			vestingAmount:       sdk.NewInt(0),
-			delegationAmount:    app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
-			tokenizeShareAmount: app.StakingKeeper.TokensFromConsensusPower(ctx, 30),
-			redeemAmount:        app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
-			slashFactor:         sdk.ZeroDec(),
-			exemptionFactor:     sdk.NewDec(-1),
-			exemptDelegate:      false,
-			expTokenizeErr:      true,
-			expRedeemErr:        false,
+			name:                    "over tokenize",
+			vestingAmount:           sdk.NewInt(0),
+			delegationAmount:        app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
+			tokenizeShareAmount:     app.StakingKeeper.TokensFromConsensusPower(ctx, 30),
+			redeemAmount:            app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
+			slashFactor:             sdk.ZeroDec(),
+			validatorBondFactor:     sdk.NewDec(-1),
+			validatorBondDelegation: false,
+			expTokenizeErr:          true,
+			expRedeemErr:            false,
 		},
 		{
-			name:                "over redeem",
-			vestingAmount:       sdk.NewInt(0),
-			delegationAmount:    app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
-			tokenizeShareAmount: app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
-			redeemAmount:        app.StakingKeeper.TokensFromConsensusPower(ctx, 40),
-			slashFactor:         sdk.ZeroDec(),
-			exemptionFactor:     sdk.NewDec(-1),
-			exemptDelegate:      false,
-			expTokenizeErr:      false,
-			expRedeemErr:        true,
+			name:                    "over redeem",
+			vestingAmount:           sdk.NewInt(0),
+			delegationAmount:        app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
+			tokenizeShareAmount:     app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
+			redeemAmount:            app.StakingKeeper.TokensFromConsensusPower(ctx, 40),
+			slashFactor:             sdk.ZeroDec(),
+			validatorBondFactor:     sdk.NewDec(-1),
+			validatorBondDelegation: false,
+			expTokenizeErr:          false,
+			expRedeemErr:            true,
 		},
 		{
 			name:                        "vesting account tokenize share failure",
@@ -110,8 +111,8 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			tokenizeShareAmount:         app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
 			redeemAmount:                app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
 			slashFactor:                 sdk.ZeroDec(),
-			exemptionFactor:             sdk.NewDec(-1),
-			exemptDelegate:              false,
+			validatorBondFactor:         sdk.NewDec(-1),
+			validatorBondDelegation:     false,
 			expTokenizeErr:              true,
 			expRedeemErr:                false,
 			prevAccountDelegationExists: true,
@@ -125,14 +126,14 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			targetVestingDelAfterShare:  app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			targetVestingDelAfterRedeem: app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			slashFactor:                 sdk.ZeroDec(),
-			exemptionFactor:             sdk.NewDec(-1),
-			exemptDelegate:              false,
+			validatorBondFactor:         sdk.NewDec(-1),
+			validatorBondDelegation:     false,
 			expTokenizeErr:              false,
 			expRedeemErr:                false,
 			prevAccountDelegationExists: true,
 		},
 		{
-			name:                        "try tokenize share for exempt delegation",
+			name:                        "try tokenize share for validator bond delegation",
 			vestingAmount:               app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			delegationAmount:            app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
 			tokenizeShareAmount:         app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
@@ -140,15 +141,15 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			targetVestingDelAfterShare:  app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			targetVestingDelAfterRedeem: app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			slashFactor:                 sdk.ZeroDec(),
-			exemptionFactor:             sdk.NewDec(10),
-			exemptDelegate:              true,
-			exemptDelegatorIndex:        1,
+			validatorBondFactor:         sdk.NewDec(10),
+			validatorBondDelegation:     true,
+			validatorBondDelegatorIndex: 1,
 			expTokenizeErr:              true,
 			expRedeemErr:                false,
 			prevAccountDelegationExists: true,
 		},
 		{
-			name:                        "exempt factor enabled without exempt delegation tokenize share",
+			name:                        "validator bond factor enabled without validator bond delegation tokenize share",
 			vestingAmount:               app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			delegationAmount:            app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
 			tokenizeShareAmount:         app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
@@ -156,14 +157,14 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			targetVestingDelAfterShare:  app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			targetVestingDelAfterRedeem: app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			slashFactor:                 sdk.ZeroDec(),
-			exemptionFactor:             sdk.NewDec(10),
-			exemptDelegate:              false,
+			validatorBondFactor:         sdk.NewDec(10),
+			validatorBondDelegation:     false,
 			expTokenizeErr:              true,
 			expRedeemErr:                false,
 			prevAccountDelegationExists: true,
 		},
 		{
-			name:                        "exempt factor enabled with exempt delegation - successful tokenize share",
+			name:                        "validator bond factor enabled with validator bond delegation - successful tokenize share",
 			vestingAmount:               app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			delegationAmount:            app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
 			tokenizeShareAmount:         app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
@@ -171,9 +172,9 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			targetVestingDelAfterShare:  app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			targetVestingDelAfterRedeem: app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
 			slashFactor:                 sdk.ZeroDec(),
-			exemptionFactor:             sdk.NewDec(10),
-			exemptDelegate:              true,
-			exemptDelegatorIndex:        0,
+			validatorBondFactor:         sdk.NewDec(10),
+			validatorBondDelegation:     true,
+			validatorBondDelegatorIndex: 0,
 			expTokenizeErr:              false,
 			expRedeemErr:                false,
 			prevAccountDelegationExists: true,
@@ -187,9 +188,9 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			addrAcc1, addrAcc2 := addrs[0], addrs[1]
 			addrVal1, addrVal2 := sdk.ValAddress(addrAcc1), sdk.ValAddress(addrAcc2)
 
-			// set exemption factor
+			// set validator bond factor
 			params := app.StakingKeeper.GetParams(ctx)
-			params.ExemptionFactor = tc.exemptionFactor
+			params.ValidatorBondFactor = tc.validatorBondFactor
 			app.StakingKeeper.SetParams(ctx, params)
 
 			if !tc.vestingAmount.IsZero() {
@@ -233,11 +234,11 @@ func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
 			require.True(t, found)
 
 			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
-			if tc.exemptDelegate {
-				err := delegateCoinsFromAccount(ctx, app, addrs[tc.exemptDelegatorIndex], delTokens, val1)
+			if tc.validatorBondDelegation {
+				err := delegateCoinsFromAccount(ctx, app, addrs[tc.validatorBondDelegatorIndex], delTokens, val1)
 				require.NoError(t, err)
-				_, err = msgServer.ExemptDelegation(sdk.WrapSDKContext(ctx), &types.MsgExemptDelegation{
-					DelegatorAddress: addrs[tc.exemptDelegatorIndex].String(),
+				_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+					DelegatorAddress: addrs[tc.validatorBondDelegatorIndex].String(),
 					ValidatorAddress: addrVal1.String(),
 				})
 				require.NoError(t, err)
@@ -396,7 +397,7 @@ func TestTransferTokenizeShareRecord(t *testing.T) {
 		Id:            1,
 		Owner:         addrAcc1.String(),
 		ModuleAccount: "module_account",
-		Validator:     val.String(),
+		Validator:     addrVal.String(),
 	})
 	require.NoError(t, err)
 
@@ -417,32 +418,32 @@ func TestTransferTokenizeShareRecord(t *testing.T) {
 	require.Len(t, records, 1)
 }
 
-func TestExemptDelegation(t *testing.T) {
+func TestValidatorBond(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 
 	testCases := []struct {
-		name             string
-		delegationAmount math.Int
-		alreadyExempt    bool
-		expectErr        bool
+		name                 string
+		delegationAmount     math.Int
+		alreadyValidatorBond bool
+		expectErr            bool
 	}{
 		{
-			name:             "delegation not exist case",
-			delegationAmount: app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
-			alreadyExempt:    false,
-			expectErr:        false,
+			name:                 "delegation not exist case",
+			delegationAmount:     app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
+			alreadyValidatorBond: false,
+			expectErr:            false,
 		},
 		{
-			name:             "already exempt delegation case",
-			delegationAmount: app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
-			alreadyExempt:    true,
-			expectErr:        false,
+			name:                 "already validator bond delegation case",
+			delegationAmount:     app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
+			alreadyValidatorBond: true,
+			expectErr:            false,
 		},
 		{
-			name:             "successful exempt share case",
-			delegationAmount: app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
-			alreadyExempt:    false,
-			expectErr:        false,
+			name:                 "successful validator bond case",
+			delegationAmount:     app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
+			alreadyValidatorBond: false,
+			expectErr:            false,
 		},
 	}
 
@@ -470,7 +471,7 @@ func TestExemptDelegation(t *testing.T) {
 			}
 
 			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
-			_, err := msgServer.ExemptDelegation(sdk.WrapSDKContext(ctx), &types.MsgExemptDelegation{
+			_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
 				DelegatorAddress: addrAcc1.String(),
 				ValidatorAddress: addrVal1.String(),
 			})
@@ -479,20 +480,137 @@ func TestExemptDelegation(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 
-				// check exempt true
+				// check validator bond true
 				delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrAcc1, addrVal1)
 				require.True(t, found)
-				require.True(t, delegation.Exempt)
+				require.True(t, delegation.ValidatorBond)
 
-				// check total exempt shares value increase
+				// check total validator bond shares value increase
 				validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
 				require.True(t, found)
-				require.True(t, validator.TotalExemptShares.Equal(delegation.Shares))
+				require.True(t, validator.TotalValidatorBondShares.Equal(delegation.Shares))
 			}
 		})
 	}
 }
 
+func TestExemptDelegationForwardsToValidatorBond(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrAcc1 := addrs[0]
+	addrVal1 := sdk.ValAddress(addrAcc1)
+
+	val1 := teststaking.NewValidator(t, addrVal1, simapp.CreateTestPubKeys(1)[0])
+	val1.Status = sdkstaking.Bonded
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val1)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, val1)
+
+	err := delegateCoinsFromAccount(ctx, app, addrAcc1, app.StakingKeeper.TokensFromConsensusPower(ctx, 20), val1)
+	require.NoError(t, err)
+
+	// the ADR-001 message is still accepted and marks the delegation as a validator bond
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err = msgServer.ExemptDelegation(sdk.WrapSDKContext(ctx), &types.MsgExemptDelegation{ //nolint:staticcheck
+		DelegatorAddress: addrAcc1.String(),
+		ValidatorAddress: addrVal1.String(),
+	})
+	require.NoError(t, err)
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrAcc1, addrVal1)
+	require.True(t, found)
+	require.True(t, delegation.ValidatorBond)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+	require.True(t, found)
+	require.True(t, validator.TotalValidatorBondShares.Equal(delegation.Shares))
+}
+
+func TestRevokeValidatorBond(t *testing.T) {
+	testCases := []struct {
+		name                string
+		validatorBond       bool
+		validatorBondFactor sdk.Dec
+		liquidShares        sdk.Dec
+		expectedErr         error
+	}{
+		{
+			name:                "delegation is not a validator bond",
+			validatorBond:       false,
+			validatorBondFactor: sdk.NewDec(-1),
+			liquidShares:        sdk.ZeroDec(),
+			expectedErr:         types.ErrDelegationNotValidatorBond,
+		},
+		{
+			name:                "remaining validator bond does not cover liquid shares",
+			validatorBond:       true,
+			validatorBondFactor: sdk.NewDec(10),
+			liquidShares:        sdk.NewDec(1),
+			expectedErr:         types.ErrInsufficientValidatorBondShares,
+		},
+		{
+			name:                "validator bond factor disabled",
+			validatorBond:       true,
+			validatorBondFactor: sdk.NewDec(-1),
+			liquidShares:        sdk.NewDec(1),
+		},
+		{
+			name:                "successful revoke without liquid shares",
+			validatorBond:       true,
+			validatorBondFactor: sdk.NewDec(10),
+			liquidShares:        sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, app, ctx := createTestInput(t)
+			addrs := simapp.AddTestAddrs(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+			addrAcc1 := addrs[0]
+			addrVal1 := sdk.ValAddress(addrAcc1)
+
+			params := app.StakingKeeper.GetParams(ctx)
+			params.ValidatorBondFactor = tc.validatorBondFactor
+			app.StakingKeeper.SetParams(ctx, params)
+
+			val1 := teststaking.NewValidator(t, addrVal1, simapp.CreateTestPubKeys(1)[0])
+			val1.Status = sdkstaking.Bonded
+			app.StakingKeeper.SetValidator(ctx, val1)
+			app.StakingKeeper.SetValidatorByPowerIndex(ctx, val1)
+			app.StakingKeeper.SetValidatorByConsAddr(ctx, val1)
+
+			err := delegateCoinsFromAccount(ctx, app, addrAcc1, app.StakingKeeper.TokensFromConsensusPower(ctx, 20), val1)
+			require.NoError(t, err)
+
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+			if tc.validatorBond {
+				_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(addrAcc1, addrVal1))
+				require.NoError(t, err)
+			}
+
+			validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+			require.True(t, found)
+			validator.TotalLiquidShares = tc.liquidShares
+			app.StakingKeeper.SetValidator(ctx, validator)
+
+			_, err = msgServer.RevokeValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgRevokeValidatorBond(addrAcc1, addrVal1))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrAcc1, addrVal1)
+			require.True(t, found)
+			require.False(t, delegation.ValidatorBond)
+
+			validator, found = app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+			require.True(t, found)
+			require.True(t, validator.TotalValidatorBondShares.IsZero())
+		})
+	}
+}
+
 func TestUnbondValidator(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
@@ -527,3 +645,629 @@ func TestUnbondValidator(t *testing.T) {
 	require.True(t, found)
 	require.True(t, validator.Jailed)
 }
+
+func TestUpdateParams(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxValidators = 50
+	params.ValidatorBondFactor = sdk.NewDec(10)
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: sdk.AccAddress("invalid_authority___").String(),
+		Params:    params,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// invalid params are rejected
+	invalidParams := params
+	invalidParams.BondDenom = ""
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.StakingKeeper.GetAuthority(),
+		Params:    invalidParams,
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.StakingKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+	require.Equal(t, uint32(50), app.StakingKeeper.MaxValidators(ctx))
+	require.Equal(t, sdk.NewDec(10), app.StakingKeeper.ValidatorBondFactor(ctx))
+}
+
+func TestValidatorBondFactorOverrideMsgs(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrVal1 := sdk.ValAddress(addrs[0])
+	authority := app.StakingKeeper.GetAuthority()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	val1 := teststaking.NewValidator(t, addrVal1, simapp.CreateTestPubKeys(1)[0])
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val1)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	validatorBondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[0], addrVal1, sdk.NewCoin(bondDenom, validatorBondTokens)))
+	require.NoError(t, err)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(addrs[0], addrVal1))
+	require.NoError(t, err)
+
+	// only the authority can set an override
+	_, err = msgServer.SetValidatorBondFactorOverride(sdk.WrapSDKContext(ctx), &types.MsgSetValidatorBondFactorOverride{
+		Authority:           addrs[0].String(),
+		ValidatorAddress:    addrVal1.String(),
+		ValidatorBondFactor: sdk.OneDec(),
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// the validator must exist
+	_, err = msgServer.SetValidatorBondFactorOverride(sdk.WrapSDKContext(ctx), &types.MsgSetValidatorBondFactorOverride{
+		Authority:           authority,
+		ValidatorAddress:    sdk.ValAddress(addrs[1]).String(),
+		ValidatorBondFactor: sdk.OneDec(),
+	})
+	require.ErrorIs(t, err, sdkstaking.ErrNoValidatorFound)
+
+	_, err = msgServer.SetValidatorBondFactorOverride(sdk.WrapSDKContext(ctx), &types.MsgSetValidatorBondFactorOverride{
+		Authority:           authority,
+		ValidatorAddress:    addrVal1.String(),
+		ValidatorBondFactor: sdk.OneDec(),
+	})
+	require.NoError(t, err)
+
+	// tokenizing more than the overridden cap fails although the param would allow it
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 11)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[1], addrVal1, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    addrs[1].String(),
+		ValidatorAddress:    addrVal1.String(),
+		Amount:              sdk.NewCoin(bondDenom, delTokens),
+		TokenizedShareOwner: addrs[1].String(),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	// only the authority can remove the override
+	_, err = msgServer.RemoveValidatorBondFactorOverride(sdk.WrapSDKContext(ctx), &types.MsgRemoveValidatorBondFactorOverride{
+		Authority:        addrs[0].String(),
+		ValidatorAddress: addrVal1.String(),
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = msgServer.RemoveValidatorBondFactorOverride(sdk.WrapSDKContext(ctx), &types.MsgRemoveValidatorBondFactorOverride{
+		Authority:        authority,
+		ValidatorAddress: addrVal1.String(),
+	})
+	require.NoError(t, err)
+
+	// the param applies again
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    addrs[1].String(),
+		ValidatorAddress:    addrVal1.String(),
+		Amount:              sdk.NewCoin(bondDenom, delTokens),
+		TokenizedShareOwner: addrs[1].String(),
+	})
+	require.NoError(t, err)
+
+	// removing a missing override fails
+	_, err = msgServer.RemoveValidatorBondFactorOverride(sdk.WrapSDKContext(ctx), &types.MsgRemoveValidatorBondFactorOverride{
+		Authority:        authority,
+		ValidatorAddress: addrVal1.String(),
+	})
+	require.ErrorIs(t, err, types.ErrNoValidatorBondFactorOverride)
+}
+
+func TestSetTokenizeSharesPolicy(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	addrVal1 := sdk.ValAddress(addrs[0])
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	val1 := teststaking.NewValidator(t, addrVal1, simapp.CreateTestPubKeys(1)[0])
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val1)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(-1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	for _, addr := range addrs[1:] {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addr, addrVal1, sdk.NewCoin(bondDenom, delTokens)))
+		require.NoError(t, err)
+	}
+
+	tokenizeShares := func(delAddr, owner sdk.AccAddress) (*types.MsgTokenizeSharesResponse, error) {
+		return msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    delAddr.String(),
+			ValidatorAddress:    addrVal1.String(),
+			Amount:              sdk.NewCoin(bondDenom, delTokens.QuoRaw(10)),
+			TokenizedShareOwner: owner.String(),
+		})
+	}
+
+	// a record created before the policy keeps working
+	resp, err := tokenizeShares(addrs[1], addrs[1])
+	require.NoError(t, err)
+
+	// the policy must be set on an existing validator
+	_, err = msgServer.SetTokenizeSharesPolicy(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeSharesPolicy(sdk.ValAddress(addrs[1]), types.TokenizeSharesPolicy{Disabled: true}))
+	require.ErrorIs(t, err, sdkstaking.ErrNoValidatorFound)
+
+	_, err = msgServer.SetTokenizeSharesPolicy(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeSharesPolicy(addrVal1, types.TokenizeSharesPolicy{Disabled: true}))
+	require.NoError(t, err)
+
+	val1, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+	require.True(t, found)
+	require.True(t, val1.TokenizeSharesPolicy.Disabled)
+
+	_, err = tokenizeShares(addrs[1], addrs[1])
+	require.ErrorIs(t, err, types.ErrTokenizeSharesDisabledForValidator)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, resp.Amount.Denom)
+	require.NoError(t, err)
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                addrs[1].String(),
+		NewOwner:              addrs[2].String(),
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: addrs[1].String(),
+		Amount:           resp.Amount,
+	})
+	require.NoError(t, err)
+
+	// only the allowed owners can tokenize
+	_, err = msgServer.SetTokenizeSharesPolicy(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeSharesPolicy(addrVal1, types.TokenizeSharesPolicy{AllowedOwners: []string{addrs[2].String()}}))
+	require.NoError(t, err)
+
+	_, err = tokenizeShares(addrs[1], addrs[1])
+	require.ErrorIs(t, err, types.ErrTokenizeShareOwnerNotAllowed)
+
+	resp, err = tokenizeShares(addrs[1], addrs[2])
+	require.NoError(t, err)
+
+	// an allowed owner cannot transfer its record to an owner outside of the allowlist
+	record, err = app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, resp.Amount.Denom)
+	require.NoError(t, err)
+	transferRecord := func(newOwner sdk.AccAddress) error {
+		_, err := msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), &types.MsgTransferTokenizeShareRecord{
+			TokenizeShareRecordId: record.Id,
+			Sender:                record.Owner,
+			NewOwner:              newOwner.String(),
+		})
+		return err
+	}
+	require.ErrorIs(t, transferRecord(addrs[1]), types.ErrTokenizeShareOwnerNotAllowed)
+	require.NoError(t, transferRecord(addrs[2]))
+
+	// clearing the policy allows any owner again
+	_, err = msgServer.SetTokenizeSharesPolicy(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeSharesPolicy(addrVal1, types.TokenizeSharesPolicy{}))
+	require.NoError(t, err)
+
+	_, err = tokenizeShares(addrs[2], addrs[2])
+	require.NoError(t, err)
+}
+
+func TestMinValidatorSelfBond(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	pubKeys := simapp.CreateTestPubKeys(2)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinValidatorSelfBond = app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	commission := types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	selfBond := sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+
+	// validator 1 marks its initial self-delegation as a validator bond
+	addrVal1 := sdk.ValAddress(addrs[0])
+	msg, err := types.NewMsgCreateValidator(addrVal1, pubKeys[0], selfBond, types.Description{Moniker: "val1"}, commission)
+	require.NoError(t, err)
+	msg.ValidatorBond = true
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	val1, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+	require.True(t, found)
+	require.Equal(t, val1.DelegatorShares, val1.TotalValidatorBondShares)
+	selfDelegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, addrs[0], addrVal1)
+	require.True(t, found)
+	require.True(t, selfDelegation.ValidatorBond)
+
+	// validator 2 does not
+	addrVal2 := sdk.ValAddress(addrs[1])
+	msg, err = types.NewMsgCreateValidator(addrVal2, pubKeys[1], selfBond, types.Description{Moniker: "val2"}, commission)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	val2, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVal2)
+	require.True(t, found)
+	require.True(t, val2.TotalValidatorBondShares.IsZero())
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	tokenizeShares := func(valAddr sdk.ValAddress) error {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrs[2], valAddr, sdk.NewCoin(bondDenom, delTokens)))
+		require.NoError(t, err)
+
+		_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    addrs[2].String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(bondDenom, delTokens),
+			TokenizedShareOwner: addrs[2].String(),
+		})
+		return err
+	}
+
+	require.NoError(t, tokenizeShares(addrVal1))
+	require.ErrorIs(t, tokenizeShares(addrVal2), types.ErrInsufficientValidatorSelfBond)
+
+	// a validator bond below the min is not enough
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(addrs[1], addrVal2))
+	require.NoError(t, err)
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(addrs[1], addrVal2, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	require.ErrorIs(t, tokenizeShares(addrVal2), types.ErrInsufficientValidatorSelfBond)
+
+	// the check is disabled with a zero min
+	params.MinValidatorSelfBond = sdk.ZeroInt()
+	app.StakingKeeper.SetParams(ctx, params)
+	require.NoError(t, tokenizeShares(addrVal2))
+}
+
+func TestRedeemTokensAndRedelegate(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	pubKeys := simapp.CreateTestPubKeys(2)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delAddr := addrs[2]
+
+	// create two bonded validators so that redelegations are not completed right away
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	commission := types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	selfBond := sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	addrVal1, addrVal2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	for i, valAddr := range []sdk.ValAddress{addrVal1, addrVal2} {
+		msg, err := types.NewMsgCreateValidator(valAddr, pubKeys[i], selfBond, types.Description{Moniker: valAddr.String()}, commission)
+		require.NoError(t, err)
+		_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, addrVal1, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	tokenizeRes, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorAddress:    addrVal1.String(),
+		Amount:              sdk.NewCoin(bondDenom, delTokens),
+		TokenizedShareOwner: delAddr.String(),
+	})
+	require.NoError(t, err)
+	shareDenom := tokenizeRes.Amount.Denom
+
+	// the shares cannot be redelegated to the validator of the record
+	_, err = msgServer.RedeemTokensAndRedelegate(sdk.WrapSDKContext(ctx),
+		types.NewMsgRedeemTokensAndRedelegate(delAddr, sdk.NewCoin(shareDenom, delTokens), addrVal1))
+	require.ErrorIs(t, err, sdkstaking.ErrSelfRedelegation)
+
+	// redeem and redelegate part of the shares
+	redeemAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	res, err := msgServer.RedeemTokensAndRedelegate(sdk.WrapSDKContext(ctx),
+		types.NewMsgRedeemTokensAndRedelegate(delAddr, sdk.NewCoin(shareDenom, redeemAmount), addrVal2))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(bondDenom, redeemAmount), res.Amount)
+	require.True(t, res.TokenizedShares.IsZero())
+	require.Equal(t, ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)), res.CompletionTime)
+
+	require.Equal(t, delTokens.Sub(redeemAmount), app.BankKeeper.GetBalance(ctx, delAddr, shareDenom).Amount)
+	_, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, addrVal1)
+	require.False(t, found)
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, addrVal2)
+	require.True(t, found)
+	val2, _ := app.StakingKeeper.GetLiquidValidator(ctx, addrVal2)
+	require.Equal(t, redeemAmount, val2.TokensFromShares(delegation.Shares).TruncateInt())
+
+	redelegation, found := app.StakingKeeper.GetRedelegation(ctx, delAddr, addrVal1, addrVal2)
+	require.True(t, found)
+	require.Len(t, redelegation.Entries, 1)
+
+	// redeem the rest of the shares and tokenize them again against the destination validator
+	res, err = msgServer.RedeemTokensAndRedelegate(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensAndRedelegate{
+		DelegatorAddress:    delAddr.String(),
+		Amount:              sdk.NewCoin(shareDenom, delTokens.Sub(redeemAmount)),
+		ValidatorDstAddress: addrVal2.String(),
+		TokenizedShareOwner: addrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, delTokens.Sub(redeemAmount), res.TokenizedShares.Amount)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareDenom)
+	require.Error(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, res.TokenizedShares.Denom)
+	require.NoError(t, err)
+	require.Equal(t, addrVal2.String(), record.Validator)
+	require.Equal(t, addrs[0].String(), record.Owner)
+	require.Equal(t, res.TokenizedShares, app.BankKeeper.GetBalance(ctx, delAddr, res.TokenizedShares.Denom))
+
+	redelegation, found = app.StakingKeeper.GetRedelegation(ctx, delAddr, addrVal1, addrVal2)
+	require.True(t, found)
+	require.Len(t, redelegation.Entries, 2)
+
+	// the delegator cannot redelegate again from a validator it is redelegating to
+	_, err = msgServer.RedeemTokensAndRedelegate(sdk.WrapSDKContext(ctx),
+		types.NewMsgRedeemTokensAndRedelegate(delAddr, res.TokenizedShares, addrVal1))
+	require.ErrorIs(t, err, sdkstaking.ErrTransitiveRedelegation)
+}
+
+func TestTokenizeUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	ctx = ctx.WithBlockHeight(10)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delAddr := addrs[1]
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	addrVal1 := sdk.ValAddress(addrs[0])
+	msg, err := types.NewMsgCreateValidator(addrVal1, simapp.CreateTestPubKeys(1)[0],
+		sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 100)),
+		types.Description{Moniker: "val1"}, types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()))
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, addrVal1, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(delAddr, addrVal1, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	_, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, addrVal1)
+	require.False(t, found)
+
+	// the checks of CancelUnbondingDelegation apply
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 6)
+	_, err = msgServer.TokenizeUnbondingDelegation(sdk.WrapSDKContext(ctx),
+		types.NewMsgTokenizeUnbondingDelegation(delAddr, addrVal1, sdk.NewCoin(bondDenom, tokenizeAmount), 11, delAddr))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = msgServer.TokenizeUnbondingDelegation(sdk.WrapSDKContext(ctx),
+		types.NewMsgTokenizeUnbondingDelegation(delAddr, addrVal1, sdk.NewCoin(bondDenom, delTokens.AddRaw(1)), 10, delAddr))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	res, err := msgServer.TokenizeUnbondingDelegation(sdk.WrapSDKContext(ctx),
+		types.NewMsgTokenizeUnbondingDelegation(delAddr, addrVal1, sdk.NewCoin(bondDenom, tokenizeAmount), 10, addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, tokenizeAmount, res.Amount.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delAddr, res.Amount.Denom))
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, res.Amount.Denom)
+	require.NoError(t, err)
+	require.Equal(t, addrs[0].String(), record.Owner)
+	require.Equal(t, addrVal1.String(), record.Validator)
+
+	// the rest of the entry keeps unbonding and the tokens are no longer delegated by the delegator
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, addrVal1)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, delTokens.Sub(tokenizeAmount), ubd.Entries[0].Balance)
+	_, found = app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, addrVal1)
+	require.False(t, found)
+}
+
+func TestTokenizeSharesBatch(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	pubKeys := simapp.CreateTestPubKeys(2)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delAddr := addrs[2]
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	addrVal1, addrVal2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	for i, valAddr := range []sdk.ValAddress{addrVal1, addrVal2} {
+		val := teststaking.NewValidator(t, valAddr, pubKeys[i])
+		app.StakingKeeper.SetValidator(ctx, val)
+		app.StakingKeeper.SetValidatorByPowerIndex(ctx, val)
+
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens)))
+		require.NoError(t, err)
+	}
+
+	entries := []types.TokenizeSharesBatchEntry{
+		{ValidatorAddress: addrVal1.String(), Amount: sdk.NewCoin(bondDenom, delTokens.QuoRaw(2))},
+		{ValidatorAddress: addrVal2.String(), Amount: sdk.NewCoin(bondDenom, delTokens.QuoRaw(4))},
+	}
+
+	// the checks of TokenizeShares apply to each entry
+	_, err := msgServer.SetTokenizeSharesPolicy(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeSharesPolicy(addrVal2, types.TokenizeSharesPolicy{Disabled: true}))
+	require.NoError(t, err)
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.TokenizeSharesBatch(sdk.WrapSDKContext(cacheCtx), types.NewMsgTokenizeSharesBatch(delAddr, entries, delAddr))
+	require.ErrorIs(t, err, types.ErrTokenizeSharesDisabledForValidator)
+
+	_, err = msgServer.SetTokenizeSharesPolicy(sdk.WrapSDKContext(ctx),
+		types.NewMsgSetTokenizeSharesPolicy(addrVal2, types.TokenizeSharesPolicy{}))
+	require.NoError(t, err)
+
+	res, err := msgServer.TokenizeSharesBatch(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeSharesBatch(delAddr, entries, delAddr))
+	require.NoError(t, err)
+	require.Len(t, res.Amounts, 2)
+	require.Len(t, res.RecordIds, 2)
+
+	for i, entry := range entries {
+		record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, res.RecordIds[i])
+		require.NoError(t, err)
+		require.Equal(t, entry.ValidatorAddress, record.Validator)
+		require.Equal(t, delAddr.String(), record.Owner)
+		require.Equal(t, record.GetShareTokenDenom(), res.Amounts[i].Denom)
+		require.Equal(t, entry.Amount.Amount, res.Amounts[i].Amount)
+		require.Equal(t, res.Amounts[i], app.BankKeeper.GetBalance(ctx, delAddr, res.Amounts[i].Denom))
+
+		metadata, found := app.BankKeeper.GetDenomMetaData(ctx, record.GetShareTokenDenom())
+		require.True(t, found)
+		require.NoError(t, metadata.Validate())
+	}
+
+	// the rest of the delegations are left
+	for _, entry := range entries {
+		valAddr, _ := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+		require.True(t, found)
+		val, _ := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		require.Equal(t, delTokens.Sub(entry.Amount.Amount), val.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+}
+
+func TestMergeTokenizeShareRecords(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs := simapp.AddTestAddrs(app, ctx, 4, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
+	pubKeys := simapp.CreateTestPubKeys(2)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delAddr, otherAddr := addrs[2], addrs[3]
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	addrVal1, addrVal2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	for i, valAddr := range []sdk.ValAddress{addrVal1, addrVal2} {
+		val := teststaking.NewValidator(t, valAddr, pubKeys[i])
+		app.StakingKeeper.SetValidator(ctx, val)
+		app.StakingKeeper.SetValidatorByPowerIndex(ctx, val)
+
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens)))
+		require.NoError(t, err)
+	}
+
+	tokenize := func(valAddr sdk.ValAddress, amount math.Int) types.TokenizeShareRecord {
+		_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    delAddr.String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(bondDenom, amount),
+			TokenizedShareOwner: delAddr.String(),
+		})
+		require.NoError(t, err)
+		record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, app.StakingKeeper.GetLastTokenizeShareRecordId(ctx))
+		require.NoError(t, err)
+		return record
+	}
+
+	record1 := tokenize(addrVal1, delTokens.QuoRaw(2))
+	record2 := tokenize(addrVal1, delTokens.QuoRaw(4))
+	record3 := tokenize(addrVal1, delTokens.QuoRaw(8))
+	record4 := tokenize(addrVal2, delTokens.QuoRaw(2))
+
+	val1Before, _ := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+	totalLiquidStakedBefore := app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)
+
+	// records of another validator cannot be merged
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgServer.MergeTokenizeShareRecords(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgMergeTokenizeShareRecords(delAddr, record1.Id, []uint64{record2.Id, record4.Id}))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordValidatorMismatch)
+
+	// records of another owner cannot be merged
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.MergeTokenizeShareRecords(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgMergeTokenizeShareRecords(otherAddr, record1.Id, []uint64{record2.Id}))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	// records whose rewards are split or compounded cannot be merged, since the share tokens minted
+	// for the surviving record would take a part of the rewards of its holders
+	for _, recordIds := range [][]uint64{{record1.Id, record2.Id}, {record2.Id, record1.Id}} {
+		cacheCtx, _ = ctx.CacheContext()
+		_, err = msgServer.EnableTokenizeShareRecordSplitRewards(sdk.WrapSDKContext(cacheCtx),
+			types.NewMsgEnableTokenizeShareRecordSplitRewards(delAddr, recordIds[0]))
+		require.NoError(t, err)
+		_, err = msgServer.MergeTokenizeShareRecords(sdk.WrapSDKContext(cacheCtx),
+			types.NewMsgMergeTokenizeShareRecords(delAddr, record1.Id, []uint64{record2.Id}))
+		require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotMergeable)
+
+		cacheCtx, _ = ctx.CacheContext()
+		_, err = msgServer.SetTokenizeShareRecordCompoundRewards(sdk.WrapSDKContext(cacheCtx),
+			types.NewMsgSetTokenizeShareRecordCompoundRewards(delAddr, recordIds[0], true))
+		require.NoError(t, err)
+		_, err = msgServer.MergeTokenizeShareRecords(sdk.WrapSDKContext(cacheCtx),
+			types.NewMsgMergeTokenizeShareRecords(delAddr, record1.Id, []uint64{record2.Id}))
+		require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotMergeable)
+	}
+
+	// all the share tokens of the merged records must be held by the owner
+	heldByOther := sdk.NewCoin(record3.GetShareTokenDenom(), sdk.NewInt(1))
+	err = app.BankKeeper.SendCoins(ctx, delAddr, otherAddr, sdk.Coins{heldByOther})
+	require.NoError(t, err)
+
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.MergeTokenizeShareRecords(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgMergeTokenizeShareRecords(delAddr, record1.Id, []uint64{record2.Id, record3.Id}))
+	require.ErrorIs(t, err, types.ErrNotAllShareTokensHeld)
+
+	err = app.BankKeeper.SendCoins(ctx, otherAddr, delAddr, sdk.Coins{heldByOther})
+	require.NoError(t, err)
+
+	// share tokens of the surviving record may be held by others
+	heldByOther = sdk.NewCoin(record1.GetShareTokenDenom(), delTokens.QuoRaw(4))
+	err = app.BankKeeper.SendCoins(ctx, delAddr, otherAddr, sdk.Coins{heldByOther})
+	require.NoError(t, err)
+
+	res, err := msgServer.MergeTokenizeShareRecords(sdk.WrapSDKContext(ctx),
+		types.NewMsgMergeTokenizeShareRecords(delAddr, record1.Id, []uint64{record2.Id, record3.Id}))
+	require.NoError(t, err)
+	mergedTokens := delTokens.QuoRaw(4).Add(delTokens.QuoRaw(8))
+	require.Equal(t, sdk.NewCoin(record1.GetShareTokenDenom(), mergedTokens), res.Amount)
+	require.Equal(t, delTokens.QuoRaw(4).Add(mergedTokens), app.BankKeeper.GetBalance(ctx, delAddr, record1.GetShareTokenDenom()).Amount)
+
+	// the merged records are deleted along with their share tokens and delegations
+	for _, record := range []types.TokenizeShareRecord{record2, record3} {
+		_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+		require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+		require.True(t, app.BankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+		_, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), addrVal1)
+		require.False(t, found)
+		require.False(t, app.BankKeeper.HasDenomMetaData(ctx, record.GetShareTokenDenom()))
+	}
+	require.True(t, app.BankKeeper.HasDenomMetaData(ctx, record1.GetShareTokenDenom()))
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, record1.GetModuleAddress(), addrVal1)
+	require.True(t, found)
+	val1, _ := app.StakingKeeper.GetLiquidValidator(ctx, addrVal1)
+	require.Equal(t, delTokens.QuoRaw(2).Add(mergedTokens), val1.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// the delegation moved between the records, so the validator and liquid staked totals are unchanged
+	require.Equal(t, val1Before.Tokens, val1.Tokens)
+	require.Equal(t, val1Before.TotalLiquidShares, val1.TotalLiquidShares)
+	require.Equal(t, totalLiquidStakedBefore, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the merged share tokens are redeemable from the surviving record
+	redeemRes, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: delAddr.String(),
+		Amount:           app.BankKeeper.GetBalance(ctx, delAddr, record1.GetShareTokenDenom()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, delTokens.QuoRaw(4).Add(mergedTokens), redeemRes.Amount.Amount)
+}
@@ -126,6 +126,32 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
 	}
 
+	// Since the total liquid staked is denominated in tokens, decrement it by
+	// the portion of the burned tokens that backs the validator's liquid shares
+	// The liquid and validator bond shares are tracked in shares, so the tokens
+	// they lost are only recorded in the event and the validator's liquid slash total
+	if validator.DelegatorShares.IsPositive() {
+		liquidPortion := validator.TotalLiquidShares.Quo(validator.DelegatorShares)
+		liquidTokensSlashed := liquidPortion.MulInt(tokensToBurn)
+		validatorBondPortion := validator.TotalValidatorBondShares.Quo(validator.DelegatorShares)
+		validatorBondTokensSlashed := validatorBondPortion.MulInt(tokensToBurn)
+
+		k.DecreaseTotalLiquidStakedTokens(ctx, liquidTokensSlashed)
+		if liquidTokensSlashed.IsPositive() {
+			k.IncreaseValidatorLiquidTokensSlashed(ctx, operatorAddress, liquidTokensSlashed)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashLiquidTokens,
+				sdk.NewAttribute(types.AttributeKeyValidator, operatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyBurnedTokens, tokensToBurn.String()),
+				sdk.NewAttribute(types.AttributeKeyLiquidTokens, liquidTokensSlashed.String()),
+				sdk.NewAttribute(types.AttributeKeyBondTokens, validatorBondTokensSlashed.String()),
+			),
+		)
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -284,6 +310,21 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			panic("destination validator not found")
 		}
 
+		// the unbonded shares no longer count towards the validator bond or liquid shares of
+		// the destination validator, and the burned liquid tokens no longer count as liquid staked
+		_, err = k.GetTokenizeShareRecordByModuleAccount(ctx, delegatorAddress)
+		isLiquid := err == nil || k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+		if delegation.ValidatorBond {
+			dstValidator.TotalValidatorBondShares = dstValidator.TotalValidatorBondShares.Sub(sharesToUnbond)
+		}
+		if isLiquid {
+			dstValidator.TotalLiquidShares = dstValidator.TotalLiquidShares.Sub(sharesToUnbond)
+			k.DecreaseTotalLiquidStakedTokens(ctx, sdk.NewDecFromInt(tokensToBurn))
+		}
+		if delegation.ValidatorBond || isLiquid {
+			k.SetValidator(ctx, dstValidator)
+		}
+
 		// tokens of a redelegation currently live in the destination validator
 		// therefor we must burn tokens from the destination-validator's bonding status
 		switch {
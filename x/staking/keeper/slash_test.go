@@ -10,6 +10,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
@@ -185,6 +186,51 @@ func TestSlashRedelegation(t *testing.T) {
 	require.Equal(t, balances.Sub(burnedCoins...), app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress()))
 }
 
+// tests that slashing a redelegation keeps the validator bond and liquid shares of the
+// destination validator in sync with its delegations
+func TestSlashRedelegationLiquidShares(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	fraction := sdk.NewDecWithPrec(5, 1)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delTokens := sdk.NewInt(1000)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	// a validator bond delegation on the destination validator, which also makes room for liquid shares
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrDels[0], addrVals[1], sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(addrDels[0], addrVals[1]))
+	require.NoError(t, err)
+
+	// redelegations into the validator bond delegation and into a liquid staking provider delegation
+	providerAddress := sdk.AccAddress(address.Module("interchain-account", []byte("provider")))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, providerAddress, sdk.NewCoins(sdk.NewCoin(bondDenom, delTokens))))
+	for _, delAddr := range []sdk.AccAddress{addrDels[0], providerAddress} {
+		_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, addrVals[0], sdk.NewCoin(bondDenom, delTokens)))
+		require.NoError(t, err)
+		_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegate(delAddr, addrVals[0], addrVals[1], sdk.NewCoin(bondDenom, delTokens)))
+		require.NoError(t, err)
+	}
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+	totalLiquidStaked := app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)
+
+	// slash both redelegations
+	srcValidator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	for _, delAddr := range []sdk.AccAddress{addrDels[0], providerAddress} {
+		rd, found := app.StakingKeeper.GetRedelegation(ctx, delAddr, addrVals[0], addrVals[1])
+		require.True(t, found)
+		slashAmount := app.StakingKeeper.SlashRedelegation(ctx, srcValidator, rd, 0, fraction)
+		require.Equal(t, delTokens.QuoRaw(2), slashAmount)
+	}
+	requireLiquidInvariants(t, ctx, app.StakingKeeper)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.QuoRaw(2).Add(delTokens)), validator.TotalValidatorBondShares)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.QuoRaw(2)), validator.TotalLiquidShares)
+	require.Equal(t, totalLiquidStaked.Sub(sdk.NewDecFromInt(delTokens.QuoRaw(2))), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
 // tests Slash at a future height (must panic)
 func TestSlashAtFutureHeight(t *testing.T) {
 	app, ctx, _, _ := bootstrapSlashTest(t, 10)
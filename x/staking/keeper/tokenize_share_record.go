@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -55,6 +56,25 @@ func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAdd
 	return
 }
 
+func (k Keeper) GetTokenizeShareRecordsByValidator(ctx sdk.Context, valAddr sdk.ValAddress) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	var it sdk.Iterator = sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIdsByValidatorPrefix(valAddr))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var id gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(it.Value(), &id)
+
+		tokenizeShareRecord, err := k.GetTokenizeShareRecord(ctx, id.Value)
+		if err != nil {
+			continue
+		}
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+	return
+}
+
 func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetTokenizeShareRecordIdByDenomKey(denom))
@@ -68,6 +88,19 @@ func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (ty
 	return k.GetTokenizeShareRecord(ctx, id.Value)
 }
 
+func (k Keeper) GetTokenizeShareRecordByModuleAccount(ctx sdk.Context, moduleAccount sdk.AccAddress) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordIdByModuleAccountKey(moduleAccount))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, fmt.Errorf("tokenize share record not found from module account: %s", moduleAccount)
+	}
+
+	var id gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &id)
+
+	return k.GetTokenizeShareRecord(ctx, id.Value)
+}
+
 func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (tokenizeShareRecords []types.TokenizeShareRecord) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -88,15 +121,21 @@ func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord type
 		return errorsmod.Wrapf(types.ErrTokenizeShareRecordAlreadyExists, "TokenizeShareRecord already exists: %d", tokenizeShareRecord.Id)
 	}
 
-	k.setTokenizeShareRecord(ctx, tokenizeShareRecord)
-
 	owner, err := sdk.AccAddressFromBech32(tokenizeShareRecord.Owner)
 	if err != nil {
 		return err
 	}
 
+	valAddr, err := sdk.ValAddressFromBech32(tokenizeShareRecord.Validator)
+	if err != nil {
+		return err
+	}
+
+	k.setTokenizeShareRecord(ctx, tokenizeShareRecord)
 	k.setTokenizeShareRecordWithOwner(ctx, owner, tokenizeShareRecord.Id)
+	k.setTokenizeShareRecordWithValidator(ctx, valAddr, tokenizeShareRecord.Id)
 	k.setTokenizeShareRecordWithDenom(ctx, tokenizeShareRecord.GetShareTokenDenom(), tokenizeShareRecord.Id)
+	k.setTokenizeShareRecordWithModuleAccount(ctx, tokenizeShareRecord.GetModuleAddress(), tokenizeShareRecord.Id)
 
 	return nil
 }
@@ -110,11 +149,38 @@ func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordId uint64) erro
 	if err != nil {
 		return err
 	}
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetTokenizeShareRecordByIndexKey(recordId))
 	store.Delete(types.GetTokenizeShareRecordIdByOwnerAndIdKey(owner, recordId))
+	store.Delete(types.GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr, recordId))
 	store.Delete(types.GetTokenizeShareRecordIdByDenomKey(record.GetShareTokenDenom()))
+	store.Delete(types.GetTokenizeShareRecordIdByModuleAccountKey(record.GetModuleAddress()))
+
+	k.bankKeeper.DeleteDenomMetaData(ctx, record.GetShareTokenDenom())
+	return nil
+}
+
+// SetTokenizeShareRecordDenomMetadata registers the bank denom metadata of the share tokens of a
+// tokenize share record, so that they are displayed in the units of the bond denom
+func (k Keeper) SetTokenizeShareRecordDenomMetadata(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return sdkstaking.ErrNoValidatorFound
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	bondMetadata, _ := k.bankKeeper.GetDenomMetaData(ctx, bondDenom)
+	k.bankKeeper.SetDenomMetaData(ctx, record.GetShareTokenMetadata(validator.Description.Moniker, bondDenom, bondMetadata))
+
 	return nil
 }
 
@@ -142,9 +208,23 @@ func (k Keeper) deleteTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.Ac
 	store.Delete(types.GetTokenizeShareRecordIdByOwnerAndIdKey(owner, id))
 }
 
+func (k Keeper) setTokenizeShareRecordWithValidator(ctx sdk.Context, valAddr sdk.ValAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+
+	store.Set(types.GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr, id), bz)
+}
+
 func (k Keeper) setTokenizeShareRecordWithDenom(ctx sdk.Context, denom string, id uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
 
 	store.Set(types.GetTokenizeShareRecordIdByDenomKey(denom), bz)
 }
+
+func (k Keeper) setTokenizeShareRecordWithModuleAccount(ctx sdk.Context, moduleAccount sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+
+	store.Set(types.GetTokenizeShareRecordIdByModuleAccountKey(moduleAccount), bz)
+}
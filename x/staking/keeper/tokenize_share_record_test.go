@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -16,28 +18,29 @@ func (suite *KeeperTestSuite) TestGetLastTokenizeShareRecordId() {
 func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 	app, ctx := suite.app, suite.ctx
 	owner1, owner2 := suite.addrs[0], suite.addrs[1]
+	val1, val2 := sdk.ValAddress(owner1), sdk.ValAddress(owner2)
 
 	tokenizeShareRecord1 := types.TokenizeShareRecord{
 		Id:            0,
 		Owner:         owner1.String(),
 		ModuleAccount: "test-module-account-1",
-		Validator:     "test-validator",
+		Validator:     val1.String(),
 	}
 	tokenizeShareRecord2 := types.TokenizeShareRecord{
 		Id:            1,
 		Owner:         owner2.String(),
 		ModuleAccount: "test-module-account-2",
-		Validator:     "test-validator",
+		Validator:     val1.String(),
 	}
 	tokenizeShareRecord3 := types.TokenizeShareRecord{
 		Id:            2,
 		Owner:         owner1.String(),
 		ModuleAccount: "test-module-account-3",
-		Validator:     "test-validator",
+		Validator:     val2.String(),
 	}
-	app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord1)
-	app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord2)
-	app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord3)
+	suite.NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord1))
+	suite.NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord2))
+	suite.NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord3))
 
 	tokenizeShareRecord, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 2)
 	suite.NoError(err)
@@ -47,6 +50,10 @@ func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 	suite.NoError(err)
 	suite.Equal(tokenizeShareRecord, tokenizeShareRecord2)
 
+	tokenizeShareRecord, err = app.StakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, tokenizeShareRecord1.GetModuleAddress())
+	suite.NoError(err)
+	suite.Equal(tokenizeShareRecord, tokenizeShareRecord1)
+
 	tokenizeShareRecords := app.StakingKeeper.GetAllTokenizeShareRecords(ctx)
 	suite.Equal(len(tokenizeShareRecords), 3)
 
@@ -55,4 +62,46 @@ func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 
 	tokenizeShareRecords = app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner2)
 	suite.Equal(len(tokenizeShareRecords), 1)
+
+	tokenizeShareRecords = app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, val1)
+	suite.Equal([]types.TokenizeShareRecord{tokenizeShareRecord1, tokenizeShareRecord2}, tokenizeShareRecords)
+
+	tokenizeShareRecords = app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, val2)
+	suite.Equal([]types.TokenizeShareRecord{tokenizeShareRecord3}, tokenizeShareRecords)
+}
+
+func (suite *KeeperTestSuite) TestAddTokenizeShareRecordInvalidValidator() {
+	app, ctx := suite.app, suite.ctx
+
+	err := app.StakingKeeper.AddTokenizeShareRecord(ctx, types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         suite.addrs[0].String(),
+		ModuleAccount: "test-module-account-1",
+		Validator:     "test-validator",
+	})
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestDeleteTokenizeShareRecord() {
+	app, ctx := suite.app, suite.ctx
+	owner := suite.addrs[0]
+	valAddr := sdk.ValAddress(owner)
+
+	tokenizeShareRecord := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner.String(),
+		ModuleAccount: "test-module-account-1",
+		Validator:     valAddr.String(),
+	}
+	suite.NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord))
+	suite.NoError(app.StakingKeeper.DeleteTokenizeShareRecord(ctx, tokenizeShareRecord.Id))
+
+	_, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, tokenizeShareRecord.Id)
+	suite.Error(err)
+	_, err = app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, tokenizeShareRecord.GetShareTokenDenom())
+	suite.Error(err)
+	_, err = app.StakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, tokenizeShareRecord.GetModuleAddress())
+	suite.Error(err)
+	suite.Len(app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner), 0)
+	suite.Len(app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddr), 0)
 }
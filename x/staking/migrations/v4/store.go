@@ -0,0 +1,173 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// KeyExemptionFactor is the ADR-001 param key that was renamed to ValidatorBondFactor
+var KeyExemptionFactor = []byte("ExemptionFactor")
+
+// MigrateStore performs in-place store migrations from consensus version 3 to 4,
+// which renames exempt delegations to validator bonds (ADR-002).
+// The migration includes:
+//
+// - Copying the ExemptionFactor param to the ValidatorBondFactor param
+// - Setting the GlobalLiquidStakingCap param to its default value if it is not set
+// - Indexing the existing tokenize share records by module account and by validator
+// - Initializing the total liquid staked tokens from the existing tokenize share records
+// - Adding the delegations of liquid staking providers to the liquid shares of their
+// validators and to the total liquid staked tokens
+// - Indexing the validator bond delegations by validator
+//
+// The Delegation.ValidatorBond, Validator.TotalValidatorBondShares and
+// Validator.TotalLiquidShares fields keep the field numbers of the fields they
+// replace, so no validator or delegation needs to be rewritten
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace, ak types.AccountKeeper) error {
+	if err := migrateParamsStore(ctx, paramstore); err != nil {
+		return err
+	}
+
+	totalLiquidStakedTokens := migrateTokenizeShareRecords(ctx, storeKey, cdc)
+	totalLiquidStakedTokens = totalLiquidStakedTokens.Add(migrateLiquidStakingProviderDelegations(ctx, storeKey, cdc, ak))
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.TotalLiquidStakedTokensKey, cdc.MustMarshal(&sdk.DecProto{Dec: totalLiquidStakedTokens}))
+
+	migrateValidatorBondDelegations(ctx, storeKey, cdc)
+
+	return nil
+}
+
+// migrateParamsStore copies the exemption factor to the validator bond factor key and
+// sets the global liquid staking cap, which the param set cannot be read without
+// The paramstore is expected to already have the current KeyTable registered
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	validatorBondFactor := types.DefaultValidatorBondFactor
+	if bz := paramstore.GetRaw(ctx, KeyExemptionFactor); bz != nil {
+		if err := validatorBondFactor.UnmarshalJSON(bz); err != nil {
+			return err
+		}
+	}
+
+	paramstore.Set(ctx, types.KeyValidatorBondFactor, validatorBondFactor)
+
+	if !paramstore.Has(ctx, types.KeyGlobalLiquidStakingCap) {
+		paramstore.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	}
+	return nil
+}
+
+// migrateTokenizeShareRecords adds the module account and validator indexes for each
+// tokenize share record and returns the tokens held by the record module accounts
+func migrateTokenizeShareRecords(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) sdk.Dec {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	totalLiquidStakedTokens := sdk.ZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		cdc.MustUnmarshal(iterator.Value(), &record)
+
+		moduleAddress := record.GetModuleAddress()
+		store.Set(
+			types.GetTokenizeShareRecordIdByModuleAccountKey(moduleAddress),
+			cdc.MustMarshal(&gogotypes.UInt64Value{Value: record.Id}),
+		)
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			continue
+		}
+
+		store.Set(
+			types.GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr, record.Id),
+			cdc.MustMarshal(&gogotypes.UInt64Value{Value: record.Id}),
+		)
+
+		delegationBz := store.Get(types.GetLiquidDelegationKey(moduleAddress, valAddr))
+		validatorBz := store.Get(types.GetValidatorKey(valAddr))
+		if delegationBz == nil || validatorBz == nil {
+			continue
+		}
+
+		delegation := types.MustUnmarshalDelegation(cdc, delegationBz)
+		validator := types.MustUnmarshalValidator(cdc, validatorBz)
+		totalLiquidStakedTokens = totalLiquidStakedTokens.Add(validator.TokensFromShares(delegation.Shares))
+	}
+
+	return totalLiquidStakedTokens
+}
+
+// migrateLiquidStakingProviderDelegations adds the shares delegated by liquid staking providers
+// to the liquid shares of their validators, which only included the tokenized shares so far,
+// and returns the tokens of these delegations. The tokenize share records must be indexed by
+// module account first, since the record module accounts are not providers
+func migrateLiquidStakingProviderDelegations(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, ak types.AccountKeeper) sdk.Dec {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	defer iterator.Close()
+
+	totalLiquidStakedTokens := sdk.ZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		delegation := types.MustUnmarshalDelegation(cdc, iterator.Value())
+		if !isLiquidStakingProvider(ctx, store, ak, delegation.GetDelegatorAddr()) {
+			continue
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validatorBz := store.Get(types.GetValidatorKey(valAddr))
+		if validatorBz == nil {
+			continue
+		}
+
+		validator := types.MustUnmarshalValidator(cdc, validatorBz)
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(delegation.Shares)
+		store.Set(types.GetValidatorKey(valAddr), types.MustMarshalValidator(cdc, &validator))
+
+		totalLiquidStakedTokens = totalLiquidStakedTokens.Add(validator.TokensFromShares(delegation.Shares))
+	}
+
+	return totalLiquidStakedTokens
+}
+
+// isLiquidStakingProvider mirrors Keeper.AccountIsLiquidStakingProvider on the migrated store
+func isLiquidStakingProvider(ctx sdk.Context, store sdk.KVStore, ak types.AccountKeeper, address sdk.AccAddress) bool {
+	if store.Has(types.GetTokenizeShareRecordIdByModuleAccountKey(address)) {
+		return false
+	}
+
+	if len(address) == 32 {
+		return true
+	}
+
+	_, isModuleAccount := ak.GetAccount(ctx, address).(authtypes.ModuleAccountI)
+	return isModuleAccount
+}
+
+// migrateValidatorBondDelegations indexes the validator bond delegations, formerly exempt
+// delegations, by validator
+func migrateValidatorBondDelegations(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegation := types.MustUnmarshalDelegation(cdc, iterator.Value())
+		if !delegation.ValidatorBond {
+			continue
+		}
+
+		store.Set(types.GetValidatorBondDelegationByValidatorKey(delegation.GetValidatorAddr(), delegation.GetDelegatorAddr()), []byte{})
+	}
+}
@@ -0,0 +1,99 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	v4 "github.com/iqlusioninc/liquidity-staking-module/x/staking/migrations/v4"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	cdc := app.AppCodec()
+	stakingKey := app.GetKey(types.StoreKey)
+
+	// store the exemption factor under its ADR-001 key
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	paramsStore.Set(append([]byte(types.ModuleName+"/"), v4.KeyExemptionFactor...), []byte(`"5.000000000000000000"`))
+	paramsStore.Delete(append([]byte(types.ModuleName+"/"), types.KeyGlobalLiquidStakingCap...))
+
+	// store a tokenize share record without the module account and validator indexes
+	_, _, valAcc := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(valAcc)
+	validator := teststaking.NewValidator(t, valAddr, simapp.CreateTestPubKeys(1)[0])
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(1000))
+	validator.TotalLiquidShares = sdk.NewDec(400)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         valAcc.String(),
+		ModuleAccount: "tokenizeshare_1",
+		Validator:     valAddr.String(),
+	}
+	ctx.KVStore(stakingKey).Set(types.GetTokenizeShareRecordByIndexKey(record.Id), cdc.MustMarshal(&record))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(record.GetModuleAddress(), valAddr, sdk.NewDec(400), false))
+
+	// store a delegation from a liquid staking provider, whose shares were not liquid before
+	// the migration, and a delegation from a regular account
+	providerAddress := sdk.AccAddress(address.Module("interchain-account", []byte("provider")))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(providerAddress, valAddr, sdk.NewDec(300), false))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(valAcc, valAddr, sdk.NewDec(200), false))
+
+	// store an exempt delegation without the validator bond index
+	_, _, exemptAcc := testdata.KeyTestPubAddr()
+	exemptDelegation := types.NewDelegation(exemptAcc, valAddr, sdk.NewDec(100), true)
+	ctx.KVStore(stakingKey).Set(types.GetLiquidDelegationKey(exemptAcc, valAddr), types.MustMarshalDelegation(cdc, exemptDelegation))
+	require.Empty(t, app.StakingKeeper.GetValidatorBondDelegators(ctx, valAddr))
+
+	require.NoError(t, v4.MigrateStore(ctx, stakingKey, cdc, app.GetSubspace(types.ModuleName), app.AccountKeeper))
+
+	var validatorBondFactor sdk.Dec
+	app.GetSubspace(types.ModuleName).Get(ctx, types.KeyValidatorBondFactor, &validatorBondFactor)
+	require.Equal(t, sdk.NewDec(5), validatorBondFactor)
+
+	// the global liquid staking cap was not set before the migration
+	var globalLiquidStakingCap sdk.Dec
+	app.GetSubspace(types.ModuleName).Get(ctx, types.KeyGlobalLiquidStakingCap, &globalLiquidStakingCap)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, globalLiquidStakingCap)
+
+	migratedRecord, err := app.StakingKeeper.GetTokenizeShareRecordByModuleAccount(ctx, record.GetModuleAddress())
+	require.NoError(t, err)
+	require.Equal(t, record.Id, migratedRecord.Id)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddr))
+
+	require.Equal(t, sdk.NewDec(700), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	migratedValidator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(700), migratedValidator.TotalLiquidShares)
+	require.Equal(t, []sdk.AccAddress{exemptAcc}, app.StakingKeeper.GetValidatorBondDelegators(ctx, valAddr))
+}
+
+func TestMigrateStoreWithoutExemptionFactor(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// a global liquid staking cap that is already set is kept
+	app.GetSubspace(types.ModuleName).Set(ctx, types.KeyGlobalLiquidStakingCap, sdk.NewDecWithPrec(25, 2))
+
+	require.NoError(t, v4.MigrateStore(ctx, app.GetKey(types.StoreKey), app.AppCodec(), app.GetSubspace(types.ModuleName), app.AccountKeeper))
+
+	var validatorBondFactor sdk.Dec
+	app.GetSubspace(types.ModuleName).Get(ctx, types.KeyValidatorBondFactor, &validatorBondFactor)
+	require.Equal(t, types.DefaultValidatorBondFactor, validatorBondFactor)
+
+	var globalLiquidStakingCap sdk.Dec
+	app.GetSubspace(types.ModuleName).Get(ctx, types.KeyGlobalLiquidStakingCap, &globalLiquidStakingCap)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), globalLiquidStakingCap)
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
@@ -0,0 +1,31 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 4 to 5,
+// which moves the module parameters from the x/params subspace to the x/staking store.
+// The paramstore is expected to already have the current KeyTable registered.
+// Params that were never stored in the subspace keep their default value
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	params := types.DefaultParams()
+	paramstore.GetParamSetIfExists(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+	return nil
+}
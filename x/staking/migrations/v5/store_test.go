@@ -0,0 +1,53 @@
+package v5_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	v5 "github.com/iqlusioninc/liquidity-staking-module/x/staking/migrations/v5"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	stakingKey := app.GetKey(types.StoreKey)
+
+	// set the params in the legacy subspace only
+	params := types.DefaultParams()
+	params.MaxValidators = 42
+	params.ValidatorBondFactor = sdk.NewDec(5)
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.SetParamSet(ctx, &params)
+	ctx.KVStore(stakingKey).Delete(types.ParamsKey)
+
+	require.NoError(t, v5.MigrateStore(ctx, stakingKey, app.AppCodec(), subspace))
+
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+}
+
+func TestMigrateStoreWithoutMinValidatorSelfBond(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	stakingKey := app.GetKey(types.StoreKey)
+	paramsKey := app.GetKey(paramstypes.StoreKey)
+
+	// set the params in the legacy subspace, as they were before the min validator self bond was added
+	params := types.DefaultParams()
+	params.MaxValidators = 42
+	params.MinValidatorSelfBond = sdk.NewInt(100)
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.SetParamSet(ctx, &params)
+	ctx.KVStore(paramsKey).Delete(append([]byte(types.ModuleName+"/"), types.KeyMinValidatorSelfBond...))
+	ctx.KVStore(stakingKey).Delete(types.ParamsKey)
+
+	require.NoError(t, v5.MigrateStore(ctx, stakingKey, app.AppCodec(), subspace))
+
+	params.MinValidatorSelfBond = types.DefaultMinValidatorSelfBond
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+}
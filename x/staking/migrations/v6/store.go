@@ -0,0 +1,51 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 5 to 6,
+// which registers the bank denom metadata of the share tokens of the existing
+// tokenize share records
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, bankKeeper types.BankKeeper) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+	bondMetadata, _ := bankKeeper.GetDenomMetaData(ctx, params.BondDenom)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		if err := cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return err
+		}
+
+		// the moniker is left empty if the validator has been removed
+		moniker := ""
+		if bz := store.Get(types.GetValidatorKey(valAddr)); bz != nil {
+			validator, err := types.UnmarshalValidator(cdc, bz)
+			if err != nil {
+				return err
+			}
+			moniker = validator.Description.Moniker
+		}
+
+		bankKeeper.SetDenomMetaData(ctx, record.GetShareTokenMetadata(moniker, params.BondDenom, bondMetadata))
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package v6_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	v6 "github.com/iqlusioninc/liquidity-staking-module/x/staking/migrations/v6"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	stakingKey := app.GetKey(types.StoreKey)
+	cdc := app.AppCodec()
+	bankKeeper := simapp.NewStakingBankKeeper(app.BankKeeper, app.GetKey(banktypes.StoreKey))
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: bondDenom, Exponent: 0}, {Denom: "mystake", Exponent: 6}},
+		Base:       bondDenom,
+		Display:    "mystake",
+		Name:       "Stake",
+		Symbol:     "STK",
+	})
+
+	pk := simapp.CreateTestPubKeys(1)[0]
+	valAddr := sdk.ValAddress(pk.Address())
+	validator, err := types.NewValidator(valAddr, pk, types.Description{Moniker: "myvalidator"})
+	require.NoError(t, err)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// records created before the share tokens had metadata, one of them of a removed validator
+	removedValAddr := sdk.ValAddress(simapp.CreateTestPubKeys(2)[1].Address())
+	records := []types.TokenizeShareRecord{
+		{Id: 1, Owner: sdk.AccAddress(valAddr).String(), ModuleAccount: "tokenizeshare_1", Validator: valAddr.String()},
+		{Id: 2, Owner: sdk.AccAddress(valAddr).String(), ModuleAccount: "tokenizeshare_2", Validator: removedValAddr.String()},
+	}
+	for _, record := range records {
+		require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+		require.False(t, app.BankKeeper.HasDenomMetaData(ctx, record.GetShareTokenDenom()))
+	}
+
+	require.NoError(t, v6.MigrateStore(ctx, stakingKey, cdc, bankKeeper))
+
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, records[0].GetShareTokenDenom())
+	require.True(t, found)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, records[0].GetShareTokenDenom(), metadata.Base)
+	require.Equal(t, records[0].GetShareTokenDenom()+"/mystake", metadata.Display)
+	require.Equal(t, uint32(6), metadata.DenomUnits[1].Exponent)
+	require.Equal(t, "STK-1", metadata.Symbol)
+	require.Contains(t, metadata.Description, "myvalidator")
+
+	metadata, found = app.BankKeeper.GetDenomMetaData(ctx, records[1].GetShareTokenDenom())
+	require.True(t, found)
+	require.NoError(t, metadata.Validate())
+}
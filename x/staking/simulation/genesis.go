@@ -40,11 +40,11 @@ func getHistEntries(r *rand.Rand) uint32 {
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
 	var (
-		unbondTime        time.Duration
-		maxVals           uint32
-		histEntries       uint32
-		minCommissionRate sdk.Dec
-		exemptionFactor   sdk.Dec
+		unbondTime          time.Duration
+		maxVals             uint32
+		histEntries         uint32
+		minCommissionRate   sdk.Dec
+		validatorBondFactor sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -65,7 +65,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, exemptionFactor)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, validatorBondFactor, types.DefaultGlobalLiquidStakingCap, types.DefaultMinValidatorSelfBond)
 
 	// validators & delegations
 	var (
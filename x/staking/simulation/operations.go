@@ -19,6 +19,7 @@ const (
 	DefaultWeightMsgTokenizeShares              int = 100
 	DefaultWeightMsgRedeemTokensforShares       int = 100
 	DefaultWeightMsgTransferTokenizeShareRecord int = 50
+	DefaultWeightMsgRevokeValidatorBond         int = 50
 )
 
 // Simulation operation weights constants
@@ -32,6 +33,7 @@ const (
 	OpWeightMsgTokenizeShares              = "op_weight_msg_tokenize_shares"
 	OpWeightMsgRedeemTokensforShares       = "op_weight_msg_redeem_tokens_for_shares"
 	OpWeightMsgTransferTokenizeShareRecord = "op_weight_msg_transfer_tokenize_share_record"
+	OpWeightMsgRevokeValidatorBond         = "op_weight_msg_revoke_validator_bond"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -49,6 +51,7 @@ func WeightedOperations(
 		weightMsgTokenizeShares              int
 		weightMsgRedeemTokensforShares       int
 		weightMsgTransferTokenizeShareRecord int
+		weightMsgRevokeValidatorBond         int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -105,6 +108,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeValidatorBond, &weightMsgRevokeValidatorBond, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeValidatorBond = DefaultWeightMsgRevokeValidatorBond
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -142,6 +151,10 @@ func WeightedOperations(
 			weightMsgTransferTokenizeShareRecord,
 			SimulateMsgTransferTokenizeShareRecord(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeValidatorBond,
+			SimulateMsgRevokeValidatorBond(ak, bk, k),
+		),
 	}
 }
 
@@ -805,3 +818,73 @@ func SimulateMsgTransferTokenizeShareRecord(ak types.AccountKeeper, bk types.Ban
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// SimulateMsgRevokeValidatorBond generates a MsgRevokeValidatorBond for a random validator bond delegation
+func SimulateMsgRevokeValidatorBond(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeValidatorBond, "unable to pick validator"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		var validatorBonds []types.Delegation
+		for _, delegation := range k.GetValidatorDelegations(ctx, valAddr) {
+			if delegation.ValidatorBond {
+				validatorBonds = append(validatorBonds, delegation)
+			}
+		}
+		if len(validatorBonds) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeValidatorBond, "validator has no validator bond delegations"), nil, nil
+		}
+
+		delegation := validatorBonds[r.Intn(len(validatorBonds))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		// skip if the remaining validator bond shares would not cover the liquid shares
+		validatorBondFactor := k.GetValidatorBondFactor(ctx, validator.GetOperator())
+		if !validatorBondFactor.IsNegative() &&
+			validator.TotalValidatorBondShares.Sub(delegation.Shares).Mul(validatorBondFactor).LT(validator.TotalLiquidShares) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeValidatorBond, "insufficient validator bond shares"), nil, nil
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeValidatorBond, "account private key is nil"), nil, nil
+		}
+
+		msg := types.NewMsgRevokeValidatorBond(delAddr, valAddr)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
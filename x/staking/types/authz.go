@@ -74,6 +74,8 @@ func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRe
 	case *MsgBeginRedelegate:
 		validatorAddress = msg.ValidatorDstAddress
 		amount = msg.Amount
+	case *MsgRevokeValidatorBond:
+		validatorAddress = msg.ValidatorAddress
 	default:
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
 	}
@@ -100,6 +102,11 @@ func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRe
 		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validatorAddress)
 	}
 
+	// revoking a validator bond does not move any tokens, so it is not limited by MaxTokens
+	if _, ok := msg.(*MsgRevokeValidatorBond); ok {
+		return authz.AcceptResponse{Accept: true, Delete: false}, nil
+	}
+
 	if a.MaxTokens == nil {
 		return authz.AcceptResponse{
 			Accept: true, Delete: false,
@@ -150,6 +157,8 @@ func normalizeAuthzType(authzType AuthorizationType) (string, error) {
 		return sdk.MsgTypeURL(&MsgUndelegate{}), nil
 	case AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
 		return sdk.MsgTypeURL(&MsgBeginRedelegate{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND:
+		return sdk.MsgTypeURL(&MsgRevokeValidatorBond{}), nil
 	default:
 		return "", sdkerrors.ErrInvalidType.Wrapf("unknown authorization type %T", authzType)
 	}
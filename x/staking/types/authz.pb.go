@@ -37,6 +37,8 @@ const (
 	AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE AuthorizationType = 2
 	// AUTHORIZATION_TYPE_REDELEGATE defines an authorization type for Msg/BeginRedelegate
 	AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE AuthorizationType = 3
+	// AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND defines an authorization type for Msg/RevokeValidatorBond
+	AuthorizationType_AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND AuthorizationType = 4
 )
 
 var AuthorizationType_name = map[int32]string{
@@ -44,13 +46,15 @@ var AuthorizationType_name = map[int32]string{
 	1: "AUTHORIZATION_TYPE_DELEGATE",
 	2: "AUTHORIZATION_TYPE_UNDELEGATE",
 	3: "AUTHORIZATION_TYPE_REDELEGATE",
+	4: "AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND",
 }
 
 var AuthorizationType_value = map[string]int32{
-	"AUTHORIZATION_TYPE_UNSPECIFIED": 0,
-	"AUTHORIZATION_TYPE_DELEGATE":    1,
-	"AUTHORIZATION_TYPE_UNDELEGATE":  2,
-	"AUTHORIZATION_TYPE_REDELEGATE":  3,
+	"AUTHORIZATION_TYPE_UNSPECIFIED":           0,
+	"AUTHORIZATION_TYPE_DELEGATE":              1,
+	"AUTHORIZATION_TYPE_UNDELEGATE":            2,
+	"AUTHORIZATION_TYPE_REDELEGATE":            3,
+	"AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND": 4,
 }
 
 func (x AuthorizationType) String() string {
@@ -222,39 +226,40 @@ func init() {
 func init() { proto.RegisterFile("staking/v1beta1/authz.proto", fileDescriptor_dbc817c76ffc2c21) }
 
 var fileDescriptor_dbc817c76ffc2c21 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0xed, 0x06, 0x01, 0x39, 0x5c, 0xd4, 0x8c, 0xba, 0x48, 0x53, 0xd5, 0x2d, 0xdd, 0x10,
-	0x81, 0x6c, 0xd3, 0xb0, 0x43, 0x48, 0xe0, 0xb4, 0x86, 0x46, 0xaa, 0xda, 0xca, 0x71, 0x91, 0x5a,
-	0x84, 0xac, 0x49, 0x3c, 0x72, 0x46, 0xb1, 0x67, 0x52, 0xcf, 0xb8, 0x24, 0x7d, 0x0a, 0x9e, 0x80,
-	0x07, 0x60, 0xdd, 0x87, 0x40, 0xac, 0x2a, 0x56, 0xec, 0x40, 0xc9, 0x63, 0xb0, 0x41, 0xbe, 0x24,
-	0xb4, 0xb4, 0xc0, 0xa6, 0xab, 0xf1, 0xf8, 0x7c, 0xfe, 0xfe, 0xe3, 0xb9, 0xc0, 0x92, 0x90, 0xb8,
-	0x4f, 0x59, 0x60, 0x1e, 0xaf, 0x77, 0x88, 0xc4, 0xeb, 0x26, 0x4e, 0x64, 0xef, 0xc4, 0x18, 0xc4,
-	0x5c, 0x72, 0xb4, 0x1c, 0xd2, 0xa3, 0x84, 0xfa, 0x05, 0x62, 0x4c, 0xc7, 0x02, 0xad, 0x2d, 0x04,
-	0x3c, 0xe0, 0x19, 0x69, 0xa6, 0x4f, 0xf9, 0x47, 0xb5, 0xc5, 0x2e, 0x17, 0x11, 0x17, 0x5e, 0x5e,
-	0xc8, 0x27, 0x45, 0x49, 0xcb, 0x67, 0x66, 0x07, 0x0b, 0x32, 0x0b, 0xec, 0x72, 0xca, 0xf2, 0xfa,
-	0xda, 0xcf, 0x12, 0xa0, 0xb6, 0xc4, 0x7d, 0x62, 0x25, 0xb2, 0xc7, 0x63, 0x7a, 0x82, 0x25, 0xe5,
-	0x0c, 0x11, 0x80, 0x08, 0x0f, 0x3d, 0xc9, 0xfb, 0x84, 0x89, 0xaa, 0xba, 0xaa, 0xd6, 0xef, 0x34,
-	0x16, 0x8d, 0xc2, 0x9c, 0xba, 0xa6, 0x1d, 0x19, 0x1b, 0x9c, 0xb2, 0xe6, 0xe3, 0x4f, 0xdf, 0x57,
-	0x1e, 0x06, 0x54, 0xf6, 0x92, 0x8e, 0xd1, 0xe5, 0x51, 0xd1, 0x42, 0x31, 0xe8, 0xc2, 0xef, 0x9b,
-	0x72, 0x34, 0x20, 0x22, 0x83, 0x9d, 0x72, 0x84, 0x87, 0x6e, 0x26, 0x46, 0xef, 0x00, 0x70, 0x18,
-	0xf2, 0xf7, 0x5e, 0x48, 0x85, 0xac, 0xce, 0x65, 0x31, 0xcf, 0x8d, 0x7f, 0x2e, 0x81, 0x71, 0xb9,
-	0x5b, 0xe3, 0x0d, 0x0e, 0xa9, 0x8f, 0x25, 0x8f, 0xc5, 0x96, 0xe2, 0x94, 0x33, 0xe3, 0x36, 0x15,
-	0x12, 0xbd, 0x85, 0xb2, 0x4f, 0xd8, 0x28, 0xb7, 0x97, 0xae, 0xc5, 0x7e, 0x3b, 0x15, 0x66, 0x72,
-	0x0f, 0x10, 0x3e, 0xcf, 0x79, 0xe9, 0x2f, 0x56, 0x6f, 0xac, 0xaa, 0xf5, 0xfb, 0x8d, 0x27, 0xff,
-	0x49, 0xb9, 0x10, 0xe0, 0x8e, 0x06, 0xc4, 0xa9, 0xe0, 0x3f, 0x5f, 0xd5, 0x5e, 0x02, 0xfc, 0x8e,
-	0x46, 0x0d, 0xb8, 0x85, 0x7d, 0x3f, 0x26, 0x22, 0xdd, 0x8e, 0x52, 0xbd, 0xdc, 0xac, 0x7e, 0x3d,
-	0xd5, 0x17, 0x8a, 0x1d, 0xb1, 0xf2, 0x4a, 0x5b, 0xc6, 0x94, 0x05, 0xce, 0x14, 0x7c, 0x56, 0xf9,
-	0x72, 0xaa, 0xdf, 0xbb, 0x90, 0xd5, 0xbc, 0x0b, 0x70, 0x3c, 0x93, 0x3e, 0xfa, 0xa8, 0x42, 0xe5,
-	0x52, 0x2f, 0x68, 0x0d, 0x34, 0x6b, 0xdf, 0xdd, 0xda, 0x75, 0x5a, 0x87, 0x96, 0xdb, 0xda, 0xdd,
-	0xf1, 0xdc, 0x83, 0x3d, 0xdb, 0xdb, 0xdf, 0x69, 0xef, 0xd9, 0x1b, 0xad, 0x57, 0x2d, 0x7b, 0x73,
-	0x5e, 0x41, 0x2b, 0xb0, 0x74, 0x05, 0xb3, 0x69, 0x6f, 0xdb, 0xaf, 0x2d, 0xd7, 0x9e, 0x57, 0xd1,
-	0x03, 0x58, 0xbe, 0x52, 0x32, 0x43, 0xe6, 0xfe, 0x82, 0x38, 0xf6, 0x0c, 0x29, 0x35, 0x0f, 0x3e,
-	0x8f, 0x35, 0xf5, 0x6c, 0xac, 0xa9, 0x3f, 0xc6, 0x9a, 0xfa, 0x61, 0xa2, 0x29, 0x67, 0x13, 0x4d,
-	0xf9, 0x36, 0xd1, 0x94, 0xc3, 0x17, 0xe7, 0x8e, 0x1b, 0x3d, 0x0a, 0x13, 0x41, 0x39, 0xa3, 0xac,
-	0x6b, 0xe6, 0x0b, 0x4f, 0xe5, 0x48, 0x2f, 0x16, 0x5d, 0x8f, 0xb8, 0x9f, 0x84, 0xc4, 0x1c, 0x9a,
-	0xd3, 0x7b, 0x97, 0x9d, 0xc5, 0xce, 0xcd, 0xec, 0x02, 0x3c, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0xad, 0x4b, 0x1a, 0x66, 0x8f, 0x03, 0x00, 0x00,
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x86, 0xe3, 0xa6, 0xfa, 0x3e, 0x32, 0xfc, 0x28, 0x19, 0x75, 0x91, 0xa6, 0xaa, 0x5b, 0xba,
+	0x21, 0x02, 0x6c, 0xd3, 0xb0, 0x43, 0x48, 0x60, 0x37, 0x86, 0x5a, 0x44, 0x71, 0xe5, 0xb8, 0x91,
+	0x5a, 0x84, 0xac, 0x49, 0x3c, 0x72, 0x46, 0xb1, 0x3d, 0xa9, 0x67, 0x5c, 0x92, 0x5e, 0x05, 0xd7,
+	0xc1, 0xba, 0x17, 0x81, 0x10, 0x8b, 0x8a, 0x15, 0x3b, 0x50, 0x72, 0x19, 0x6c, 0x90, 0x7f, 0x12,
+	0x5a, 0x1a, 0x60, 0xc3, 0x6a, 0x32, 0x39, 0x8f, 0x9f, 0xf7, 0xd8, 0x67, 0x06, 0x6c, 0x30, 0x8e,
+	0x86, 0x24, 0xf4, 0x94, 0xd3, 0xdd, 0x1e, 0xe6, 0x68, 0x57, 0x41, 0x31, 0x1f, 0x9c, 0xc9, 0xa3,
+	0x88, 0x72, 0x0a, 0x37, 0x7d, 0x72, 0x12, 0x13, 0x37, 0x47, 0xe4, 0xf9, 0x9a, 0xa3, 0xb5, 0x35,
+	0x8f, 0x7a, 0x34, 0x25, 0x95, 0xe4, 0x57, 0xf6, 0x50, 0x6d, 0xbd, 0x4f, 0x59, 0x40, 0x99, 0x93,
+	0x15, 0xb2, 0x4d, 0x5e, 0x12, 0xb3, 0x9d, 0xd2, 0x43, 0x0c, 0x2f, 0x02, 0xfb, 0x94, 0x84, 0x59,
+	0x7d, 0xe7, 0x7b, 0x11, 0xc0, 0x0e, 0x47, 0x43, 0xac, 0xc6, 0x7c, 0x40, 0x23, 0x72, 0x86, 0x38,
+	0xa1, 0x21, 0xc4, 0x00, 0x04, 0x68, 0xec, 0x70, 0x3a, 0xc4, 0x21, 0xab, 0x0a, 0xdb, 0x42, 0xfd,
+	0x66, 0x63, 0x5d, 0xce, 0xcd, 0x89, 0x6b, 0xde, 0x91, 0xbc, 0x47, 0x49, 0xa8, 0x3d, 0x78, 0xff,
+	0x75, 0xeb, 0x9e, 0x47, 0xf8, 0x20, 0xee, 0xc9, 0x7d, 0x1a, 0xe4, 0x2d, 0xe4, 0x8b, 0xc4, 0xdc,
+	0xa1, 0xc2, 0x27, 0x23, 0xcc, 0x52, 0xd8, 0x2a, 0x05, 0x68, 0x6c, 0xa7, 0x62, 0xf8, 0x06, 0x00,
+	0xe4, 0xfb, 0xf4, 0xad, 0xe3, 0x13, 0xc6, 0xab, 0x2b, 0x69, 0xcc, 0x53, 0xf9, 0x8f, 0x9f, 0x40,
+	0xbe, 0xde, 0xad, 0xdc, 0x45, 0x3e, 0x71, 0x11, 0xa7, 0x11, 0xdb, 0x2f, 0x58, 0xa5, 0xd4, 0xd8,
+	0x22, 0x8c, 0xc3, 0xd7, 0xa0, 0xe4, 0xe2, 0x70, 0x92, 0xd9, 0x8b, 0xff, 0xc4, 0x7e, 0x23, 0x11,
+	0xa6, 0x72, 0x07, 0x40, 0x74, 0x99, 0x73, 0x92, 0x57, 0xac, 0xae, 0x6e, 0x0b, 0xf5, 0x3b, 0x8d,
+	0x47, 0x7f, 0x49, 0xb9, 0x12, 0x60, 0x4f, 0x46, 0xd8, 0xaa, 0xa0, 0x5f, 0xff, 0xaa, 0x3d, 0x07,
+	0xe0, 0x67, 0x34, 0x6c, 0x80, 0xff, 0x91, 0xeb, 0x46, 0x98, 0x25, 0xe3, 0x28, 0xd6, 0x4b, 0x5a,
+	0xf5, 0xf3, 0xb9, 0xb4, 0x96, 0x4f, 0x44, 0xcd, 0x2a, 0x1d, 0x1e, 0x91, 0xd0, 0xb3, 0xe6, 0xe0,
+	0x93, 0xca, 0xc7, 0x73, 0xe9, 0xf6, 0x95, 0x2c, 0xed, 0x16, 0x00, 0xa7, 0x0b, 0xe9, 0xfd, 0x4f,
+	0x02, 0xa8, 0x5c, 0xeb, 0x05, 0xee, 0x00, 0x51, 0x3d, 0xb4, 0xf7, 0x4d, 0xcb, 0x38, 0x56, 0x6d,
+	0xc3, 0x6c, 0x3b, 0xf6, 0xd1, 0x81, 0xee, 0x1c, 0xb6, 0x3b, 0x07, 0xfa, 0x9e, 0xf1, 0xc2, 0xd0,
+	0x9b, 0xe5, 0x02, 0xdc, 0x02, 0x1b, 0x4b, 0x98, 0xa6, 0xde, 0xd2, 0x5f, 0xaa, 0xb6, 0x5e, 0x16,
+	0xe0, 0x5d, 0xb0, 0xb9, 0x54, 0xb2, 0x40, 0x56, 0x7e, 0x83, 0x58, 0xfa, 0x02, 0x29, 0xc2, 0x87,
+	0xa0, 0xbe, 0x14, 0xe9, 0x9a, 0xaf, 0x74, 0xa7, 0xab, 0xb6, 0x8c, 0xa6, 0x6a, 0x9b, 0x96, 0xa3,
+	0x99, 0xed, 0x66, 0x79, 0x55, 0x3b, 0xfa, 0x30, 0x15, 0x85, 0x8b, 0xa9, 0x28, 0x7c, 0x9b, 0x8a,
+	0xc2, 0xbb, 0x99, 0x58, 0xb8, 0x98, 0x89, 0x85, 0x2f, 0x33, 0xb1, 0x70, 0xfc, 0xec, 0xd2, 0xe1,
+	0x24, 0x27, 0x7e, 0xcc, 0x08, 0x0d, 0x49, 0xd8, 0x57, 0xb2, 0x31, 0x11, 0x3e, 0x91, 0xf2, 0x11,
+	0x49, 0x01, 0x75, 0x63, 0x1f, 0x2b, 0x63, 0x65, 0x7e, 0x4b, 0xd3, 0x93, 0xdb, 0xfb, 0x2f, 0xbd,
+	0x2e, 0x8f, 0x7f, 0x0c, 0x00, 0x46, 0x55, 0xf2, 0x1f, 0xbd, 0x03, 0x00, 0x00,
 }
 
 func (m *StakeAuthorization) Marshal() (dAtA []byte, err error) {
@@ -46,6 +46,10 @@ func TestAuthzAuthorizations(t *testing.T) {
 	beginRedelAuth, _ := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, &coin100)
 	require.Equal(t, beginRedelAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}))
 
+	// verify MethodName
+	revokeValidatorBondAuth, _ := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND, nil)
+	require.Equal(t, revokeValidatorBondAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgRevokeValidatorBond{}))
+
 	validators1_2 := []string{val1.String(), val2.String()}
 
 	testCases := []struct {
@@ -250,6 +254,39 @@ func TestAuthzAuthorizations(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"revoke validator bond: limit is not spent",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND,
+			&coin100,
+			stakingtypes.NewMsgRevokeValidatorBond(delAddr, val1),
+			false,
+			false,
+			nil,
+		},
+		{
+			"revoke validator bond: testing with invalid validator",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND,
+			nil,
+			stakingtypes.NewMsgRevokeValidatorBond(delAddr, val3),
+			true,
+			false,
+			nil,
+		},
+		{
+			"revoke validator bond: fail permission denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REVOKE_VALIDATOR_BOND,
+			nil,
+			stakingtypes.NewMsgRevokeValidatorBond(delAddr, val1),
+			true,
+			false,
+			nil,
+		},
 	}
 
 	for _, tc := range testCases {
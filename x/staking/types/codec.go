@@ -18,8 +18,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// cdc.RegisterConcrete(&MsgUndelegate{}, "cosmos-sdk/MsgUndelegate", nil)
 	// cdc.RegisterConcrete(&MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate", nil)
 	cdc.RegisterConcrete(&MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgTokenizeSharesBatch{}, "cosmos-sdk/MsgTokenizeSharesBatch", nil)
+	cdc.RegisterConcrete(&MsgTokenizeUnbondingDelegation{}, "cosmos-sdk/MsgTokenizeUnbondingDelegation", nil)
 	cdc.RegisterConcrete(&MsgRedeemTokensforShares{}, "cosmos-sdk/MsgRedeemTokensforShares", nil)
+	cdc.RegisterConcrete(&MsgRedeemTokensAndRedelegate{}, "cosmos-sdk/MsgRedeemTokensAndRedelegate", nil)
 	cdc.RegisterConcrete(&MsgTransferTokenizeShareRecord{}, "cosmos-sdk/MsgTransferTokenizeShareRecord", nil)
+	cdc.RegisterConcrete(&MsgEnableTokenizeShareRecordSplitRewards{}, "cosmos-sdk/MsgEnableTokenizeShareRecordSplitRewards", nil)
+	cdc.RegisterConcrete(&MsgSetTokenizeShareRecordCompoundRewards{}, "cosmos-sdk/MsgSetTokenizeShareRecordCompoundRewards", nil)
+	cdc.RegisterConcrete(&MsgMergeTokenizeShareRecords{}, "cosmos-sdk/MsgMergeTokenizeShareRecords", nil)
+	cdc.RegisterConcrete(&MsgValidatorBond{}, "cosmos-sdk/MsgValidatorBond", nil)
+	cdc.RegisterConcrete(&MsgRevokeValidatorBond{}, "cosmos-sdk/MsgRevokeValidatorBond", nil)
+	cdc.RegisterConcrete(&MsgExemptDelegation{}, "cosmos-sdk/MsgExemptDelegation", nil)
+	cdc.RegisterConcrete(&MsgSetTokenizeSharesPolicy{}, "cosmos-sdk/MsgSetTokenizeSharesPolicy", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetValidatorBondFactorOverride{}, "cosmos-sdk/MsgSetValidatorBondFactorOverride", nil)
+	cdc.RegisterConcrete(&MsgRemoveValidatorBondFactorOverride{}, "cosmos-sdk/MsgRemoveValidatorBondFactorOverride", nil)
 
 	// cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	// cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
@@ -37,8 +50,21 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgTokenizeShares{},
+		&MsgTokenizeSharesBatch{},
+		&MsgTokenizeUnbondingDelegation{},
 		&MsgRedeemTokensforShares{},
+		&MsgRedeemTokensAndRedelegate{},
 		&MsgTransferTokenizeShareRecord{},
+		&MsgEnableTokenizeShareRecordSplitRewards{},
+		&MsgSetTokenizeShareRecordCompoundRewards{},
+		&MsgMergeTokenizeShareRecords{},
+		&MsgValidatorBond{},
+		&MsgRevokeValidatorBond{},
+		&MsgSetTokenizeSharesPolicy{},
+		&MsgUpdateParams{},
+		&MsgSetValidatorBondFactorOverride{},
+		&MsgRemoveValidatorBondFactorOverride{},
+		&MsgExemptDelegation{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -50,13 +50,24 @@ var (
 	// ErrNoHistoricalInfo                 = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	// ErrEmptyValidatorPubKey             = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	// ErrCommissionLTMinRate              = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
-	ErrNotEnoughBalance                           = sdkerrors.Register(ModuleName, 41, "not enough balance")
-	ErrTokenizeShareRecordNotExists               = sdkerrors.Register(ModuleName, 42, "tokenize share record not exists")
-	ErrTokenizeShareRecordAlreadyExists           = sdkerrors.Register(ModuleName, 43, "tokenize share record already exists")
-	ErrNotTokenizeShareRecordOwner                = sdkerrors.Register(ModuleName, 44, "not tokenize share record owner")
-	ErrExceedingFreeVestingDelegations            = sdkerrors.Register(ModuleName, 45, "trying to exceed vested free delegation for vesting account")
-	ErrOnlyBondDenomAllowdForTokenize             = sdkerrors.Register(ModuleName, 46, "only bond denom is allowed for tokenize")
-	ErrInsufficientExemptShares                   = sdkerrors.Register(ModuleName, 47, "insufficient exempt shares")
-	ErrRedelegationNotAllowedForExemptDelegation  = sdkerrors.Register(ModuleName, 48, "redelegation is not allowed for exempt delegation")
-	ErrExemptDelegationNotAllowedForTokenizeShare = sdkerrors.Register(ModuleName, 49, "exempt delegation is not allowed for tokenize share")
+	ErrNotEnoughBalance                        = sdkerrors.Register(ModuleName, 41, "not enough balance")
+	ErrTokenizeShareRecordNotExists            = sdkerrors.Register(ModuleName, 42, "tokenize share record not exists")
+	ErrTokenizeShareRecordAlreadyExists        = sdkerrors.Register(ModuleName, 43, "tokenize share record already exists")
+	ErrNotTokenizeShareRecordOwner             = sdkerrors.Register(ModuleName, 44, "not tokenize share record owner")
+	ErrExceedingFreeVestingDelegations         = sdkerrors.Register(ModuleName, 45, "trying to exceed vested free delegation for vesting account")
+	ErrOnlyBondDenomAllowdForTokenize          = sdkerrors.Register(ModuleName, 46, "only bond denom is allowed for tokenize")
+	ErrInsufficientValidatorBondShares         = sdkerrors.Register(ModuleName, 47, "insufficient validator bond shares")
+	ErrRedelegationNotAllowedForValidatorBond  = sdkerrors.Register(ModuleName, 48, "redelegation is not allowed for validator bond delegation")
+	ErrValidatorBondNotAllowedForTokenizeShare = sdkerrors.Register(ModuleName, 49, "validator bond delegation is not allowed to tokenize share")
+	ErrGlobalLiquidStakingCapExceeded          = sdkerrors.Register(ModuleName, 50, "delegation or tokenization exceeds the global cap")
+	ErrDelegationNotValidatorBond              = sdkerrors.Register(ModuleName, 51, "delegation is not a validator bond")
+	ErrTokenizeShareRecordSplitRewardsEnabled  = sdkerrors.Register(ModuleName, 52, "tokenize share record rewards are already split between the share token holders")
+	ErrNoValidatorBondFactorOverride           = sdkerrors.Register(ModuleName, 53, "no validator bond factor override found for the validator")
+	ErrTokenizeSharesDisabledForValidator      = sdkerrors.Register(ModuleName, 54, "tokenize shares are disabled for the validator")
+	ErrTokenizeShareOwnerNotAllowed            = sdkerrors.Register(ModuleName, 55, "share owner is not allowed by the tokenize shares policy of the validator")
+	ErrInsufficientValidatorSelfBond           = sdkerrors.Register(ModuleName, 56, "validator self-bond is below the min validator self bond")
+	ErrTokenizeShareRecordValidatorMismatch    = sdkerrors.Register(ModuleName, 57, "tokenize share records have different validators")
+	ErrNotAllShareTokensHeld                   = sdkerrors.Register(ModuleName, 58, "not all share tokens of the tokenize share record are held")
+	ErrTokenizeShareRecordCompoundRewards      = sdkerrors.Register(ModuleName, 59, "tokenize share record rewards are compounded")
+	ErrTokenizeShareRecordNotMergeable         = sdkerrors.Register(ModuleName, 60, "tokenize share records whose rewards are split or compounded cannot be merged")
 )
@@ -2,27 +2,44 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding           = "complete_unbonding"
-	EventTypeCompleteRedelegation        = "complete_redelegation"
-	EventTypeCreateValidator             = "create_validator"
-	EventTypeEditValidator               = "edit_validator"
-	EventTypeDelegate                    = "delegate"
-	EventTypeUnbond                      = "unbond"
-	EventTypeRedelegate                  = "redelegate"
-	EventTypeTokenizeShares              = "tokenize_shares"
-	EventTypeRedeemShares                = "redeem_shares"
-	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
-	EventTypeExemptDelegation            = "exempt_delegation"
+	EventTypeCompleteUnbonding                 = "complete_unbonding"
+	EventTypeCompleteRedelegation              = "complete_redelegation"
+	EventTypeCreateValidator                   = "create_validator"
+	EventTypeEditValidator                     = "edit_validator"
+	EventTypeDelegate                          = "delegate"
+	EventTypeUnbond                            = "unbond"
+	EventTypeRedelegate                        = "redelegate"
+	EventTypeTokenizeShares                    = "tokenize_shares"
+	EventTypeRedeemShares                      = "redeem_shares"
+	EventTypeTransferTokenizeShareRecord       = "transfer_tokenize_share_record"
+	EventTypeEnableSplitRewards                = "enable_split_rewards"
+	EventTypeSetCompoundRewards                = "set_compound_rewards"
+	EventTypeMergeTokenizeShareRecords         = "merge_tokenize_share_records"
+	EventTypeValidatorBond                     = "validator_bond"
+	EventTypeRevokeValidatorBond               = "revoke_validator_bond"
+	EventTypeSetTokenizeSharesPolicy           = "set_tokenize_shares_policy"
+	EventTypeSetValidatorBondFactorOverride    = "set_validator_bond_factor_override"
+	EventTypeRemoveValidatorBondFactorOverride = "remove_validator_bond_factor_override"
+	EventTypeSlashLiquidTokens                 = "slash_liquid_tokens"
 
-	AttributeKeyValidator      = "validator"
-	AttributeKeyCommissionRate = "commission_rate"
-	AttributeKeySrcValidator   = "source_validator"
-	AttributeKeyDstValidator   = "destination_validator"
-	AttributeKeyDelegator      = "delegator"
-	AttributeKeyCompletionTime = "completion_time"
-	AttributeKeyNewShares      = "new_shares"
-	AttributeKeyShareOwner     = "share_owner"
-	AttributeKeyShareRecordId  = "share_record_id"
-	AttributeKeyAmount         = "amount"
-	AttributeValueCategory     = ModuleName
+	AttributeKeyValidator       = "validator"
+	AttributeKeyCommissionRate  = "commission_rate"
+	AttributeKeySrcValidator    = "source_validator"
+	AttributeKeyDstValidator    = "destination_validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyCompletionTime  = "completion_time"
+	AttributeKeyNewShares       = "new_shares"
+	AttributeKeyShareOwner      = "share_owner"
+	AttributeKeyShareRecordId   = "share_record_id"
+	AttributeKeyMergedRecordId  = "merged_share_record_id"
+	AttributeKeySplitRewards    = "split_rewards"
+	AttributeKeyCompoundRewards = "compound_rewards"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyBondFactor      = "validator_bond_factor"
+	AttributeKeyDisabled        = "disabled"
+	AttributeKeyAllowedOwners   = "allowed_owners"
+	AttributeKeyBurnedTokens    = "burned_tokens"
+	AttributeKeyLiquidTokens    = "liquid_tokens"
+	AttributeKeyBondTokens      = "validator_bond_tokens"
+	AttributeValueCategory      = ModuleName
 )
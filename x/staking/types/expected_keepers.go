@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -43,6 +44,10 @@ type BankKeeper interface {
 
 	MintCoins(cts sdk.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+	DeleteDenomMetaData(ctx sdk.Context, denom string)
 }
 
 // ValidatorSet expected properties for the set of all validators (noalias)
@@ -95,10 +100,12 @@ type DelegationSet interface {
 
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
-	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error                           // Must be called when a validator is created
-	BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error                         // Must be called when a validator's state changes
-	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator is deleted
-	BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordId uint64) error                       // Must be called when tokenize share record is deleted
+	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error                                                 // Must be called when a validator is created
+	BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error                                               // Must be called when a validator's state changes
+	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error                       // Must be called when a validator is deleted
+	BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordId uint64) error                                             // Must be called when tokenize share record is deleted
+	BeforeTokenizeShareRecordModified(ctx sdk.Context, recordId uint64) error                                            // Must be called before the owner, the reward mode or the delegation of a tokenize share record is modified
+	BeforeTokenizeShareRecordRedeemed(ctx sdk.Context, recordId uint64, redeemer sdk.AccAddress, fraction sdk.Dec) error // Must be called before share tokens of a tokenize share record are redeemed, with the redeemed fraction of its share tokens
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error         // Must be called when a validator is bonded
 	AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator begins unbonding
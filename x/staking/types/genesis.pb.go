@@ -48,6 +48,12 @@ type GenesisState struct {
 	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
 	// last tokenize share record id, used for next share record id calculation
 	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total number of liquid staked tokens, from liquid staking providers or tokenized shares
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_liquid_staked_tokens"`
+	// per validator overrides of the validator bond factor param
+	ValidatorBondFactorOverrides []ValidatorBondFactorOverride `protobuf:"bytes,12,rep,name=validator_bond_factor_overrides,json=validatorBondFactorOverrides,proto3" json:"validator_bond_factor_overrides"`
+	// per validator cumulative liquid tokens burned by slashes
+	ValidatorLiquidTokensSlashed []ValidatorLiquidTokensSlashed `protobuf:"bytes,13,rep,name=validator_liquid_tokens_slashed,json=validatorLiquidTokensSlashed,proto3" json:"validator_liquid_tokens_slashed"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -146,6 +152,20 @@ func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetValidatorBondFactorOverrides() []ValidatorBondFactorOverride {
+	if m != nil {
+		return m.ValidatorBondFactorOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorLiquidTokensSlashed() []ValidatorLiquidTokensSlashed {
+	if m != nil {
+		return m.ValidatorLiquidTokensSlashed
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -195,43 +215,50 @@ func init() {
 func init() { proto.RegisterFile("staking/v1beta1/genesis.proto", fileDescriptor_30376b0921a07e54) }
 
 var fileDescriptor_30376b0921a07e54 = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x13, 0xb6, 0x75, 0x9d, 0x3b, 0x10, 0x32, 0x1d, 0xca, 0x2a, 0x35, 0xad, 0x26, 0x81,
-	0x82, 0x50, 0x13, 0xb5, 0xdc, 0xb8, 0x00, 0x05, 0x09, 0x4d, 0x42, 0x68, 0xa4, 0xe3, 0xef, 0x25,
-	0x72, 0x6b, 0x2b, 0xb5, 0x9a, 0xda, 0x5d, 0xec, 0x8c, 0x8d, 0x4f, 0xc0, 0x91, 0x8f, 0xb0, 0x0f,
-	0xc1, 0x87, 0xd8, 0x71, 0x82, 0x0b, 0xe2, 0x30, 0xa1, 0xf6, 0xc2, 0xc7, 0x40, 0x89, 0x9d, 0x12,
-	0x14, 0x44, 0x39, 0x25, 0xd6, 0xf3, 0x3e, 0xbf, 0xe7, 0xb5, 0xe5, 0xd7, 0xa0, 0x29, 0x24, 0x9a,
-	0x50, 0x16, 0x7a, 0xc7, 0xdd, 0x21, 0x91, 0xa8, 0xeb, 0x85, 0x84, 0x11, 0x41, 0x85, 0x3b, 0x8b,
-	0xb9, 0xe4, 0xb0, 0x19, 0xd1, 0xa3, 0x84, 0x62, 0x5d, 0xe4, 0xe6, 0x5f, 0x5d, 0xdc, 0xa8, 0x87,
-	0x3c, 0xe4, 0x59, 0xa5, 0x97, 0xfe, 0x29, 0x53, 0x63, 0x77, 0xc4, 0xc5, 0x94, 0x8b, 0x40, 0x09,
-	0x6a, 0xa1, 0xa5, 0x52, 0x5c, 0x4e, 0xcc, 0xe4, 0xbd, 0xaf, 0x15, 0xb0, 0xfd, 0x54, 0x35, 0x30,
-	0x90, 0x48, 0x12, 0xf8, 0x18, 0x54, 0x66, 0x28, 0x46, 0x53, 0x61, 0x99, 0x6d, 0xd3, 0xa9, 0xf5,
-	0x6e, 0xb9, 0xff, 0x6c, 0xc8, 0x3d, 0xc8, 0x8a, 0xfb, 0xeb, 0xe7, 0x97, 0x2d, 0xc3, 0xd7, 0x56,
-	0xf8, 0x06, 0x5c, 0x8f, 0x90, 0x90, 0x81, 0xe4, 0x12, 0x45, 0xc1, 0x8c, 0xbf, 0x27, 0xb1, 0x75,
-	0xa5, 0x6d, 0x3a, 0xdb, 0x7d, 0x37, 0xad, 0xfb, 0x7e, 0xd9, 0xba, 0x1d, 0x52, 0x39, 0x4e, 0x86,
-	0xee, 0x88, 0x4f, 0x75, 0xbf, 0xfa, 0xd3, 0x11, 0x78, 0xe2, 0xc9, 0xd3, 0x19, 0x11, 0xee, 0x3e,
-	0x93, 0xfe, 0xb5, 0x94, 0x73, 0x98, 0x62, 0x0e, 0x52, 0x0a, 0x9c, 0x80, 0x9d, 0x8c, 0x7c, 0x8c,
-	0x22, 0x8a, 0x91, 0xe4, 0xb1, 0xa2, 0x0b, 0x6b, 0xad, 0xbd, 0xe6, 0xd4, 0x7a, 0xdd, 0x15, 0xdd,
-	0x3e, 0x43, 0x42, 0xbe, 0xca, 0xad, 0x19, 0x51, 0x77, 0x7e, 0x23, 0x2a, 0x29, 0x02, 0x3e, 0x07,
-	0x60, 0x99, 0x23, 0xac, 0xf5, 0x2c, 0xc1, 0x59, 0x91, 0xb0, 0x64, 0x68, 0x70, 0x81, 0x00, 0x5f,
-	0x80, 0x1a, 0x26, 0x11, 0x09, 0x91, 0xa4, 0x9c, 0x09, 0x6b, 0x23, 0x03, 0xde, 0x59, 0x01, 0x7c,
-	0xb2, 0x74, 0x68, 0x62, 0x91, 0x01, 0xa7, 0x60, 0x27, 0x61, 0x43, 0xce, 0x30, 0x65, 0x61, 0x50,
-	0x84, 0x57, 0x32, 0x78, 0x6f, 0x05, 0xfc, 0x65, 0xee, 0x2d, 0xa5, 0xd4, 0x93, 0xb2, 0x24, 0xe0,
-	0x6b, 0x70, 0x35, 0x26, 0xc5, 0x98, 0xcd, 0x2c, 0xe6, 0xee, 0x8a, 0x18, 0xbf, 0xe0, 0xd1, 0xfc,
-	0x3f, 0x39, 0xb0, 0x01, 0xaa, 0xe4, 0x64, 0xc6, 0x63, 0x49, 0xb0, 0x55, 0x6d, 0x9b, 0x4e, 0xd5,
-	0x5f, 0xae, 0x21, 0x03, 0x37, 0x25, 0x9f, 0x10, 0x46, 0x3f, 0x90, 0x40, 0x8c, 0x51, 0x4c, 0x82,
-	0x98, 0x8c, 0x78, 0x8c, 0x85, 0xb5, 0xf5, 0x5f, 0x9b, 0x3c, 0xd4, 0xe6, 0x41, 0xea, 0xf5, 0x33,
-	0x6b, 0xbe, 0x49, 0x59, 0x96, 0x04, 0x7c, 0x08, 0x9a, 0xfa, 0xf6, 0xfe, 0x25, 0x34, 0xa0, 0xd8,
-	0x02, 0x6d, 0xd3, 0x59, 0xf7, 0x77, 0xd5, 0xd5, 0x2c, 0x01, 0xf6, 0xf1, 0xde, 0x18, 0xc0, 0xf2,
-	0x4d, 0x83, 0x3d, 0xb0, 0x89, 0x30, 0x8e, 0x89, 0x50, 0xb3, 0xb5, 0xd5, 0xb7, 0xbe, 0x7c, 0xee,
-	0xd4, 0xf5, 0xb4, 0x3e, 0x52, 0xca, 0x40, 0xc6, 0x94, 0x85, 0x7e, 0x5e, 0x08, 0xeb, 0x60, 0xe3,
-	0xf7, 0xf8, 0xac, 0xf9, 0x6a, 0x71, 0xbf, 0xfa, 0xf1, 0xac, 0x65, 0xfc, 0x3c, 0x6b, 0x19, 0xfd,
-	0xb7, 0xe7, 0x73, 0xdb, 0xbc, 0x98, 0xdb, 0xe6, 0x8f, 0xb9, 0x6d, 0x7e, 0x5a, 0xd8, 0xc6, 0xc5,
-	0xc2, 0x36, 0xbe, 0x2d, 0x6c, 0xe3, 0xdd, 0x83, 0xc2, 0x84, 0xd1, 0xa3, 0x28, 0x11, 0x94, 0x33,
-	0xca, 0x46, 0x9e, 0x3a, 0x2b, 0x2a, 0x4f, 0x3b, 0xfa, 0x9c, 0x3a, 0x53, 0x8e, 0x93, 0x88, 0x78,
-	0x27, 0xf9, 0xd3, 0xa0, 0xc6, 0x6f, 0x58, 0xc9, 0x5e, 0x88, 0x7b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x5e, 0x1f, 0x8a, 0x11, 0xb1, 0x04, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcb, 0x6e, 0x13, 0x3d,
+	0x14, 0xc7, 0x33, 0x5f, 0x6f, 0x89, 0xd3, 0x7e, 0x42, 0x26, 0x85, 0x69, 0x44, 0x93, 0xa8, 0x12,
+	0x68, 0x10, 0xca, 0x44, 0x0d, 0xbb, 0xb2, 0x00, 0x42, 0x05, 0xaa, 0x54, 0x41, 0x99, 0x94, 0xeb,
+	0x66, 0xe4, 0xc4, 0x66, 0x62, 0x65, 0x32, 0x4e, 0x6d, 0x27, 0xb4, 0xbc, 0x00, 0x5d, 0xf2, 0x08,
+	0x7d, 0x08, 0x1e, 0xa2, 0xcb, 0x0a, 0x09, 0x09, 0xb1, 0xa8, 0x50, 0xbb, 0xe1, 0x31, 0xd0, 0xd8,
+	0x9e, 0x34, 0x65, 0x0a, 0x29, 0xab, 0x19, 0xeb, 0x9c, 0xf3, 0xfb, 0xff, 0x8f, 0x2f, 0x07, 0x2c,
+	0x0b, 0x89, 0xba, 0x34, 0x0a, 0x6a, 0xc3, 0xd5, 0x16, 0x91, 0x68, 0xb5, 0x16, 0x90, 0x88, 0x08,
+	0x2a, 0xdc, 0x3e, 0x67, 0x92, 0xc1, 0xe5, 0x90, 0xee, 0x0c, 0x28, 0x36, 0x49, 0x6e, 0xf2, 0x35,
+	0xc9, 0xc5, 0x42, 0xc0, 0x02, 0xa6, 0x32, 0x6b, 0xf1, 0x9f, 0x2e, 0x2a, 0x2e, 0xb5, 0x99, 0xe8,
+	0x31, 0xe1, 0xeb, 0x80, 0x5e, 0x98, 0x50, 0x4a, 0x2e, 0x21, 0xaa, 0xf0, 0xca, 0xd7, 0x1c, 0x98,
+	0x7f, 0xa2, 0x0d, 0x34, 0x25, 0x92, 0x04, 0x3e, 0x02, 0xb3, 0x7d, 0xc4, 0x51, 0x4f, 0xd8, 0x56,
+	0xc5, 0x72, 0xf2, 0xf5, 0x9b, 0xee, 0x5f, 0x0d, 0xb9, 0x5b, 0x2a, 0xb9, 0x31, 0x7d, 0x78, 0x5c,
+	0xce, 0x78, 0xa6, 0x14, 0xbe, 0x06, 0x57, 0x42, 0x24, 0xa4, 0x2f, 0x99, 0x44, 0xa1, 0xdf, 0x67,
+	0xef, 0x09, 0xb7, 0xff, 0xab, 0x58, 0xce, 0x7c, 0xc3, 0x8d, 0xf3, 0xbe, 0x1f, 0x97, 0x6f, 0x05,
+	0x54, 0x76, 0x06, 0x2d, 0xb7, 0xcd, 0x7a, 0xc6, 0xaf, 0xf9, 0x54, 0x05, 0xee, 0xd6, 0xe4, 0x5e,
+	0x9f, 0x08, 0x77, 0x23, 0x92, 0xde, 0xff, 0x31, 0x67, 0x3b, 0xc6, 0x6c, 0xc5, 0x14, 0xd8, 0x05,
+	0x8b, 0x8a, 0x3c, 0x44, 0x21, 0xc5, 0x48, 0x32, 0xae, 0xe9, 0xc2, 0x9e, 0xaa, 0x4c, 0x39, 0xf9,
+	0xfa, 0xea, 0x04, 0xb7, 0x9b, 0x48, 0xc8, 0x97, 0x49, 0xa9, 0x22, 0x1a, 0xe7, 0x57, 0xc3, 0x54,
+	0x44, 0xc0, 0xa7, 0x00, 0x8c, 0x74, 0x84, 0x3d, 0xad, 0x14, 0x9c, 0x09, 0x0a, 0x23, 0x86, 0x01,
+	0x8f, 0x11, 0xe0, 0x73, 0x90, 0xc7, 0x24, 0x24, 0x01, 0x92, 0x94, 0x45, 0xc2, 0x9e, 0x51, 0xc0,
+	0xdb, 0x13, 0x80, 0xeb, 0xa3, 0x0a, 0x43, 0x1c, 0x67, 0xc0, 0x1e, 0x58, 0x1c, 0x44, 0x2d, 0x16,
+	0x61, 0x1a, 0x05, 0xfe, 0x38, 0x7c, 0x56, 0xc1, 0xeb, 0x13, 0xe0, 0x2f, 0x92, 0xda, 0x94, 0x4a,
+	0x61, 0x90, 0x0e, 0x09, 0xf8, 0x0a, 0x2c, 0x70, 0x32, 0x2e, 0x33, 0xa7, 0x64, 0xee, 0x4c, 0x90,
+	0xf1, 0x08, 0xfe, 0x9d, 0x7f, 0x9e, 0x03, 0x8b, 0x20, 0x4b, 0x76, 0xfb, 0x8c, 0x4b, 0x82, 0xed,
+	0x6c, 0xc5, 0x72, 0xb2, 0xde, 0x68, 0x0d, 0x23, 0x70, 0x4d, 0xb2, 0x2e, 0x89, 0xe8, 0x07, 0xe2,
+	0x8b, 0x0e, 0xe2, 0xc4, 0xe7, 0xa4, 0xcd, 0x38, 0x16, 0x76, 0xee, 0x52, 0x4d, 0x6e, 0x9b, 0xe2,
+	0x66, 0x5c, 0xeb, 0xa9, 0xd2, 0xa4, 0x49, 0x99, 0x0e, 0x09, 0xf8, 0x00, 0x2c, 0x9b, 0xdb, 0x7b,
+	0x81, 0xa8, 0x4f, 0xb1, 0x0d, 0x2a, 0x96, 0x33, 0xed, 0x2d, 0xe9, 0xab, 0x99, 0x02, 0x6c, 0x60,
+	0xd8, 0x05, 0x45, 0x7d, 0xf5, 0xb5, 0x31, 0x3f, 0x76, 0x44, 0xb0, 0x06, 0x0a, 0x3b, 0x5f, 0xb1,
+	0x9c, 0xdc, 0x3f, 0xbd, 0x84, 0x75, 0xd2, 0xf6, 0xae, 0x2b, 0xe2, 0xa6, 0x02, 0x36, 0x15, 0x4f,
+	0x69, 0x0b, 0xf8, 0xd1, 0x02, 0xe5, 0xb3, 0xe7, 0x10, 0x1f, 0x9a, 0xff, 0x0e, 0xb5, 0xe3, 0x7f,
+	0x36, 0x24, 0x9c, 0x53, 0x4c, 0x84, 0x3d, 0xaf, 0x36, 0x6a, 0xed, 0xd2, 0x77, 0x97, 0x45, 0xf8,
+	0xb1, 0x62, 0x3c, 0x33, 0x08, 0xb3, 0x61, 0x37, 0x86, 0x7f, 0x4e, 0x11, 0x70, 0xff, 0x9c, 0x13,
+	0xd3, 0xbb, 0x6e, 0xda, 0x17, 0x21, 0x12, 0x1d, 0x82, 0xed, 0x05, 0xe5, 0xe4, 0xde, 0x65, 0x9d,
+	0xe8, 0x7e, 0x75, 0xa7, 0x4d, 0x8d, 0x48, 0x59, 0xb9, 0x20, 0x67, 0xa5, 0x03, 0x60, 0xfa, 0xad,
+	0xc3, 0x3a, 0x98, 0x43, 0x18, 0x73, 0x22, 0xf4, 0x74, 0xcb, 0x35, 0xec, 0x2f, 0x9f, 0xab, 0x05,
+	0x33, 0x2f, 0x1f, 0xea, 0x48, 0x53, 0x72, 0x1a, 0x05, 0x5e, 0x92, 0x08, 0x0b, 0x60, 0xe6, 0x6c,
+	0x80, 0x4d, 0x79, 0x7a, 0xb1, 0x96, 0xdd, 0x3f, 0x28, 0x67, 0x7e, 0x1e, 0x94, 0x33, 0x8d, 0x37,
+	0x87, 0x27, 0x25, 0xeb, 0xe8, 0xa4, 0x64, 0xfd, 0x38, 0x29, 0x59, 0x9f, 0x4e, 0x4b, 0x99, 0xa3,
+	0xd3, 0x52, 0xe6, 0xdb, 0x69, 0x29, 0xf3, 0xf6, 0xfe, 0xd8, 0xc9, 0xd2, 0x9d, 0x70, 0x20, 0x28,
+	0x8b, 0x68, 0xd4, 0xae, 0xe9, 0xd6, 0xa9, 0xdc, 0xab, 0x9a, 0xb6, 0xab, 0x3d, 0x86, 0x07, 0x21,
+	0xa9, 0xed, 0x26, 0xc3, 0x59, 0x1f, 0x7b, 0x6b, 0x56, 0xcd, 0xe8, 0xbb, 0xbf, 0x06, 0x00, 0xc1,
+	0xa9, 0xd9, 0xeb, 0x33, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -254,6 +281,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorLiquidTokensSlashed) > 0 {
+		for iNdEx := len(m.ValidatorLiquidTokensSlashed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorLiquidTokensSlashed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorBondFactorOverrides) > 0 {
+		for iNdEx := len(m.ValidatorBondFactorOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBondFactorOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	if m.LastTokenizeShareRecordId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
 		i--
@@ -474,6 +539,20 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastTokenizeShareRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
 	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorBondFactorOverrides) > 0 {
+		for _, e := range m.ValidatorBondFactorOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorLiquidTokensSlashed) > 0 {
+		for _, e := range m.ValidatorLiquidTokensSlashed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -837,6 +916,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactorOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBondFactorOverrides = append(m.ValidatorBondFactorOverrides, ValidatorBondFactorOverride{})
+			if err := m.ValidatorBondFactorOverrides[len(m.ValidatorBondFactorOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidTokensSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorLiquidTokensSlashed = append(m.ValidatorLiquidTokensSlashed, ValidatorLiquidTokensSlashed{})
+			if err := m.ValidatorLiquidTokensSlashed[len(m.ValidatorLiquidTokensSlashed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
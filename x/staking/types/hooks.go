@@ -50,6 +50,24 @@ func (h MultiStakingHooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, rec
 	return nil
 }
 
+func (h MultiStakingHooks) BeforeTokenizeShareRecordModified(ctx sdk.Context, recordId uint64) error {
+	for i := range h {
+		if err := h[i].BeforeTokenizeShareRecordModified(ctx, recordId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) BeforeTokenizeShareRecordRedeemed(ctx sdk.Context, recordId uint64, redeemer sdk.AccAddress, fraction sdk.Dec) error {
+	for i := range h {
+		if err := h[i].BeforeTokenizeShareRecordRedeemed(ctx, recordId, redeemer, fraction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiStakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	for i := range h {
 		if err := h[i].AfterValidatorBonded(ctx, consAddr, valAddr); err != nil {
@@ -49,11 +49,18 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
-
-	TokenizeShareRecordPrefix          = []byte{0x61} // key for tokenizeshare record prefix
-	TokenizeShareRecordIdByOwnerPrefix = []byte{0x62} // key for tokenizeshare record id by owner prefix
-	TokenizeShareRecordIdByDenomPrefix = []byte{0x63} // key for tokenizeshare record id by denom prefix
-	LastTokenizeShareRecordIdKey       = []byte{0x64} // key for last tokenize share record id
+	ParamsKey         = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix                  = []byte{0x61} // key for tokenizeshare record prefix
+	TokenizeShareRecordIdByOwnerPrefix         = []byte{0x62} // key for tokenizeshare record id by owner prefix
+	TokenizeShareRecordIdByDenomPrefix         = []byte{0x63} // key for tokenizeshare record id by denom prefix
+	LastTokenizeShareRecordIdKey               = []byte{0x64} // key for last tokenize share record id
+	TotalLiquidStakedTokensKey                 = []byte{0x65} // key for total liquid staked tokens
+	TokenizeShareRecordIdByModuleAccountPrefix = []byte{0x66} // key for tokenizeshare record id by module account prefix
+	TokenizeShareRecordIdByValidatorPrefix     = []byte{0x67} // key for tokenizeshare record id by validator prefix
+	ValidatorBondFactorOverridePrefix          = []byte{0x68} // key for the validator bond factor override of a validator
+	ValidatorLiquidTokensSlashedPrefix         = []byte{0x69} // key for the cumulative liquid tokens slashed of a validator
+	ValidatorBondDelegationByValidatorPrefix   = []byte{0x6A} // key for validator bond delegation index, by validator
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -372,3 +379,40 @@ func GetTokenizeShareRecordIdByOwnerAndIdKey(owner sdk.AccAddress, id uint64) []
 func GetTokenizeShareRecordIdByDenomKey(denom string) []byte {
 	return append(TokenizeShareRecordIdByDenomPrefix, []byte(denom)...)
 }
+
+// GetTokenizeShareRecordIdsByValidatorPrefix returns the key of the specified validator. Intended for querying all tokenizeShareRecords of a validator
+func GetTokenizeShareRecordIdsByValidatorPrefix(valAddr sdk.ValAddress) []byte {
+	return append(TokenizeShareRecordIdByValidatorPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetTokenizeShareRecordIdByValidatorAndIdKey returns the key of the specified validator and id. Intended for setting tokenizeShareRecord of a validator
+func GetTokenizeShareRecordIdByValidatorAndIdKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIdsByValidatorPrefix(valAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetValidatorBondFactorOverrideKey returns the key of the validator bond factor override of a validator
+func GetValidatorBondFactorOverrideKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondFactorOverridePrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorLiquidTokensSlashedKey returns the key of the cumulative liquid tokens slashed of a validator
+func GetValidatorLiquidTokensSlashedKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidTokensSlashedPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorBondDelegationsByValidatorPrefix returns the key prefix of the validator bond delegations
+// of a validator. Intended for querying all the validator bond delegators of a validator
+func GetValidatorBondDelegationsByValidatorPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondDelegationByValidatorPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorBondDelegationByValidatorKey returns the key of the validator bond delegation of a delegator
+// in the index of a validator
+func GetValidatorBondDelegationByValidatorKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(GetValidatorBondDelegationsByValidatorPrefix(valAddr), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetTokenizeShareRecordIdByModuleAccountKey returns the key of the specified module account. Intended for querying the tokenizeShareRecord by its custodian module account
+func GetTokenizeShareRecordIdByModuleAccountKey(moduleAccount sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIdByModuleAccountPrefix, address.MustLengthPrefix(moduleAccount)...)
+}
@@ -13,19 +13,40 @@ import (
 
 // staking message types
 const (
-	TypeMsgUndelegate                  = "begin_unbonding"
-	TypeMsgUnbondValidator             = "unbond_validator"
-	TypeMsgEditValidator               = "edit_validator"
-	TypeMsgCreateValidator             = "create_validator"
-	TypeMsgDelegate                    = "delegate"
-	TypeMsgBeginRedelegate             = "begin_redelegate"
-	TypeMsgCancelUnbondingDelegation   = "cancel_unbond"
-	TypeMsgTokenizeShares              = "tokenize_shares"
-	TypeMsgRedeemTokensforShares       = "redeem_tokens_for_shares"
-	TypeMsgTransferTokenizeShareRecord = "transfer_tokenize_share_record"
-	TypeMsgExemptDelegation            = "exempt_delegation"
+	TypeMsgUndelegate                            = "begin_unbonding"
+	TypeMsgUnbondValidator                       = "unbond_validator"
+	TypeMsgEditValidator                         = "edit_validator"
+	TypeMsgCreateValidator                       = "create_validator"
+	TypeMsgDelegate                              = "delegate"
+	TypeMsgBeginRedelegate                       = "begin_redelegate"
+	TypeMsgCancelUnbondingDelegation             = "cancel_unbond"
+	TypeMsgTokenizeShares                        = "tokenize_shares"
+	TypeMsgTokenizeSharesBatch                   = "tokenize_shares_batch"
+	TypeMsgTokenizeUnbondingDelegation           = "tokenize_unbonding_delegation"
+	TypeMsgRedeemTokensforShares                 = "redeem_tokens_for_shares"
+	TypeMsgRedeemTokensAndRedelegate             = "redeem_tokens_and_redelegate"
+	TypeMsgTransferTokenizeShareRecord           = "transfer_tokenize_share_record"
+	TypeMsgEnableTokenizeShareRecordSplitRewards = "enable_tokenize_share_record_split_rewards"
+	TypeMsgSetTokenizeShareRecordCompoundRewards = "set_tokenize_share_record_compound_rewards"
+	TypeMsgMergeTokenizeShareRecords             = "merge_tokenize_share_records"
+	TypeMsgValidatorBond                         = "validator_bond"
+	TypeMsgRevokeValidatorBond                   = "revoke_validator_bond"
+	TypeMsgSetTokenizeSharesPolicy               = "set_tokenize_shares_policy"
+	TypeMsgUpdateParams                          = "update_params"
+	TypeMsgSetValidatorBondFactorOverride        = "set_validator_bond_factor_override"
+	TypeMsgRemoveValidatorBondFactorOverride     = "remove_validator_bond_factor_override"
+	// Deprecated: use TypeMsgValidatorBond
+	TypeMsgExemptDelegation = "exempt_delegation"
 )
 
+// MaxTokenizeSharesBatchEntries is the maximum number of validators to tokenize
+// shares from in a single MsgTokenizeSharesBatch
+const MaxTokenizeSharesBatchEntries = 50
+
+// MaxMergedTokenizeShareRecords is the maximum number of tokenize share records
+// to merge into a surviving record in a single MsgMergeTokenizeShareRecords
+const MaxMergedTokenizeShareRecords = 50
+
 var (
 	_ sdk.Msg                            = &MsgCreateValidator{}
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateValidator)(nil)
@@ -36,9 +57,22 @@ var (
 	_ sdk.Msg                            = &MsgUnbondValidator{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgTokenizeSharesBatch{}
+	_ sdk.Msg                            = &MsgTokenizeUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgRedeemTokensforShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensAndRedelegate{}
 	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgEnableTokenizeShareRecordSplitRewards{}
+	_ sdk.Msg                            = &MsgSetTokenizeShareRecordCompoundRewards{}
+	_ sdk.Msg                            = &MsgMergeTokenizeShareRecords{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg                            = &MsgValidatorBond{}
+	_ sdk.Msg                            = &MsgRevokeValidatorBond{}
+	_ sdk.Msg                            = &MsgSetTokenizeSharesPolicy{}
+	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgSetValidatorBondFactorOverride{}
+	_ sdk.Msg                            = &MsgRemoveValidatorBondFactorOverride{}
+	_ sdk.Msg                            = &MsgExemptDelegation{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -419,6 +453,134 @@ func (msg MsgTokenizeShares) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgTokenizeSharesBatch creates a new MsgTokenizeSharesBatch instance.
+//
+//nolint:interfacer
+func NewMsgTokenizeSharesBatch(delAddr sdk.AccAddress, entries []TokenizeSharesBatchEntry, owner sdk.AccAddress) *MsgTokenizeSharesBatch {
+	return &MsgTokenizeSharesBatch{
+		DelegatorAddress:    delAddr.String(),
+		Entries:             entries,
+		TokenizedShareOwner: owner.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTokenizeSharesBatch) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTokenizeSharesBatch) Type() string { return TypeMsgTokenizeSharesBatch }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTokenizeSharesBatch) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTokenizeSharesBatch) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTokenizeSharesBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenize share owner address: %s", err)
+	}
+
+	if len(msg.Entries) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no entries to tokenize")
+	}
+	if len(msg.Entries) > MaxTokenizeSharesBatchEntries {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "too many entries; got: %d, max: %d", len(msg.Entries), MaxTokenizeSharesBatchEntries)
+	}
+
+	validators := make(map[string]bool, len(msg.Entries))
+	for _, entry := range msg.Entries {
+		if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+		if validators[entry.ValidatorAddress] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate validator %s", entry.ValidatorAddress)
+		}
+		validators[entry.ValidatorAddress] = true
+
+		if !entry.Amount.IsValid() || !entry.Amount.Amount.IsPositive() {
+			return errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"invalid shares amount",
+			)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgTokenizeUnbondingDelegation creates a new MsgTokenizeUnbondingDelegation instance.
+//
+//nolint:interfacer
+func NewMsgTokenizeUnbondingDelegation(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, creationHeight int64, owner sdk.AccAddress,
+) *MsgTokenizeUnbondingDelegation {
+	return &MsgTokenizeUnbondingDelegation{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              amount,
+		CreationHeight:      creationHeight,
+		TokenizedShareOwner: owner.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTokenizeUnbondingDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTokenizeUnbondingDelegation) Type() string { return TypeMsgTokenizeUnbondingDelegation }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTokenizeUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTokenizeUnbondingDelegation) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTokenizeUnbondingDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenize share owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid amount",
+		)
+	}
+
+	if msg.CreationHeight <= 0 {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid height",
+		)
+	}
+
+	return nil
+}
+
 // Type implements the sdk.Msg interface.
 func (msg MsgRedeemTokensforShares) Type() string { return TypeMsgRedeemTokensforShares }
 
@@ -450,6 +612,62 @@ func (msg MsgRedeemTokensforShares) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgRedeemTokensAndRedelegate creates a new MsgRedeemTokensAndRedelegate instance.
+//
+//nolint:interfacer
+func NewMsgRedeemTokensAndRedelegate(delAddr sdk.AccAddress, amount sdk.Coin, valDstAddr sdk.ValAddress) *MsgRedeemTokensAndRedelegate {
+	return &MsgRedeemTokensAndRedelegate{
+		DelegatorAddress:    delAddr.String(),
+		Amount:              amount,
+		ValidatorDstAddress: valDstAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRedeemTokensAndRedelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRedeemTokensAndRedelegate) Type() string { return TypeMsgRedeemTokensAndRedelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemTokensAndRedelegate) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedeemTokensAndRedelegate) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRedeemTokensAndRedelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination validator address: %s", err)
+	}
+
+	if msg.TokenizedShareOwner != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenize share owner address: %s", err)
+		}
+	} else if msg.SplitRewards {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "split rewards requires a tokenize share owner")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	return nil
+}
+
 // Type implements the sdk.Msg interface.
 func (msg MsgTransferTokenizeShareRecord) Type() string { return TypeMsgTransferTokenizeShareRecord }
 
@@ -477,6 +695,149 @@ func (msg MsgTransferTokenizeShareRecord) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgEnableTokenizeShareRecordSplitRewards creates a new MsgEnableTokenizeShareRecordSplitRewards instance.
+//
+//nolint:interfacer
+func NewMsgEnableTokenizeShareRecordSplitRewards(sender sdk.AccAddress, recordId uint64) *MsgEnableTokenizeShareRecordSplitRewards {
+	return &MsgEnableTokenizeShareRecordSplitRewards{
+		TokenizeShareRecordId: recordId,
+		Sender:                sender.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgEnableTokenizeShareRecordSplitRewards) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgEnableTokenizeShareRecordSplitRewards) Type() string {
+	return TypeMsgEnableTokenizeShareRecordSplitRewards
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgEnableTokenizeShareRecordSplitRewards) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgEnableTokenizeShareRecordSplitRewards) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgEnableTokenizeShareRecordSplitRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgSetTokenizeShareRecordCompoundRewards creates a new MsgSetTokenizeShareRecordCompoundRewards instance.
+//
+//nolint:interfacer
+func NewMsgSetTokenizeShareRecordCompoundRewards(sender sdk.AccAddress, recordId uint64, enabled bool) *MsgSetTokenizeShareRecordCompoundRewards {
+	return &MsgSetTokenizeShareRecordCompoundRewards{
+		TokenizeShareRecordId: recordId,
+		Sender:                sender.String(),
+		Enabled:               enabled,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetTokenizeShareRecordCompoundRewards) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetTokenizeShareRecordCompoundRewards) Type() string {
+	return TypeMsgSetTokenizeShareRecordCompoundRewards
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetTokenizeShareRecordCompoundRewards) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetTokenizeShareRecordCompoundRewards) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetTokenizeShareRecordCompoundRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgMergeTokenizeShareRecords creates a new MsgMergeTokenizeShareRecords instance.
+//
+//nolint:interfacer
+func NewMsgMergeTokenizeShareRecords(sender sdk.AccAddress, recordId uint64, mergedRecordIds []uint64) *MsgMergeTokenizeShareRecords {
+	return &MsgMergeTokenizeShareRecords{
+		Sender:                sender.String(),
+		TokenizeShareRecordId: recordId,
+		MergedRecordIds:       mergedRecordIds,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgMergeTokenizeShareRecords) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgMergeTokenizeShareRecords) Type() string {
+	return TypeMsgMergeTokenizeShareRecords
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgMergeTokenizeShareRecords) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgMergeTokenizeShareRecords) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgMergeTokenizeShareRecords) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if len(msg.MergedRecordIds) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no tokenize share records to merge")
+	}
+	if len(msg.MergedRecordIds) > MaxMergedTokenizeShareRecords {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "too many tokenize share records to merge; got: %d, max: %d", len(msg.MergedRecordIds), MaxMergedTokenizeShareRecords)
+	}
+
+	recordIds := map[uint64]bool{msg.TokenizeShareRecordId: true}
+	for _, recordId := range msg.MergedRecordIds {
+		if recordIds[recordId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate tokenize share record %d", recordId)
+		}
+		recordIds[recordId] = true
+	}
+
+	return nil
+}
+
 // NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
 //
 //nolint:interfacer
@@ -532,8 +893,268 @@ func (msg MsgCancelUnbondingDelegation) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgValidatorBond creates a new MsgValidatorBond instance.
+//
+//nolint:interfacer
+func NewMsgValidatorBond(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgValidatorBond {
+	return &MsgValidatorBond{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgValidatorBond) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgValidatorBond) Type() string { return TypeMsgValidatorBond }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgValidatorBond) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgValidatorBond) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgValidatorBond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgRevokeValidatorBond creates a new MsgRevokeValidatorBond instance.
+//
+//nolint:interfacer
+func NewMsgRevokeValidatorBond(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgRevokeValidatorBond {
+	return &MsgRevokeValidatorBond{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeValidatorBond) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeValidatorBond) Type() string { return TypeMsgRevokeValidatorBond }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeValidatorBond) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevokeValidatorBond) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeValidatorBond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgSetTokenizeSharesPolicy creates a new MsgSetTokenizeSharesPolicy instance.
+//
+//nolint:interfacer
+func NewMsgSetTokenizeSharesPolicy(valAddr sdk.ValAddress, policy TokenizeSharesPolicy) *MsgSetTokenizeSharesPolicy {
+	return &MsgSetTokenizeSharesPolicy{
+		ValidatorAddress:     valAddr.String(),
+		TokenizeSharesPolicy: policy,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetTokenizeSharesPolicy) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetTokenizeSharesPolicy) Type() string { return TypeMsgSetTokenizeSharesPolicy }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetTokenizeSharesPolicy) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetTokenizeSharesPolicy) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetTokenizeSharesPolicy) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return msg.TokenizeSharesPolicy.Validate()
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+//
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgSetValidatorBondFactorOverride creates a new MsgSetValidatorBondFactorOverride instance.
+//
+//nolint:interfacer
+func NewMsgSetValidatorBondFactorOverride(authority sdk.AccAddress, valAddr sdk.ValAddress, validatorBondFactor sdk.Dec) *MsgSetValidatorBondFactorOverride {
+	return &MsgSetValidatorBondFactorOverride{
+		Authority:           authority.String(),
+		ValidatorAddress:    valAddr.String(),
+		ValidatorBondFactor: validatorBondFactor,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondFactorOverride) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondFactorOverride) Type() string {
+	return TypeMsgSetValidatorBondFactorOverride
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondFactorOverride) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondFactorOverride) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetValidatorBondFactorOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.ValidatorBondFactor.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrap("validator bond factor cannot be nil")
+	}
+
+	return validateValidatorBondFactor(msg.ValidatorBondFactor)
+}
+
+// NewMsgRemoveValidatorBondFactorOverride creates a new MsgRemoveValidatorBondFactorOverride instance.
+//
+//nolint:interfacer
+func NewMsgRemoveValidatorBondFactorOverride(authority sdk.AccAddress, valAddr sdk.ValAddress) *MsgRemoveValidatorBondFactorOverride {
+	return &MsgRemoveValidatorBondFactorOverride{
+		Authority:        authority.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondFactorOverride) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondFactorOverride) Type() string {
+	return TypeMsgRemoveValidatorBondFactorOverride
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondFactorOverride) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondFactorOverride) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRemoveValidatorBondFactorOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
 // NewMsgExemptDelegation creates a new MsgExemptDelegation instance.
 //
+// Deprecated: use NewMsgValidatorBond, MsgExemptDelegation is only kept so that
+// transactions signed with the ADR-001 type URL are still accepted.
+//
 //nolint:interfacer
 func NewMsgExemptDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgExemptDelegation {
 	return &MsgExemptDelegation{
@@ -192,3 +192,72 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgTokenizeSharesBatch
+func TestMsgTokenizeSharesBatch(t *testing.T) {
+	delAddr := sdk.AccAddress(valAddr1)
+	tooManyEntries := make([]types.TokenizeSharesBatchEntry, types.MaxTokenizeSharesBatchEntries+1)
+	for i := range tooManyEntries {
+		tooManyEntries[i] = types.TokenizeSharesBatchEntry{
+			ValidatorAddress: sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Amount:           coinPos,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		entries    []types.TokenizeSharesBatchEntry
+		expectPass bool
+	}{
+		{"regular", []types.TokenizeSharesBatchEntry{{valAddr1.String(), coinPos}, {valAddr2.String(), coinPos}}, true},
+		{"no entries", nil, false},
+		{"too many entries", tooManyEntries, false},
+		{"empty validator", []types.TokenizeSharesBatchEntry{{"", coinPos}}, false},
+		{"duplicate validator", []types.TokenizeSharesBatchEntry{{valAddr1.String(), coinPos}, {valAddr1.String(), coinPos}}, false},
+		{"zero amount", []types.TokenizeSharesBatchEntry{{valAddr1.String(), coinZero}}, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgTokenizeSharesBatch(delAddr, tc.entries, delAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgMergeTokenizeShareRecords(t *testing.T) {
+	sender := sdk.AccAddress(valAddr1)
+	tooManyRecords := make([]uint64, types.MaxMergedTokenizeShareRecords+1)
+	for i := range tooManyRecords {
+		tooManyRecords[i] = uint64(i + 2)
+	}
+
+	tests := []struct {
+		name            string
+		recordId        uint64
+		mergedRecordIds []uint64
+		expectPass      bool
+	}{
+		{"regular", 1, []uint64{2, 3}, true},
+		{"no merged records", 1, nil, false},
+		{"too many merged records", 1, tooManyRecords, false},
+		{"merged into itself", 1, []uint64{1}, false},
+		{"duplicate merged record", 1, []uint64{2, 2}, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgMergeTokenizeShareRecords(sender, tc.recordId, tc.mergedRecordIds)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgExemptDelegationGetSignBytes(t *testing.T) {
+	msg := types.NewMsgExemptDelegation(sdk.AccAddress(valAddr1), valAddr2)
+	require.Contains(t, string(msg.GetSignBytes()), `"type":"cosmos-sdk/MsgExemptDelegation"`)
+}
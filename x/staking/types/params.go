@@ -36,18 +36,24 @@ const (
 var (
 	// DefaultMinCommissionRate is set to 0%
 	DefaultMinCommissionRate = sdk.ZeroDec()
-	// DefaultExemptionFactor is set to -1 (disabled)
-	DefaultExemptionFactor = sdk.NewDecFromInt(sdk.NewInt(-1))
+	// DefaultValidatorBondFactor is set to -1 (disabled)
+	DefaultValidatorBondFactor = sdk.NewDecFromInt(sdk.NewInt(-1))
+	// DefaultGlobalLiquidStakingCap is set to 100%
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+	// DefaultMinValidatorSelfBond is set to 0 (disabled)
+	DefaultMinValidatorSelfBond = sdk.ZeroInt()
 )
 
 var (
-	KeyUnbondingTime     = []byte("UnbondingTime")
-	KeyMaxValidators     = []byte("MaxValidators")
-	KeyMaxEntries        = []byte("MaxEntries")
-	KeyBondDenom         = []byte("BondDenom")
-	KeyHistoricalEntries = []byte("HistoricalEntries")
-	KeyMinCommissionRate = []byte("MinCommissionRate")
-	KeyExemptionFactor   = []byte("ExemptionFactor")
+	KeyUnbondingTime          = []byte("UnbondingTime")
+	KeyMaxValidators          = []byte("MaxValidators")
+	KeyMaxEntries             = []byte("MaxEntries")
+	KeyBondDenom              = []byte("BondDenom")
+	KeyHistoricalEntries      = []byte("HistoricalEntries")
+	KeyMinCommissionRate      = []byte("MinCommissionRate")
+	KeyValidatorBondFactor    = []byte("ValidatorBondFactor")
+	KeyGlobalLiquidStakingCap = []byte("GlobalLiquidStakingCap")
+	KeyMinValidatorSelfBond   = []byte("MinValidatorSelfBond")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -58,15 +64,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate, exemptionFactor sdk.Dec) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate, validatorBondFactor, globalLiquidStakingCap sdk.Dec, minValidatorSelfBond math.Int) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
-		ExemptionFactor:   exemptionFactor,
+		UnbondingTime:          unbondingTime,
+		MaxValidators:          maxValidators,
+		MaxEntries:             maxEntries,
+		HistoricalEntries:      historicalEntries,
+		BondDenom:              bondDenom,
+		MinCommissionRate:      minCommissionRate,
+		ValidatorBondFactor:    validatorBondFactor,
+		GlobalLiquidStakingCap: globalLiquidStakingCap,
+		MinValidatorSelfBond:   minValidatorSelfBond,
 	}
 }
 
@@ -79,7 +87,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
-		paramtypes.NewParamSetPair(KeyExemptionFactor, &p.ExemptionFactor, validateExemptionFactor),
+		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateGlobalLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyMinValidatorSelfBond, &p.MinValidatorSelfBond, validateMinValidatorSelfBond),
 	}
 }
 
@@ -92,7 +102,9 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
-		DefaultExemptionFactor,
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultMinValidatorSelfBond,
 	)
 }
 
@@ -144,7 +156,15 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	if err := validateExemptionFactor(p.ExemptionFactor); err != nil {
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateGlobalLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateMinValidatorSelfBond(p.MinValidatorSelfBond); err != nil {
 		return err
 	}
 
@@ -245,14 +265,46 @@ func validateMinCommissionRate(i interface{}) error {
 	return nil
 }
 
-func validateExemptionFactor(i interface{}) error {
+func validateValidatorBondFactor(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
 	if v.IsNegative() && !v.Equal(sdk.NewDec(-1)) {
-		return fmt.Errorf("invalid exemption factor: %s", v)
+		return fmt.Errorf("invalid validator bond factor: %s", v)
+	}
+
+	return nil
+}
+
+func validateGlobalLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("global liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("global liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinValidatorSelfBond(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min validator self bond cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min validator self bond cannot be negative: %s", v)
 	}
 
 	return nil
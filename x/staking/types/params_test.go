@@ -35,4 +35,12 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate min validator self bond
+	params = types.DefaultParams()
+	params.MinValidatorSelfBond = sdk.NewInt(-1)
+	require.Error(t, params.Validate())
+
+	params.MinValidatorSelfBond = sdk.Int{}
+	require.Error(t, params.Validate())
 }
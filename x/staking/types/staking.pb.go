@@ -9,9 +9,9 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types2 "github.com/cosmos/cosmos-sdk/codec/types"
-	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types3 "github.com/cosmos/cosmos-sdk/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 	proto "github.com/gogo/protobuf/proto"
@@ -332,9 +332,12 @@ type Validator struct {
 	UnbondingTime time.Time `protobuf:"bytes,9,opt,name=unbonding_time,json=unbondingTime,proto3,stdtime" json:"unbonding_time"`
 	// commission defines the commission parameters.
 	Commission Commission `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission"`
-	// Number of shares marked_exempt_to_this_validator
-	TotalExemptShares    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=total_exempt_shares,json=totalExemptShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_exempt_shares" yaml:"total_exempt_shares"`
-	TotalTokenizedShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=total_tokenized_shares,json=totalTokenizedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_tokenized_shares" yaml:"total_tokenized_shares"`
+	// Number of shares self bonded from the validator
+	TotalValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=total_validator_bond_shares,json=totalValidatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_validator_bond_shares" yaml:"total_validator_bond_shares"`
+	// Number of shares either tokenized or owned by a liquid staking provider
+	TotalLiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=total_liquid_shares,json=totalLiquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_liquid_shares" yaml:"total_liquid_shares"`
+	// Restrictions set by the validator operator on new tokenizations of delegations to the validator
+	TokenizeSharesPolicy TokenizeSharesPolicy `protobuf:"bytes,13,opt,name=tokenize_shares_policy,json=tokenizeSharesPolicy,proto3" json:"tokenize_shares_policy" yaml:"tokenize_shares_policy"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -369,6 +372,63 @@ func (m *Validator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Validator proto.InternalMessageInfo
 
+// TokenizeSharesPolicy restricts the tokenization of delegations to a validator.
+// Existing tokenize share records are not affected by the policy.
+type TokenizeSharesPolicy struct {
+	// disabled rejects all new tokenizations of delegations to the validator
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// allowed_owners limits new tokenizations to the listed share owners, any owner
+	// is allowed when the list is empty
+	AllowedOwners []string `protobuf:"bytes,2,rep,name=allowed_owners,json=allowedOwners,proto3" json:"allowed_owners,omitempty"`
+}
+
+func (m *TokenizeSharesPolicy) Reset()         { *m = TokenizeSharesPolicy{} }
+func (m *TokenizeSharesPolicy) String() string { return proto.CompactTextString(m) }
+func (*TokenizeSharesPolicy) ProtoMessage()    {}
+func (*TokenizeSharesPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{5}
+}
+func (m *TokenizeSharesPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeSharesPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeSharesPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeSharesPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeSharesPolicy.Merge(m, src)
+}
+func (m *TokenizeSharesPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeSharesPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeSharesPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeSharesPolicy proto.InternalMessageInfo
+
+func (m *TokenizeSharesPolicy) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *TokenizeSharesPolicy) GetAllowedOwners() []string {
+	if m != nil {
+		return m.AllowedOwners
+	}
+	return nil
+}
+
 // ValAddresses defines a repeated set of validator addresses.
 type ValAddresses struct {
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
@@ -377,7 +437,7 @@ type ValAddresses struct {
 func (m *ValAddresses) Reset()      { *m = ValAddresses{} }
 func (*ValAddresses) ProtoMessage() {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{5}
+	return fileDescriptor_76a7656dabf68054, []int{6}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +484,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{6}
+	return fileDescriptor_76a7656dabf68054, []int{7}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +522,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{7}
+	return fileDescriptor_76a7656dabf68054, []int{8}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,7 +571,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{8}
+	return fileDescriptor_76a7656dabf68054, []int{9}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +609,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{9}
+	return fileDescriptor_76a7656dabf68054, []int{10}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,14 +655,14 @@ type Delegation struct {
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	// shares define the delegation shares received.
 	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
-	// has this delegation been marked as exempt.
-	Exempt bool `protobuf:"varint,4,opt,name=exempt,proto3" json:"exempt,omitempty"`
+	// has this delegation been marked as a validator self bond.
+	ValidatorBond bool `protobuf:"varint,4,opt,name=validator_bond,json=validatorBond,proto3" json:"validator_bond,omitempty"`
 }
 
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{10}
+	return fileDescriptor_76a7656dabf68054, []int{11}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +705,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{11}
+	return fileDescriptor_76a7656dabf68054, []int{12}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +749,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{12}
+	return fileDescriptor_76a7656dabf68054, []int{13}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +807,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{13}
+	return fileDescriptor_76a7656dabf68054, []int{14}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +866,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{14}
+	return fileDescriptor_76a7656dabf68054, []int{15}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,14 +909,22 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
-	// exemption_factor is required for tokenize share and undelegation check for network safety
-	ExemptionFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=exemption_factor,json=exemptionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exemption_factor" yaml:"exemption_factor"`
+	// validator_bond_factor is required as a safety check for tokenizing shares and
+	// delegations from liquid staking providers
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor" yaml:"validator_bond_factor"`
+	// global_liquid_staking_cap represents a cap on the portion of stake that
+	// comes from liquid staking providers
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// min_validator_self_bond is the minimum amount of tokens that the validator operator
+	// must have self-delegated as a validator bond before delegations to the validator
+	// can be tokenized
+	MinValidatorSelfBond github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_validator_self_bond,json=minValidatorSelfBond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_validator_self_bond" yaml:"min_validator_self_bond"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{15}
+	return fileDescriptor_76a7656dabf68054, []int{16}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +998,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{16}
+	return fileDescriptor_76a7656dabf68054, []int{17}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1053,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{17}
+	return fileDescriptor_76a7656dabf68054, []int{18}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1033,7 +1101,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{18}
+	return fileDescriptor_76a7656dabf68054, []int{19}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1155,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{19}
+	return fileDescriptor_76a7656dabf68054, []int{20}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1118,17 +1186,19 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 // TokenizeShareRecord represents a tokenized delegation
 type TokenizeShareRecord struct {
-	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
-	Validator     string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner           string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	ModuleAccount   string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	Validator       string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	SplitRewards    bool   `protobuf:"varint,5,opt,name=split_rewards,json=splitRewards,proto3" json:"split_rewards,omitempty"`
+	CompoundRewards bool   `protobuf:"varint,6,opt,name=compound_rewards,json=compoundRewards,proto3" json:"compound_rewards,omitempty"`
 }
 
 func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
 func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
 func (*TokenizeShareRecord) ProtoMessage()    {}
 func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76a7656dabf68054, []int{20}
+	return fileDescriptor_76a7656dabf68054, []int{21}
 }
 func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1185,6 +1255,117 @@ func (m *TokenizeShareRecord) GetValidator() string {
 	return ""
 }
 
+func (m *TokenizeShareRecord) GetSplitRewards() bool {
+	if m != nil {
+		return m.SplitRewards
+	}
+	return false
+}
+
+func (m *TokenizeShareRecord) GetCompoundRewards() bool {
+	if m != nil {
+		return m.CompoundRewards
+	}
+	return false
+}
+
+// ValidatorBondFactorOverride replaces the global validator bond factor param
+// for a single validator
+type ValidatorBondFactorOverride struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// validator_bond_factor applied to the validator instead of the global param,
+	// a negative factor disables the validator bond cap for the validator
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor"`
+}
+
+func (m *ValidatorBondFactorOverride) Reset()         { *m = ValidatorBondFactorOverride{} }
+func (m *ValidatorBondFactorOverride) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBondFactorOverride) ProtoMessage()    {}
+func (*ValidatorBondFactorOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{22}
+}
+func (m *ValidatorBondFactorOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBondFactorOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBondFactorOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBondFactorOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBondFactorOverride.Merge(m, src)
+}
+func (m *ValidatorBondFactorOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBondFactorOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBondFactorOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBondFactorOverride proto.InternalMessageInfo
+
+func (m *ValidatorBondFactorOverride) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// ValidatorLiquidTokensSlashed tracks the cumulative amount of tokens backing the
+// liquid shares of a validator that were burned by its slashes
+type ValidatorLiquidTokensSlashed struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// liquid_tokens_slashed is the total of the liquid tokens burned since genesis
+	LiquidTokensSlashed github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquid_tokens_slashed,json=liquidTokensSlashed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_tokens_slashed"`
+}
+
+func (m *ValidatorLiquidTokensSlashed) Reset()         { *m = ValidatorLiquidTokensSlashed{} }
+func (m *ValidatorLiquidTokensSlashed) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiquidTokensSlashed) ProtoMessage()    {}
+func (*ValidatorLiquidTokensSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{23}
+}
+func (m *ValidatorLiquidTokensSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiquidTokensSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiquidTokensSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLiquidTokensSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiquidTokensSlashed.Merge(m, src)
+}
+func (m *ValidatorLiquidTokensSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiquidTokensSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiquidTokensSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiquidTokensSlashed proto.InternalMessageInfo
+
+func (m *ValidatorLiquidTokensSlashed) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("liquidstaking.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "liquidstaking.staking.v1beta1.HistoricalInfo")
@@ -1192,6 +1373,7 @@ func init() {
 	proto.RegisterType((*Commission)(nil), "liquidstaking.staking.v1beta1.Commission")
 	proto.RegisterType((*Description)(nil), "liquidstaking.staking.v1beta1.Description")
 	proto.RegisterType((*Validator)(nil), "liquidstaking.staking.v1beta1.Validator")
+	proto.RegisterType((*TokenizeSharesPolicy)(nil), "liquidstaking.staking.v1beta1.TokenizeSharesPolicy")
 	proto.RegisterType((*ValAddresses)(nil), "liquidstaking.staking.v1beta1.ValAddresses")
 	proto.RegisterType((*DVPair)(nil), "liquidstaking.staking.v1beta1.DVPair")
 	proto.RegisterType((*DVPairs)(nil), "liquidstaking.staking.v1beta1.DVPairs")
@@ -1208,128 +1390,148 @@ func init() {
 	proto.RegisterType((*RedelegationResponse)(nil), "liquidstaking.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "liquidstaking.staking.v1beta1.Pool")
 	proto.RegisterType((*TokenizeShareRecord)(nil), "liquidstaking.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ValidatorBondFactorOverride)(nil), "liquidstaking.staking.v1beta1.ValidatorBondFactorOverride")
+	proto.RegisterType((*ValidatorLiquidTokensSlashed)(nil), "liquidstaking.staking.v1beta1.ValidatorLiquidTokensSlashed")
 }
 
 func init() { proto.RegisterFile("staking/v1beta1/staking.proto", fileDescriptor_76a7656dabf68054) }
 
 var fileDescriptor_76a7656dabf68054 = []byte{
-	// 1855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
-	0x19, 0xe7, 0x52, 0x34, 0x45, 0x7e, 0x94, 0x44, 0x69, 0xac, 0x24, 0x34, 0x11, 0x8b, 0x02, 0x81,
-	0xa4, 0x72, 0x5a, 0x51, 0x8d, 0x0a, 0xa4, 0xad, 0x51, 0xa0, 0x10, 0x45, 0xba, 0x56, 0xed, 0xd8,
-	0xec, 0xea, 0x91, 0x26, 0x3d, 0x10, 0xc3, 0xdd, 0x31, 0x35, 0x15, 0x77, 0x96, 0xd9, 0x19, 0x3a,
-	0x62, 0xd1, 0x02, 0x05, 0x8a, 0x16, 0x81, 0x4e, 0x3e, 0x15, 0xb9, 0x18, 0x30, 0xd0, 0x9e, 0x8a,
-	0x1c, 0x83, 0xfe, 0x01, 0x3d, 0x05, 0x05, 0x0a, 0xb8, 0x39, 0xf5, 0x05, 0x35, 0xb0, 0x2f, 0x41,
-	0x4f, 0x45, 0x0f, 0xbd, 0x15, 0x28, 0xe6, 0xb1, 0x0f, 0x93, 0xaa, 0x69, 0x1a, 0x2a, 0x10, 0x20,
-	0x17, 0x89, 0xf3, 0xcd, 0xf7, 0xfd, 0x76, 0xbe, 0xdf, 0xf7, 0x98, 0x07, 0x5c, 0xe6, 0x02, 0x1f,
-	0x51, 0xd6, 0xdd, 0xb8, 0xfb, 0x7a, 0x87, 0x08, 0xfc, 0xfa, 0x86, 0x19, 0xd7, 0xfa, 0x81, 0x2f,
-	0x7c, 0x74, 0xb9, 0x47, 0xdf, 0x1d, 0x50, 0x37, 0x14, 0x86, 0xff, 0x8d, 0x72, 0x79, 0xb9, 0xeb,
-	0x77, 0x7d, 0xa5, 0xb9, 0x21, 0x7f, 0x69, 0xa3, 0xf2, 0xa5, 0xae, 0xef, 0x77, 0x7b, 0x64, 0x43,
-	0x8d, 0x3a, 0x83, 0x3b, 0x1b, 0x98, 0x0d, 0xcd, 0xd4, 0xca, 0xe8, 0x94, 0x3b, 0x08, 0xb0, 0xa0,
-	0x3e, 0x33, 0xf3, 0x95, 0xd1, 0x79, 0x41, 0x3d, 0xc2, 0x05, 0xf6, 0xfa, 0x21, 0xb6, 0xe3, 0x73,
-	0xcf, 0xe7, 0x6d, 0xfd, 0x51, 0x3d, 0x08, 0xb1, 0xf5, 0x68, 0xa3, 0x83, 0x39, 0x89, 0xdc, 0x71,
-	0x7c, 0x1a, 0x62, 0xbf, 0x2c, 0x08, 0x73, 0x49, 0xe0, 0x51, 0x26, 0x36, 0xc4, 0xb0, 0x4f, 0xb8,
-	0xfe, 0xab, 0x67, 0xab, 0xf7, 0x2c, 0x58, 0xb8, 0x4e, 0xb9, 0xf0, 0x03, 0xea, 0xe0, 0xde, 0x0e,
-	0xbb, 0xe3, 0xa3, 0x37, 0x20, 0x7b, 0x48, 0xb0, 0x4b, 0x82, 0x92, 0xb5, 0x6a, 0xad, 0x15, 0x36,
-	0x4b, 0xb5, 0x18, 0xa1, 0xa6, 0x6d, 0xaf, 0xab, 0xf9, 0x7a, 0xe6, 0xe3, 0xd3, 0x4a, 0xca, 0x36,
-	0xda, 0xe8, 0x1a, 0x64, 0xef, 0xe2, 0x1e, 0x27, 0xa2, 0x94, 0x5e, 0x9d, 0x59, 0x2b, 0x6c, 0xae,
-	0xd5, 0x9e, 0xca, 0x62, 0xed, 0x00, 0xf7, 0xa8, 0x8b, 0x85, 0x1f, 0xe1, 0x68, 0xeb, 0xea, 0x87,
-	0x69, 0x28, 0x6e, 0xfb, 0x9e, 0x47, 0x39, 0xa7, 0x3e, 0xb3, 0xb1, 0x20, 0x1c, 0xb5, 0x20, 0x13,
-	0x60, 0x41, 0xd4, 0x8a, 0xf2, 0xf5, 0x6f, 0x49, 0xfd, 0xbf, 0x9c, 0x56, 0x5e, 0xed, 0x52, 0x71,
-	0x38, 0xe8, 0xd4, 0x1c, 0xdf, 0x33, 0x9c, 0x98, 0x7f, 0xeb, 0xdc, 0x3d, 0x32, 0x6e, 0x36, 0x88,
-	0xf3, 0xc9, 0x47, 0xeb, 0x60, 0x28, 0x6b, 0x10, 0xc7, 0x56, 0x48, 0xe8, 0x2d, 0xc8, 0x79, 0xf8,
-	0xb8, 0xad, 0x50, 0xd3, 0xe7, 0x80, 0x3a, 0xeb, 0xe1, 0x63, 0xb9, 0x56, 0xe4, 0x42, 0x51, 0x02,
-	0x3b, 0x87, 0x98, 0x75, 0x89, 0xc6, 0x9f, 0x39, 0x07, 0xfc, 0x79, 0x0f, 0x1f, 0x6f, 0x2b, 0x4c,
-	0xf9, 0x95, 0xab, 0xb9, 0x0f, 0x1e, 0x54, 0x52, 0x9f, 0x3d, 0xa8, 0x58, 0xd5, 0xdf, 0x59, 0x00,
-	0x31, 0x5d, 0xc8, 0x81, 0x45, 0x27, 0x1a, 0xa9, 0xcf, 0x73, 0x13, 0xc7, 0xda, 0x84, 0x78, 0x8c,
-	0x70, 0x5e, 0xcf, 0xc9, 0xf5, 0x3e, 0x3c, 0xad, 0x58, 0x76, 0xd1, 0x19, 0x09, 0x47, 0x13, 0x0a,
-	0x83, 0xbe, 0x8b, 0x05, 0x69, 0xcb, 0x44, 0x55, 0xfc, 0x15, 0x36, 0xcb, 0x35, 0x9d, 0xc5, 0xb5,
-	0x30, 0x8b, 0x6b, 0x7b, 0x61, 0x16, 0x6b, 0xac, 0x7b, 0x7f, 0xaf, 0x58, 0x36, 0x68, 0x43, 0x39,
-	0x95, 0x70, 0xe2, 0x43, 0x0b, 0x0a, 0x0d, 0xc2, 0x9d, 0x80, 0xf6, 0x65, 0x59, 0xa0, 0x12, 0xcc,
-	0x7a, 0x3e, 0xa3, 0x47, 0x26, 0x09, 0xf3, 0x76, 0x38, 0x44, 0x65, 0xc8, 0x51, 0x97, 0x30, 0x41,
-	0xc5, 0x50, 0xc7, 0xcd, 0x8e, 0xc6, 0xd2, 0xea, 0x3d, 0xd2, 0xe1, 0x34, 0xa4, 0xdc, 0x0e, 0x87,
-	0xe8, 0x0a, 0x2c, 0x72, 0xe2, 0x0c, 0x02, 0x2a, 0x86, 0x6d, 0xc7, 0x67, 0x02, 0x3b, 0xa2, 0x94,
-	0x51, 0x2a, 0xc5, 0x50, 0xbe, 0xad, 0xc5, 0x12, 0xc4, 0x25, 0x02, 0xd3, 0x1e, 0x2f, 0x5d, 0xd0,
-	0x20, 0x66, 0x98, 0x58, 0xee, 0xbf, 0x67, 0x21, 0x1f, 0xa5, 0x2f, 0xda, 0x86, 0x45, 0xbf, 0x4f,
-	0x02, 0xf9, 0xbb, 0x8d, 0x5d, 0x37, 0x20, 0x9c, 0x9b, 0x44, 0x2d, 0x7d, 0xf2, 0xd1, 0xfa, 0xb2,
-	0x09, 0xe2, 0x96, 0x9e, 0xd9, 0x15, 0x01, 0x65, 0x5d, 0xbb, 0x18, 0x5a, 0x18, 0x31, 0x7a, 0x5b,
-	0xc6, 0x8d, 0x71, 0xc2, 0xf8, 0x80, 0xb7, 0xfb, 0x83, 0xce, 0x11, 0x19, 0x1a, 0x5e, 0x97, 0xc7,
-	0x78, 0xdd, 0x62, 0xc3, 0x7a, 0xe9, 0xf7, 0x31, 0xb4, 0x13, 0x0c, 0xfb, 0xc2, 0xaf, 0xb5, 0x06,
-	0x9d, 0x1b, 0x64, 0x28, 0xa3, 0x65, 0x70, 0x5a, 0x0a, 0x06, 0xbd, 0x08, 0xd9, 0x1f, 0x62, 0xda,
-	0x23, 0xae, 0x62, 0x25, 0x67, 0x9b, 0x11, 0xda, 0x82, 0x2c, 0x17, 0x58, 0x0c, 0xb8, 0xa2, 0x62,
-	0x61, 0xf3, 0xca, 0x84, 0x04, 0xa9, 0xfb, 0xcc, 0xdd, 0x55, 0x06, 0xb6, 0x31, 0x44, 0x7b, 0x90,
-	0x15, 0xfe, 0x11, 0x61, 0x86, 0xab, 0xa9, 0x72, 0x7c, 0x87, 0x89, 0x44, 0x8e, 0xef, 0x30, 0x61,
-	0x1b, 0x2c, 0xd4, 0x85, 0x45, 0x97, 0xf4, 0x48, 0x57, 0x31, 0xca, 0x0f, 0x71, 0x40, 0x78, 0x29,
-	0x7b, 0x0e, 0x35, 0x54, 0x8c, 0x50, 0x77, 0x15, 0x28, 0xb2, 0xa1, 0xe0, 0xc6, 0x59, 0x57, 0x9a,
-	0x55, 0x7c, 0xbf, 0x36, 0x81, 0x86, 0x44, 0x9e, 0x9a, 0xce, 0x95, 0x04, 0x91, 0xa9, 0x36, 0x60,
-	0x1d, 0x9f, 0xb9, 0x94, 0x75, 0xdb, 0x87, 0x84, 0x76, 0x0f, 0x45, 0x29, 0xb7, 0x6a, 0xad, 0xcd,
-	0xd8, 0xc5, 0x48, 0x7e, 0x5d, 0x89, 0xd1, 0x0d, 0x58, 0x88, 0x55, 0x55, 0x25, 0xe5, 0xa7, 0xa8,
-	0xa4, 0xf9, 0xc8, 0x56, 0xce, 0xa2, 0xdb, 0x00, 0x71, 0x99, 0x96, 0x40, 0x01, 0x5d, 0x79, 0xe6,
-	0x92, 0x37, 0x9e, 0x24, 0x20, 0xd0, 0x8f, 0xe1, 0xa2, 0xf0, 0x05, 0xee, 0xb5, 0xc9, 0x31, 0xf1,
-	0xfa, 0x22, 0x0c, 0x44, 0x41, 0x05, 0xe2, 0xe6, 0x74, 0x81, 0xf8, 0xd7, 0x69, 0xa5, 0x3c, 0xc4,
-	0x5e, 0xef, 0x6a, 0xf5, 0x0c, 0xc8, 0xaa, 0xbd, 0xa4, 0xa4, 0x4d, 0x25, 0x34, 0xa1, 0xf9, 0xb9,
-	0x05, 0x2f, 0x6a, 0x5d, 0x95, 0x14, 0xf4, 0x47, 0xc4, 0x0d, 0x57, 0x30, 0xa7, 0x56, 0x70, 0x7b,
-	0xea, 0x15, 0x5c, 0x4e, 0xae, 0x60, 0x14, 0xb5, 0x6a, 0x2f, 0xab, 0x89, 0xbd, 0x50, 0xae, 0xd7,
-	0x71, 0x75, 0xee, 0xfd, 0x07, 0x95, 0x94, 0x29, 0xfc, 0x54, 0xb5, 0x05, 0x73, 0x07, 0xb8, 0x67,
-	0x6a, 0x96, 0x70, 0xf4, 0x06, 0xe4, 0x71, 0x38, 0x28, 0x59, 0xab, 0x33, 0x4f, 0xad, 0xf9, 0x58,
-	0x55, 0xb7, 0x92, 0x9f, 0xfe, 0x6d, 0xd5, 0xaa, 0xfe, 0xda, 0x82, 0x6c, 0xe3, 0xa0, 0x85, 0x69,
-	0x80, 0x9a, 0xb0, 0x14, 0xa7, 0xfd, 0xb3, 0x36, 0x92, 0xb8, 0x52, 0xc2, 0x4e, 0xd2, 0x84, 0xa5,
-	0xbb, 0x61, 0x6f, 0x8a, 0x60, 0xd2, 0x93, 0x60, 0x22, 0x13, 0x23, 0x1f, 0x71, 0xfc, 0x26, 0xcc,
-	0xea, 0x55, 0x72, 0xb4, 0x05, 0x17, 0xfa, 0xf2, 0x87, 0xf2, 0xb7, 0xb0, 0xf9, 0xca, 0xa4, 0x72,
-	0x51, 0x66, 0x26, 0xbf, 0xb4, 0x65, 0xf5, 0x3f, 0x16, 0x40, 0xe3, 0xe0, 0x60, 0x2f, 0xa0, 0xfd,
-	0x1e, 0x11, 0xe7, 0xe5, 0xf8, 0x4d, 0x78, 0x21, 0x76, 0x9c, 0x07, 0xce, 0x33, 0x3b, 0x7f, 0x31,
-	0x32, 0xdb, 0x0d, 0x9c, 0x33, 0xd1, 0x5c, 0x2e, 0x22, 0xb4, 0x99, 0x67, 0x46, 0x6b, 0x70, 0x71,
-	0x36, 0x9b, 0xef, 0x40, 0x21, 0x76, 0x9f, 0xa3, 0x1b, 0x90, 0x13, 0xe6, 0xb7, 0x21, 0xf5, 0xca,
-	0x44, 0x52, 0x43, 0x6b, 0x43, 0x6c, 0x04, 0x50, 0xfd, 0x65, 0x1a, 0xa0, 0xa1, 0xa9, 0x91, 0x55,
-	0xfc, 0xb9, 0x4a, 0x2a, 0xb9, 0x5f, 0x98, 0x22, 0x3e, 0x8f, 0x33, 0x91, 0xc1, 0x92, 0x1b, 0x9c,
-	0x6e, 0x28, 0x6a, 0x23, 0xcb, 0xd9, 0x66, 0x34, 0x42, 0xfa, 0x49, 0x1a, 0x2e, 0xee, 0x87, 0x2d,
-	0xf3, 0x73, 0xcb, 0xd0, 0x5b, 0x30, 0x4b, 0x98, 0x08, 0xa8, 0xa2, 0x48, 0xa6, 0xc2, 0xd7, 0x27,
-	0xa4, 0xc2, 0x19, 0x2e, 0x35, 0x99, 0x08, 0x86, 0x26, 0x31, 0x42, 0xb4, 0x11, 0x32, 0xfe, 0x9a,
-	0x86, 0xd2, 0xff, 0xb2, 0x44, 0x5f, 0x82, 0xa2, 0x13, 0x10, 0x25, 0x08, 0x77, 0x30, 0x4b, 0xed,
-	0x60, 0x0b, 0xa1, 0xd8, 0x6c, 0x60, 0x6f, 0x82, 0x3c, 0x1a, 0xca, 0xbc, 0x93, 0xaa, 0x53, 0x9f,
-	0x05, 0x17, 0x62, 0x63, 0xb5, 0x85, 0x11, 0x28, 0x52, 0x46, 0x05, 0xc5, 0xbd, 0x76, 0x07, 0xf7,
-	0x30, 0x73, 0x9e, 0xe7, 0xe8, 0x3c, 0x7e, 0xac, 0x58, 0x30, 0xa0, 0x75, 0x8d, 0x89, 0x0e, 0x60,
-	0x36, 0x84, 0xcf, 0x9c, 0x03, 0x7c, 0x08, 0x96, 0x38, 0x1f, 0xfe, 0x39, 0x0d, 0x4b, 0x36, 0x71,
-	0xbf, 0x58, 0xb4, 0xfe, 0x00, 0x40, 0xd7, 0xa3, 0xec, 0x96, 0xcf, 0xc1, 0xec, 0x78, 0x7d, 0xe7,
-	0x35, 0x5e, 0x83, 0x8b, 0x04, 0xb7, 0x7f, 0x4c, 0xc3, 0x5c, 0x92, 0xdb, 0x2f, 0xc0, 0xee, 0x81,
-	0x5a, 0x71, 0x53, 0xc8, 0xa8, 0xa6, 0xf0, 0xd5, 0x09, 0x4d, 0x61, 0x2c, 0xf9, 0x9e, 0xde, 0x0d,
-	0x3e, 0x9b, 0x81, 0x6c, 0x0b, 0x07, 0xd8, 0xe3, 0xe8, 0xbb, 0x63, 0x67, 0x52, 0x7d, 0x7b, 0xbc,
-	0x34, 0x96, 0x7a, 0x0d, 0xf3, 0x86, 0xa1, 0x33, 0xef, 0x83, 0x33, 0x8e, 0xa4, 0xaf, 0xc0, 0x82,
-	0xbc, 0x0a, 0x47, 0x1e, 0x69, 0x2e, 0xe7, 0xd5, 0x5d, 0x36, 0xba, 0x3e, 0x71, 0x54, 0x81, 0x82,
-	0x54, 0x8b, 0xdb, 0x9e, 0xd4, 0x01, 0x0f, 0x1f, 0x37, 0xb5, 0x04, 0xad, 0x03, 0x3a, 0x8c, 0xde,
-	0x28, 0xda, 0x31, 0x13, 0x52, 0x6f, 0x29, 0x9e, 0x09, 0xd5, 0x2f, 0x03, 0xc8, 0x55, 0xb4, 0x5d,
-	0xc2, 0x7c, 0xcf, 0x5c, 0xe2, 0xf2, 0x52, 0xd2, 0x90, 0x02, 0x79, 0xae, 0xf5, 0x28, 0x6b, 0x8f,
-	0xdc, 0x92, 0xcd, 0x05, 0xe3, 0xb9, 0xcf, 0xb5, 0x67, 0x40, 0x56, 0xed, 0x25, 0x8f, 0xb2, 0x27,
-	0xaf, 0xd5, 0x48, 0xc0, 0xa2, 0xde, 0x9d, 0xa4, 0xd6, 0x1d, 0xec, 0x08, 0x3f, 0x50, 0xf7, 0x8e,
-	0x7c, 0x7d, 0x67, 0xea, 0x4f, 0xbf, 0xa4, 0x3f, 0x3d, 0x8a, 0x57, 0xb5, 0x8b, 0x91, 0xe8, 0x9a,
-	0x92, 0x24, 0xca, 0xe7, 0x37, 0x16, 0xa0, 0xb8, 0xdf, 0xdb, 0x84, 0xf7, 0xe5, 0x6d, 0x51, 0xde,
-	0x1e, 0xe2, 0x8c, 0x31, 0x21, 0x9f, 0x78, 0x08, 0x89, 0x0c, 0xc2, 0xdb, 0x43, 0xa2, 0x2a, 0xbf,
-	0x19, 0x37, 0xd9, 0xb4, 0x49, 0x20, 0x93, 0xef, 0x1d, 0xcc, 0x49, 0xe2, 0x06, 0x42, 0x43, 0xeb,
-	0xb1, 0x3e, 0x9a, 0xaa, 0x7e, 0x6a, 0xc1, 0xa5, 0xb1, 0x54, 0x8e, 0xd6, 0x4c, 0x00, 0x05, 0x89,
-	0x49, 0x95, 0x18, 0x43, 0xb3, 0xf6, 0xe7, 0x2d, 0x90, 0xa5, 0x60, 0xac, 0x6d, 0xff, 0xbf, 0xb6,
-	0x8b, 0x8c, 0x8a, 0xc7, 0x1f, 0x2c, 0x58, 0x4e, 0x2e, 0x26, 0xf2, 0x6e, 0x1f, 0xe6, 0x92, 0x6b,
-	0x31, 0x7e, 0x7d, 0x79, 0x0a, 0xbf, 0x8c, 0x4b, 0x4f, 0xc0, 0xa0, 0xef, 0xc7, 0xad, 0x44, 0x3f,
-	0xd3, 0x7d, 0x63, 0x5a, 0xa6, 0xc2, 0x15, 0x8e, 0xb6, 0x94, 0x8c, 0x0a, 0xd9, 0xcf, 0xd2, 0x90,
-	0x69, 0xf9, 0x7e, 0x0f, 0xfd, 0x04, 0x96, 0x98, 0x2f, 0xda, 0xb2, 0xee, 0x88, 0xdb, 0x36, 0xaf,
-	0x04, 0xba, 0x2d, 0x7f, 0x6f, 0x3a, 0x02, 0xff, 0x71, 0x5a, 0x19, 0x87, 0x1a, 0x61, 0xb5, 0xc8,
-	0x7c, 0x51, 0x57, 0xf3, 0x7b, 0xfa, 0x0d, 0x21, 0x80, 0xf9, 0x27, 0x3f, 0xad, 0xdb, 0xf8, 0x9b,
-	0x53, 0x7f, 0x7a, 0xfe, 0x69, 0x9f, 0x9d, 0xeb, 0x24, 0xbe, 0x79, 0x35, 0x27, 0x23, 0xfa, 0x4f,
-	0x19, 0xd5, 0x5f, 0x58, 0x70, 0x31, 0xbc, 0x49, 0xaa, 0x8b, 0xa4, 0x4d, 0x1c, 0x3f, 0x70, 0xd1,
-	0x02, 0xa4, 0xa9, 0xab, 0x58, 0xc8, 0xd8, 0x69, 0xea, 0xa2, 0x65, 0xb8, 0xe0, 0xbf, 0xc7, 0x48,
-	0x60, 0x9e, 0xb2, 0xf4, 0x40, 0xf5, 0x4d, 0xdf, 0x1d, 0xf4, 0x48, 0x1b, 0x3b, 0x8e, 0x3f, 0x60,
-	0xc2, 0x3c, 0x67, 0xcd, 0x6b, 0xe9, 0x96, 0x16, 0xa2, 0x97, 0x21, 0x1f, 0xb5, 0x56, 0xf3, 0x9a,
-	0x15, 0x0b, 0x74, 0x7a, 0xbd, 0xf6, 0x5b, 0x0b, 0x20, 0x7e, 0xb7, 0x41, 0x5f, 0x81, 0x97, 0xea,
-	0xb7, 0x6f, 0x35, 0xda, 0xbb, 0x7b, 0x5b, 0x7b, 0xfb, 0xbb, 0xed, 0xfd, 0x5b, 0xbb, 0xad, 0xe6,
-	0xf6, 0xce, 0xb5, 0x9d, 0x66, 0x63, 0x31, 0x55, 0x2e, 0x9e, 0xdc, 0x5f, 0x2d, 0xec, 0x33, 0xde,
-	0x27, 0x0e, 0xbd, 0x43, 0x89, 0x8b, 0x5e, 0x85, 0xe5, 0x27, 0xb5, 0xe5, 0xa8, 0xd9, 0x58, 0xb4,
-	0xca, 0x73, 0x27, 0xf7, 0x57, 0x73, 0xfa, 0xfc, 0x48, 0x5c, 0xb4, 0x06, 0x2f, 0x8c, 0xeb, 0xed,
-	0xdc, 0xfa, 0xce, 0x62, 0xba, 0x3c, 0x7f, 0x72, 0x7f, 0x35, 0x1f, 0x1d, 0x34, 0x51, 0x15, 0x50,
-	0x52, 0xd3, 0xe0, 0xcd, 0x94, 0xe1, 0xe4, 0xfe, 0x6a, 0x56, 0xc7, 0xaf, 0x9c, 0x79, 0xff, 0x57,
-	0x2b, 0xa9, 0xfa, 0xdb, 0x1f, 0x3f, 0x5a, 0xb1, 0x1e, 0x3e, 0x5a, 0xb1, 0x3e, 0x7d, 0xb4, 0x62,
-	0xdd, 0x7b, 0xbc, 0x92, 0x7a, 0xf8, 0x78, 0x25, 0xf5, 0xa7, 0xc7, 0x2b, 0xa9, 0x77, 0xbe, 0x9d,
-	0x08, 0x1d, 0x7d, 0xb7, 0x37, 0x90, 0xad, 0x95, 0x32, 0x67, 0x43, 0xa7, 0x31, 0x15, 0xc3, 0x75,
-	0x93, 0xc2, 0xeb, 0x9a, 0xae, 0x8d, 0xe3, 0xf0, 0x75, 0x5f, 0xc7, 0xb5, 0x93, 0x55, 0x5b, 0xd8,
-	0xd7, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x9b, 0x65, 0x4d, 0x05, 0x18, 0x00, 0x00,
+	// 2135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xdd, 0x8f, 0x5b, 0x47,
+	0x15, 0xdf, 0xeb, 0x38, 0x5e, 0xfb, 0x78, 0xbd, 0xde, 0x9d, 0x6c, 0x53, 0xc7, 0x4d, 0xd6, 0x2b,
+	0xa3, 0x94, 0xa4, 0xb0, 0x5e, 0x9a, 0x4a, 0x05, 0x22, 0xa4, 0x6a, 0xbd, 0xde, 0x90, 0x25, 0x69,
+	0x62, 0xee, 0x6e, 0xb6, 0xb4, 0x48, 0x5c, 0x8d, 0xef, 0x9d, 0xf5, 0x0e, 0xb9, 0xbe, 0xd7, 0xbd,
+	0x33, 0x4e, 0x62, 0x04, 0x12, 0x82, 0x97, 0x2a, 0x12, 0x52, 0x24, 0x84, 0xe8, 0x4b, 0x44, 0x24,
+	0xe0, 0x05, 0xf5, 0xb1, 0xe2, 0x0f, 0xe0, 0xa9, 0x42, 0x42, 0x0a, 0x7d, 0x40, 0x7c, 0x94, 0xa5,
+	0x4a, 0x5e, 0x10, 0x4f, 0x28, 0xef, 0x48, 0x68, 0x3e, 0xee, 0xc7, 0xda, 0xce, 0x3a, 0x2e, 0x46,
+	0xaa, 0xd4, 0x97, 0xdd, 0x3b, 0x67, 0xce, 0xf9, 0xcd, 0x39, 0x67, 0xce, 0x9c, 0x33, 0x67, 0x0c,
+	0x67, 0x18, 0xc7, 0x37, 0xa9, 0xd7, 0x5e, 0xbb, 0xf5, 0x72, 0x8b, 0x70, 0xfc, 0xf2, 0x9a, 0x1e,
+	0xd7, 0xba, 0x81, 0xcf, 0x7d, 0x74, 0xc6, 0xa5, 0x6f, 0xf7, 0xa8, 0x13, 0x12, 0xc3, 0xff, 0x9a,
+	0xb9, 0xbc, 0xd4, 0xf6, 0xdb, 0xbe, 0xe4, 0x5c, 0x13, 0x5f, 0x4a, 0xa8, 0x7c, 0xaa, 0xed, 0xfb,
+	0x6d, 0x97, 0xac, 0xc9, 0x51, 0xab, 0xb7, 0xb7, 0x86, 0xbd, 0xbe, 0x9e, 0x5a, 0x1e, 0x9c, 0x72,
+	0x7a, 0x01, 0xe6, 0xd4, 0xf7, 0xf4, 0x7c, 0x65, 0x70, 0x9e, 0xd3, 0x0e, 0x61, 0x1c, 0x77, 0xba,
+	0x21, 0xb6, 0xed, 0xb3, 0x8e, 0xcf, 0x2c, 0xb5, 0xa8, 0x1a, 0x84, 0xd8, 0x6a, 0xb4, 0xd6, 0xc2,
+	0x8c, 0x44, 0xe6, 0xd8, 0x3e, 0x0d, 0xb1, 0x4f, 0x73, 0xe2, 0x39, 0x24, 0xe8, 0x50, 0x8f, 0xaf,
+	0xf1, 0x7e, 0x97, 0x30, 0xf5, 0x57, 0xcd, 0x56, 0xef, 0x19, 0x30, 0x7f, 0x99, 0x32, 0xee, 0x07,
+	0xd4, 0xc6, 0xee, 0x96, 0xb7, 0xe7, 0xa3, 0x57, 0x21, 0xb3, 0x4f, 0xb0, 0x43, 0x82, 0x92, 0xb1,
+	0x62, 0x9c, 0xcb, 0x5f, 0x28, 0xd5, 0x62, 0x84, 0x9a, 0x92, 0xbd, 0x2c, 0xe7, 0xeb, 0xe9, 0x0f,
+	0x0e, 0x2a, 0x33, 0xa6, 0xe6, 0x46, 0x97, 0x20, 0x73, 0x0b, 0xbb, 0x8c, 0xf0, 0x52, 0x6a, 0xe5,
+	0xd8, 0xb9, 0xfc, 0x85, 0x73, 0xb5, 0x23, 0xbd, 0x58, 0xdb, 0xc5, 0x2e, 0x75, 0x30, 0xf7, 0x23,
+	0x1c, 0x25, 0x5d, 0x7d, 0x2f, 0x05, 0xc5, 0x0d, 0xbf, 0xd3, 0xa1, 0x8c, 0x51, 0xdf, 0x33, 0x31,
+	0x27, 0x0c, 0x35, 0x21, 0x1d, 0x60, 0x4e, 0xa4, 0x46, 0xb9, 0xfa, 0xd7, 0x04, 0xff, 0x5f, 0x0f,
+	0x2a, 0x2f, 0xb6, 0x29, 0xdf, 0xef, 0xb5, 0x6a, 0xb6, 0xdf, 0xd1, 0x3e, 0xd1, 0xff, 0x56, 0x99,
+	0x73, 0x53, 0x9b, 0xd9, 0x20, 0xf6, 0x87, 0xef, 0xaf, 0x82, 0x76, 0x59, 0x83, 0xd8, 0xa6, 0x44,
+	0x42, 0x6f, 0x40, 0xb6, 0x83, 0xef, 0x58, 0x12, 0x35, 0x35, 0x05, 0xd4, 0xd9, 0x0e, 0xbe, 0x23,
+	0x74, 0x45, 0x0e, 0x14, 0x05, 0xb0, 0xbd, 0x8f, 0xbd, 0x36, 0x51, 0xf8, 0xc7, 0xa6, 0x80, 0x5f,
+	0xe8, 0xe0, 0x3b, 0x1b, 0x12, 0x53, 0xac, 0x72, 0x31, 0xfb, 0xee, 0x83, 0xca, 0xcc, 0x3f, 0x1f,
+	0x54, 0x8c, 0xea, 0xef, 0x0c, 0x80, 0xd8, 0x5d, 0xc8, 0x86, 0x05, 0x3b, 0x1a, 0xc9, 0xe5, 0x99,
+	0xde, 0xc7, 0xda, 0x98, 0xfd, 0x18, 0xf0, 0x79, 0x3d, 0x2b, 0xf4, 0x7d, 0x78, 0x50, 0x31, 0xcc,
+	0xa2, 0x3d, 0xb0, 0x1d, 0x9b, 0x90, 0xef, 0x75, 0x1d, 0xcc, 0x89, 0x25, 0x02, 0x55, 0xfa, 0x2f,
+	0x7f, 0xa1, 0x5c, 0x53, 0x51, 0x5c, 0x0b, 0xa3, 0xb8, 0xb6, 0x13, 0x46, 0xb1, 0xc2, 0xba, 0xf7,
+	0x8f, 0x8a, 0x61, 0x82, 0x12, 0x14, 0x53, 0x09, 0x23, 0xde, 0x33, 0x20, 0xdf, 0x20, 0xcc, 0x0e,
+	0x68, 0x57, 0x1c, 0x0b, 0x54, 0x82, 0xd9, 0x8e, 0xef, 0xd1, 0x9b, 0x3a, 0x08, 0x73, 0x66, 0x38,
+	0x44, 0x65, 0xc8, 0x52, 0x87, 0x78, 0x9c, 0xf2, 0xbe, 0xda, 0x37, 0x33, 0x1a, 0x0b, 0xa9, 0xdb,
+	0xa4, 0xc5, 0x68, 0xe8, 0x72, 0x33, 0x1c, 0xa2, 0xf3, 0xb0, 0xc0, 0x88, 0xdd, 0x0b, 0x28, 0xef,
+	0x5b, 0xb6, 0xef, 0x71, 0x6c, 0xf3, 0x52, 0x5a, 0xb2, 0x14, 0x43, 0xfa, 0x86, 0x22, 0x0b, 0x10,
+	0x87, 0x70, 0x4c, 0x5d, 0x56, 0x3a, 0xae, 0x40, 0xf4, 0x30, 0xa1, 0xee, 0xcf, 0x73, 0x90, 0x8b,
+	0xc2, 0x17, 0x6d, 0xc0, 0x82, 0xdf, 0x25, 0x81, 0xf8, 0xb6, 0xb0, 0xe3, 0x04, 0x84, 0x31, 0x1d,
+	0xa8, 0xa5, 0x0f, 0xdf, 0x5f, 0x5d, 0xd2, 0x9b, 0xb8, 0xae, 0x66, 0xb6, 0x79, 0x40, 0xbd, 0xb6,
+	0x59, 0x0c, 0x25, 0x34, 0x19, 0xbd, 0x29, 0xf6, 0xcd, 0x63, 0xc4, 0x63, 0x3d, 0x66, 0x75, 0x7b,
+	0xad, 0x9b, 0xa4, 0xaf, 0xfd, 0xba, 0x34, 0xe4, 0xd7, 0x75, 0xaf, 0x5f, 0x2f, 0xfd, 0x3e, 0x86,
+	0xb6, 0x83, 0x7e, 0x97, 0xfb, 0xb5, 0x66, 0xaf, 0x75, 0x85, 0xf4, 0xcd, 0x62, 0x84, 0xd3, 0x94,
+	0x30, 0xe8, 0x24, 0x64, 0xbe, 0x8b, 0xa9, 0x4b, 0x1c, 0xe9, 0x95, 0xac, 0xa9, 0x47, 0x68, 0x1d,
+	0x32, 0x8c, 0x63, 0xde, 0x63, 0xd2, 0x15, 0xf3, 0x17, 0xce, 0x8f, 0x09, 0x90, 0xba, 0xef, 0x39,
+	0xdb, 0x52, 0xc0, 0xd4, 0x82, 0x68, 0x07, 0x32, 0xdc, 0xbf, 0x49, 0x3c, 0xed, 0xab, 0x89, 0x62,
+	0x7c, 0xcb, 0xe3, 0x89, 0x18, 0xdf, 0xf2, 0xb8, 0xa9, 0xb1, 0x50, 0x1b, 0x16, 0x1c, 0xe2, 0x92,
+	0xb6, 0xf4, 0x28, 0xdb, 0xc7, 0x01, 0x61, 0xa5, 0xcc, 0x14, 0xce, 0x50, 0x31, 0x42, 0xdd, 0x96,
+	0xa0, 0xc8, 0x84, 0xbc, 0x13, 0x47, 0x5d, 0x69, 0x56, 0xfa, 0xfb, 0xa5, 0x31, 0x6e, 0x48, 0xc4,
+	0xa9, 0xce, 0x5c, 0x49, 0x10, 0x11, 0x6a, 0x3d, 0xaf, 0xe5, 0x7b, 0x0e, 0xf5, 0xda, 0xd6, 0x3e,
+	0xa1, 0xed, 0x7d, 0x5e, 0xca, 0xae, 0x18, 0xe7, 0x8e, 0x99, 0xc5, 0x88, 0x7e, 0x59, 0x92, 0xd1,
+	0x15, 0x98, 0x8f, 0x59, 0xe5, 0x49, 0xca, 0x4d, 0x70, 0x92, 0x0a, 0x91, 0xac, 0x98, 0x45, 0xd7,
+	0x01, 0xe2, 0x63, 0x5a, 0x02, 0x09, 0x74, 0xfe, 0x99, 0x8f, 0xbc, 0xb6, 0x24, 0x01, 0x81, 0x7e,
+	0x6a, 0xc0, 0x0b, 0xdc, 0xe7, 0xd8, 0xb5, 0x6e, 0x85, 0xa1, 0x6e, 0x89, 0x05, 0xc3, 0x1d, 0xc9,
+	0xcb, 0x1d, 0xd9, 0x99, 0x6c, 0x47, 0x9e, 0x1c, 0x54, 0xaa, 0x7d, 0xdc, 0x71, 0x2f, 0x56, 0x8f,
+	0x80, 0xae, 0x9a, 0x25, 0x39, 0x1b, 0x57, 0x08, 0x11, 0x79, 0x6a, 0xcb, 0xbe, 0x0f, 0x27, 0x94,
+	0xa4, 0xb2, 0x2c, 0x54, 0x66, 0x4e, 0x2a, 0x73, 0x75, 0x62, 0x65, 0xca, 0x49, 0x65, 0x0e, 0x41,
+	0x56, 0xcd, 0x45, 0x49, 0xbd, 0x2a, 0x89, 0x7a, 0xf5, 0x7b, 0x06, 0x9c, 0x94, 0x41, 0x4a, 0xbf,
+	0x47, 0x34, 0x9f, 0xd5, 0xf5, 0x5d, 0x6a, 0xf7, 0x4b, 0x05, 0xe9, 0xf1, 0x57, 0xc6, 0x78, 0x7c,
+	0x47, 0x0b, 0x2b, 0xbc, 0xa6, 0x14, 0xad, 0x9f, 0x15, 0x6a, 0x3f, 0x39, 0xa8, 0x9c, 0x09, 0x95,
+	0x19, 0xb5, 0x40, 0xd5, 0x5c, 0xe2, 0x23, 0x84, 0x2f, 0xce, 0xbd, 0xf3, 0xa0, 0x32, 0xa3, 0x33,
+	0xd3, 0x4c, 0xb5, 0x0f, 0x4b, 0xa3, 0x96, 0x10, 0x69, 0xd3, 0xa1, 0x0c, 0xb7, 0x44, 0x16, 0x30,
+	0x64, 0x16, 0x88, 0xc6, 0xe8, 0x35, 0x98, 0xc7, 0xae, 0xeb, 0xdf, 0x26, 0x8e, 0xe5, 0xdf, 0xf6,
+	0x48, 0xc0, 0x64, 0x01, 0x3f, 0x2a, 0x7b, 0x15, 0x34, 0xff, 0x75, 0xc9, 0x7e, 0x31, 0x2d, 0x93,
+	0x62, 0x13, 0xe6, 0x76, 0xb1, 0xab, 0x19, 0x09, 0x43, 0xaf, 0x42, 0x0e, 0x87, 0x83, 0x92, 0x31,
+	0x06, 0x31, 0x66, 0x55, 0x69, 0xf6, 0x87, 0x1f, 0xad, 0x18, 0xd5, 0x5f, 0x19, 0x90, 0x69, 0xec,
+	0x36, 0x31, 0x0d, 0xd0, 0x26, 0x2c, 0xc6, 0x29, 0xe1, 0x59, 0x93, 0x6c, 0x9c, 0x45, 0x34, 0x5d,
+	0xc0, 0xc4, 0x11, 0x17, 0xc2, 0xa4, 0xc6, 0xc1, 0x44, 0x22, 0x9a, 0x3e, 0xe0, 0xf3, 0xab, 0x30,
+	0xab, 0xb4, 0x64, 0x68, 0x1d, 0x8e, 0x77, 0xc5, 0x87, 0xb4, 0x37, 0x7f, 0xe1, 0xec, 0xb8, 0x54,
+	0x22, 0xc5, 0xf4, 0xd9, 0x53, 0x92, 0xd5, 0xff, 0x18, 0x00, 0x8d, 0xdd, 0xdd, 0x9d, 0x80, 0x76,
+	0x5d, 0xc2, 0xa7, 0x65, 0xf8, 0x55, 0x78, 0x2e, 0x36, 0x9c, 0x05, 0xf6, 0x33, 0x1b, 0x7f, 0x22,
+	0x12, 0xdb, 0x0e, 0xec, 0x91, 0x68, 0x0e, 0xe3, 0x11, 0xda, 0xb1, 0x67, 0x46, 0x6b, 0x30, 0x3e,
+	0xda, 0x9b, 0x6f, 0x41, 0x3e, 0x36, 0x9f, 0xa1, 0x2b, 0x90, 0xe5, 0xfa, 0x5b, 0x3b, 0xf5, 0xfc,
+	0x58, 0xa7, 0x86, 0xd2, 0xda, 0xb1, 0x11, 0x40, 0xf5, 0xd7, 0x29, 0x80, 0x86, 0x72, 0x8d, 0xc8,
+	0x70, 0x9f, 0xaa, 0xa0, 0x12, 0xb5, 0x54, 0x27, 0xb3, 0x69, 0xdc, 0x17, 0x35, 0x16, 0x3a, 0x0b,
+	0xf3, 0x87, 0x73, 0xac, 0x2c, 0xf6, 0x59, 0xb3, 0x70, 0x2b, 0x99, 0x5c, 0x07, 0xf6, 0xe0, 0x6e,
+	0x0a, 0x4e, 0xdc, 0x08, 0xab, 0xcb, 0xa7, 0xd6, 0x61, 0x6f, 0xc0, 0x2c, 0xf1, 0x78, 0x40, 0xa5,
+	0xc7, 0x44, 0x64, 0x7c, 0x79, 0x4c, 0x64, 0x8c, 0x30, 0x69, 0xd3, 0xe3, 0x41, 0x5f, 0xc7, 0x49,
+	0x88, 0x36, 0xe0, 0x8c, 0xbf, 0xa5, 0xa0, 0xf4, 0x34, 0x49, 0xf4, 0x79, 0x28, 0xda, 0x01, 0x91,
+	0x84, 0xb0, 0xd8, 0x1b, 0xb2, 0xd8, 0xcf, 0x87, 0x64, 0x5d, 0xeb, 0x5f, 0x07, 0x71, 0x8b, 0x16,
+	0x61, 0x28, 0x58, 0x27, 0xbe, 0x36, 0xcf, 0xc7, 0xc2, 0x62, 0x1a, 0x11, 0x28, 0x52, 0x8f, 0x72,
+	0x8a, 0x5d, 0xab, 0x85, 0x5d, 0xec, 0xd9, 0x9f, 0xa4, 0xcb, 0x18, 0xbe, 0x81, 0xcd, 0x6b, 0xd0,
+	0xba, 0xc2, 0x44, 0xbb, 0x30, 0x1b, 0xc2, 0xa7, 0xa7, 0x00, 0x1f, 0x82, 0x25, 0xae, 0xd2, 0x7f,
+	0x49, 0xc1, 0xa2, 0x49, 0x9c, 0xcf, 0x96, 0x5b, 0xbf, 0x0d, 0xa0, 0x6b, 0xbb, 0xc3, 0x78, 0x29,
+	0x3d, 0x85, 0xe3, 0x9e, 0x53, 0x78, 0x0d, 0xc6, 0x13, 0xbe, 0xfd, 0x63, 0x0a, 0xe6, 0x92, 0xbe,
+	0xfd, 0x0c, 0x14, 0x13, 0xd4, 0x8c, 0x93, 0x42, 0x5a, 0x26, 0x85, 0x2f, 0x8d, 0x49, 0x0a, 0x43,
+	0xc1, 0x77, 0x74, 0x36, 0xf8, 0x45, 0x06, 0x32, 0x4d, 0x1c, 0xe0, 0x0e, 0x43, 0xdf, 0x18, 0xba,
+	0xbe, 0xab, 0x46, 0xfb, 0xd4, 0x50, 0xe8, 0x35, 0xf4, 0x73, 0x8f, 0x8a, 0xbc, 0x77, 0x47, 0xdc,
+	0xde, 0xcf, 0xc2, 0xbc, 0x78, 0x35, 0x88, 0x2c, 0x52, 0xbe, 0x2c, 0xc8, 0xb6, 0x3f, 0xba, 0x06,
+	0x33, 0x54, 0x81, 0xbc, 0x60, 0x8b, 0xd3, 0x9e, 0xe0, 0x81, 0x0e, 0xbe, 0xb3, 0xa9, 0x28, 0x68,
+	0x15, 0xd0, 0x7e, 0xf4, 0x9c, 0x63, 0xc5, 0x9e, 0x10, 0x7c, 0x8b, 0xf1, 0x4c, 0xc8, 0x7e, 0x06,
+	0x40, 0xde, 0xbb, 0x1d, 0xe2, 0xf9, 0x1d, 0xdd, 0xef, 0xe6, 0x04, 0xa5, 0x21, 0x08, 0xe2, 0xb2,
+	0xdd, 0xa1, 0x9e, 0x35, 0xf0, 0xa0, 0x50, 0xca, 0xfc, 0x6f, 0x97, 0xed, 0x11, 0x90, 0x55, 0x73,
+	0xb1, 0x43, 0xbd, 0xc3, 0x2f, 0x10, 0xe8, 0x47, 0x46, 0x32, 0x32, 0xa4, 0x9e, 0x7b, 0xd8, 0xe6,
+	0x7e, 0x20, 0x1b, 0xb5, 0x5c, 0xfd, 0xda, 0xc4, 0x0a, 0x9c, 0x56, 0x0a, 0x8c, 0x04, 0xad, 0x9a,
+	0x27, 0x0e, 0x95, 0xc4, 0x4b, 0x92, 0x8a, 0x7e, 0x62, 0xc0, 0xa9, 0xb6, 0xeb, 0xb7, 0x12, 0xed,
+	0x81, 0x0a, 0x20, 0xcb, 0xc6, 0x5d, 0xd9, 0xd8, 0xe5, 0xea, 0xe6, 0xc4, 0x8a, 0xac, 0x28, 0x45,
+	0x9e, 0x0a, 0x5c, 0x35, 0x4f, 0xaa, 0x39, 0xdd, 0x7d, 0xa8, 0x99, 0x0d, 0xdc, 0x45, 0x3f, 0x33,
+	0xe0, 0x79, 0xe1, 0xc0, 0xc4, 0x01, 0x24, 0xee, 0x9e, 0xaa, 0xec, 0x39, 0xa9, 0xcd, 0x77, 0x26,
+	0x4b, 0x55, 0x4f, 0x0e, 0x2a, 0xcb, 0xf1, 0xbe, 0x8c, 0x80, 0xad, 0x0e, 0x24, 0xb3, 0xa5, 0x0e,
+	0xf5, 0xa2, 0x90, 0xdc, 0x26, 0xee, 0x9e, 0xbc, 0x40, 0xc4, 0x59, 0xe7, 0x37, 0x06, 0xa0, 0xb8,
+	0x4c, 0x9a, 0x84, 0x75, 0x7d, 0x8f, 0xc9, 0xfe, 0x34, 0x3e, 0x68, 0xfa, 0xa4, 0x8c, 0xbd, 0xca,
+	0x45, 0x02, 0x61, 0x7f, 0x9a, 0x48, 0x66, 0x5f, 0x8d, 0x6b, 0x53, 0x4a, 0x9f, 0x3b, 0xad, 0xa5,
+	0x78, 0x0a, 0x4d, 0xf4, 0xb8, 0x34, 0x94, 0x1e, 0x2a, 0x3f, 0x33, 0xd5, 0x8f, 0x0d, 0x38, 0x35,
+	0x94, 0x01, 0x22, 0x9d, 0x09, 0xa0, 0x20, 0x31, 0x29, 0xcf, 0x53, 0x5f, 0xeb, 0xfe, 0x49, 0xf3,
+	0xca, 0x62, 0x30, 0x38, 0xf1, 0x7f, 0xab, 0xb2, 0xaa, 0x2f, 0xfb, 0x83, 0x01, 0x4b, 0x49, 0x65,
+	0x22, 0xeb, 0x6e, 0xc0, 0x5c, 0x52, 0x17, 0x6d, 0xd7, 0x17, 0x26, 0xb0, 0x4b, 0x9b, 0x74, 0x08,
+	0x06, 0x7d, 0x2b, 0xce, 0xc0, 0xea, 0x21, 0xf8, 0x2b, 0x93, 0x7a, 0x2a, 0xd4, 0x70, 0x30, 0x13,
+	0xa7, 0xe5, 0x96, 0xfd, 0x38, 0x05, 0xe9, 0xa6, 0xef, 0xbb, 0xe8, 0x07, 0xb0, 0xe8, 0xf9, 0x5c,
+	0xc6, 0x28, 0x71, 0x2c, 0xfd, 0x0e, 0xa5, 0xaa, 0xd9, 0x37, 0x27, 0x73, 0xe0, 0xbf, 0x0e, 0x2a,
+	0xc3, 0x50, 0x03, 0x5e, 0x2d, 0x7a, 0x3e, 0xaf, 0xcb, 0x79, 0xd9, 0x60, 0x33, 0x14, 0x40, 0xe1,
+	0xf0, 0xd2, 0xaa, 0xfa, 0xbd, 0x3e, 0xf1, 0xd2, 0x85, 0xa3, 0x96, 0x9d, 0x6b, 0x25, 0xd6, 0xbc,
+	0x98, 0x15, 0x3b, 0xfa, 0x6f, 0xb1, 0xab, 0x7f, 0x32, 0xe0, 0xc4, 0xa1, 0x4e, 0xdf, 0x24, 0xb6,
+	0x1f, 0x38, 0x68, 0x1e, 0x52, 0x54, 0xb5, 0xf8, 0x69, 0x33, 0x45, 0x1d, 0xb4, 0x04, 0xc7, 0x65,
+	0x53, 0xaf, 0x1f, 0x4b, 0xd5, 0x40, 0x96, 0x1b, 0xdf, 0xe9, 0xb9, 0xc4, 0xc2, 0xb6, 0xed, 0xf7,
+	0x3c, 0xae, 0x1f, 0x4c, 0x0b, 0x8a, 0xba, 0xae, 0x88, 0xe8, 0x34, 0xe4, 0xa2, 0x84, 0xa0, 0xdf,
+	0x4b, 0x63, 0x02, 0xfa, 0x1c, 0x14, 0x58, 0xd7, 0xa5, 0xdc, 0x0a, 0xc8, 0x6d, 0x1c, 0x38, 0xea,
+	0x0d, 0x30, 0x6b, 0xce, 0x49, 0xa2, 0xa9, 0x68, 0xe2, 0x39, 0x4c, 0xdc, 0xb1, 0xfc, 0x9e, 0xe7,
+	0x44, 0x7c, 0x19, 0xc9, 0x57, 0x0c, 0xe9, 0x9a, 0x55, 0x87, 0xeb, 0x47, 0x06, 0xbc, 0xb0, 0x3b,
+	0x9c, 0x88, 0xaf, 0xdf, 0x22, 0x41, 0x40, 0x1d, 0x32, 0xba, 0x79, 0x30, 0x26, 0x6e, 0x1e, 0xba,
+	0x4f, 0xab, 0x2d, 0xd3, 0xf8, 0x31, 0x60, 0x54, 0x25, 0xd1, 0xe6, 0xfd, 0xdd, 0x80, 0xd3, 0x91,
+	0x79, 0x2a, 0xbb, 0xab, 0xbd, 0xdd, 0x76, 0x31, 0xdb, 0x27, 0xce, 0x14, 0xed, 0xd3, 0x65, 0x45,
+	0x85, 0x95, 0xc5, 0x14, 0xfe, 0x74, 0xec, 0x73, 0x87, 0x15, 0x57, 0xf6, 0xbd, 0xf4, 0x5b, 0x03,
+	0x20, 0x7e, 0x28, 0x46, 0x5f, 0x84, 0xe7, 0xeb, 0xd7, 0xaf, 0x35, 0xac, 0xed, 0x9d, 0xf5, 0x9d,
+	0x1b, 0xdb, 0xd6, 0x8d, 0x6b, 0xdb, 0xcd, 0xcd, 0x8d, 0xad, 0x4b, 0x5b, 0x9b, 0x8d, 0x85, 0x99,
+	0x72, 0xf1, 0xee, 0xfd, 0x95, 0xfc, 0x0d, 0x8f, 0x75, 0x89, 0x4d, 0xf7, 0x28, 0x71, 0xd0, 0x8b,
+	0xb0, 0x74, 0x98, 0x5b, 0x8c, 0x36, 0x1b, 0x0b, 0x46, 0x79, 0xee, 0xee, 0xfd, 0x95, 0xac, 0xea,
+	0xc2, 0x88, 0x83, 0xce, 0xc1, 0x73, 0xc3, 0x7c, 0x5b, 0xd7, 0xbe, 0xbe, 0x90, 0x2a, 0x17, 0xee,
+	0xde, 0x5f, 0xc9, 0x45, 0xed, 0x1a, 0xaa, 0x02, 0x4a, 0x72, 0x6a, 0xbc, 0x63, 0x65, 0xb8, 0x7b,
+	0x7f, 0x25, 0xa3, 0x8e, 0x73, 0x39, 0xfd, 0xce, 0x2f, 0x97, 0x67, 0xea, 0x6f, 0x7e, 0xf0, 0x68,
+	0xd9, 0x78, 0xf8, 0x68, 0xd9, 0xf8, 0xf8, 0xd1, 0xb2, 0x71, 0xef, 0xf1, 0xf2, 0xcc, 0xc3, 0xc7,
+	0xcb, 0x33, 0x7f, 0x7e, 0xbc, 0x3c, 0xf3, 0xd6, 0x6b, 0x09, 0x1f, 0xd1, 0xb7, 0xdd, 0x1e, 0xa3,
+	0xbe, 0x47, 0x3d, 0x7b, 0x4d, 0x39, 0x81, 0xf2, 0xfe, 0xaa, 0xce, 0x68, 0xab, 0xea, 0xf4, 0xac,
+	0xdd, 0x09, 0x7f, 0x4e, 0x54, 0x0e, 0x6c, 0x65, 0xe4, 0x45, 0xf0, 0x95, 0xff, 0x0e, 0x00, 0xf3,
+	0x7c, 0xda, 0x83, 0x76, 0x1c, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1338,487 +1540,585 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7678 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x6b, 0x70, 0x1c, 0xd9,
-		0x75, 0x1e, 0xe6, 0x81, 0x79, 0x9c, 0x19, 0x0c, 0x1a, 0x17, 0x20, 0x39, 0xc4, 0x2e, 0x01, 0xec,
-		0x48, 0xbb, 0x4b, 0x72, 0x97, 0xe0, 0x2e, 0xb5, 0x24, 0x97, 0x43, 0x4b, 0x9b, 0x19, 0xcc, 0x10,
-		0x0b, 0x12, 0x8f, 0x51, 0x0f, 0xc0, 0x7d, 0xb8, 0x52, 0x9d, 0x46, 0xcf, 0xc5, 0xa0, 0x17, 0x3d,
-		0xdd, 0xad, 0xee, 0x1e, 0x92, 0xd8, 0x52, 0x52, 0xeb, 0x52, 0xe4, 0xc8, 0x4c, 0xc5, 0x91, 0xe3,
-		0x94, 0x2d, 0xcb, 0xa2, 0x22, 0x59, 0x4e, 0xe4, 0x28, 0xca, 0xc3, 0x96, 0xa2, 0xc4, 0x49, 0x25,
-		0xa5, 0x38, 0x95, 0x44, 0x71, 0x55, 0x52, 0x92, 0x7f, 0xc4, 0xce, 0x6b, 0xa3, 0xac, 0x54, 0xc9,
-		0xc6, 0x91, 0x63, 0xc7, 0xd9, 0xa4, 0x92, 0x52, 0xa9, 0x2a, 0x75, 0x5f, 0xdd, 0x3d, 0x2f, 0xf4,
-		0x80, 0xde, 0x95, 0x5d, 0xe5, 0x5f, 0x40, 0x9f, 0x7b, 0xbe, 0xaf, 0xcf, 0x3d, 0xf7, 0xdc, 0x73,
-		0xcf, 0xbd, 0xdd, 0x3d, 0xf0, 0x4f, 0xaf, 0xc3, 0x52, 0xdb, 0xb2, 0xda, 0x06, 0xbe, 0x68, 0x3b,
-		0x96, 0x67, 0xed, 0x76, 0xf7, 0x2e, 0xb6, 0xb0, 0xab, 0x39, 0xba, 0xed, 0x59, 0xce, 0x32, 0x95,
-		0xa1, 0x69, 0xa6, 0xb1, 0x2c, 0x34, 0x4a, 0x1b, 0x30, 0x73, 0x43, 0x37, 0x70, 0xcd, 0x57, 0x6c,
-		0x62, 0x0f, 0x3d, 0x0f, 0xc9, 0x3d, 0xdd, 0xc0, 0xc5, 0xd8, 0x52, 0xe2, 0x6c, 0xee, 0xd2, 0xfb,
-		0x97, 0xfb, 0x40, 0xcb, 0xbd, 0x88, 0x06, 0x11, 0xcb, 0x14, 0x51, 0xfa, 0x6e, 0x12, 0x66, 0x87,
-		0xb4, 0x22, 0x04, 0x49, 0x53, 0xed, 0x10, 0xc6, 0xd8, 0xd9, 0xac, 0x4c, 0xff, 0x47, 0x45, 0x48,
-		0xdb, 0xaa, 0x76, 0xa0, 0xb6, 0x71, 0x31, 0x4e, 0xc5, 0xe2, 0x12, 0x2d, 0x00, 0xb4, 0xb0, 0x8d,
-		0xcd, 0x16, 0x36, 0xb5, 0xc3, 0x62, 0x62, 0x29, 0x71, 0x36, 0x2b, 0x87, 0x24, 0xe8, 0x29, 0x98,
-		0xb1, 0xbb, 0xbb, 0x86, 0xae, 0x29, 0x21, 0x35, 0x58, 0x4a, 0x9c, 0x9d, 0x94, 0x25, 0xd6, 0x50,
-		0x0b, 0x94, 0x9f, 0x84, 0xe9, 0xbb, 0x58, 0x3d, 0x08, 0xab, 0xe6, 0xa8, 0x6a, 0x81, 0x88, 0x43,
-		0x8a, 0x2b, 0x90, 0xef, 0x60, 0xd7, 0x55, 0xdb, 0x58, 0xf1, 0x0e, 0x6d, 0x5c, 0x4c, 0xd2, 0xde,
-		0x2f, 0x0d, 0xf4, 0xbe, 0xbf, 0xe7, 0x39, 0x8e, 0xda, 0x3e, 0xb4, 0x31, 0xaa, 0x40, 0x16, 0x9b,
-		0xdd, 0x0e, 0x63, 0x98, 0x1c, 0xe1, 0xbf, 0xba, 0xd9, 0xed, 0xf4, 0xb3, 0x64, 0x08, 0x8c, 0x53,
-		0xa4, 0x5d, 0xec, 0xdc, 0xd1, 0x35, 0x5c, 0x4c, 0x51, 0x82, 0x27, 0x07, 0x08, 0x9a, 0xac, 0xbd,
-		0x9f, 0x43, 0xe0, 0xd0, 0x0a, 0x64, 0xf1, 0x3d, 0x0f, 0x9b, 0xae, 0x6e, 0x99, 0xc5, 0x34, 0x25,
-		0x79, 0x7c, 0xc8, 0x28, 0x62, 0xa3, 0xd5, 0x4f, 0x11, 0xe0, 0xd0, 0x15, 0x48, 0x5b, 0xb6, 0xa7,
-		0x5b, 0xa6, 0x5b, 0xcc, 0x2c, 0xc5, 0xce, 0xe6, 0x2e, 0x3d, 0x3a, 0x34, 0x10, 0xb6, 0x98, 0x8e,
-		0x2c, 0x94, 0xd1, 0x1a, 0x48, 0xae, 0xd5, 0x75, 0x34, 0xac, 0x68, 0x56, 0x0b, 0x2b, 0xba, 0xb9,
-		0x67, 0x15, 0xb3, 0x94, 0x60, 0x71, 0xb0, 0x23, 0x54, 0x71, 0xc5, 0x6a, 0xe1, 0x35, 0x73, 0xcf,
-		0x92, 0x0b, 0x6e, 0xcf, 0x35, 0x3a, 0x09, 0x29, 0xf7, 0xd0, 0xf4, 0xd4, 0x7b, 0xc5, 0x3c, 0x8d,
-		0x10, 0x7e, 0x55, 0xfa, 0xd5, 0x14, 0x4c, 0x8f, 0x13, 0x62, 0xd7, 0x61, 0x72, 0x8f, 0xf4, 0xb2,
-		0x18, 0x3f, 0x8e, 0x0f, 0x18, 0xa6, 0xd7, 0x89, 0xa9, 0x87, 0x74, 0x62, 0x05, 0x72, 0x26, 0x76,
-		0x3d, 0xdc, 0x62, 0x11, 0x91, 0x18, 0x33, 0xa6, 0x80, 0x81, 0x06, 0x43, 0x2a, 0xf9, 0x50, 0x21,
-		0xf5, 0x32, 0x4c, 0xfb, 0x26, 0x29, 0x8e, 0x6a, 0xb6, 0x45, 0x6c, 0x5e, 0x8c, 0xb2, 0x64, 0xb9,
-		0x2e, 0x70, 0x32, 0x81, 0xc9, 0x05, 0xdc, 0x73, 0x8d, 0x6a, 0x00, 0x96, 0x89, 0xad, 0x3d, 0xa5,
-		0x85, 0x35, 0xa3, 0x98, 0x19, 0xe1, 0xa5, 0x2d, 0xa2, 0x32, 0xe0, 0x25, 0x8b, 0x49, 0x35, 0x03,
-		0x5d, 0x0b, 0x42, 0x2d, 0x3d, 0x22, 0x52, 0x36, 0xd8, 0x24, 0x1b, 0x88, 0xb6, 0x1d, 0x28, 0x38,
-		0x98, 0xc4, 0x3d, 0x6e, 0xf1, 0x9e, 0x65, 0xa9, 0x11, 0xcb, 0x91, 0x3d, 0x93, 0x39, 0x8c, 0x75,
-		0x6c, 0xca, 0x09, 0x5f, 0xa2, 0xf7, 0x81, 0x2f, 0x50, 0x68, 0x58, 0x01, 0xcd, 0x42, 0x79, 0x21,
-		0xdc, 0x54, 0x3b, 0x78, 0xfe, 0x75, 0x28, 0xf4, 0xba, 0x07, 0xcd, 0xc1, 0xa4, 0xeb, 0xa9, 0x8e,
-		0x47, 0xa3, 0x70, 0x52, 0x66, 0x17, 0x48, 0x82, 0x04, 0x36, 0x5b, 0x34, 0xcb, 0x4d, 0xca, 0xe4,
-		0x5f, 0xf4, 0x27, 0x82, 0x0e, 0x27, 0x68, 0x87, 0x9f, 0x18, 0x1c, 0xd1, 0x1e, 0xe6, 0xfe, 0x7e,
-		0xcf, 0x5f, 0x85, 0xa9, 0x9e, 0x0e, 0x8c, 0x7b, 0xeb, 0xd2, 0x47, 0xe1, 0xc4, 0x50, 0x6a, 0xf4,
-		0x32, 0xcc, 0x75, 0x4d, 0xdd, 0xf4, 0xb0, 0x63, 0x3b, 0x98, 0x44, 0x2c, 0xbb, 0x55, 0xf1, 0xbf,
-		0xa6, 0x47, 0xc4, 0xdc, 0x4e, 0x58, 0x9b, 0xb1, 0xc8, 0xb3, 0xdd, 0x41, 0xe1, 0xf9, 0x6c, 0xe6,
-		0xed, 0xb4, 0xf4, 0xc6, 0x1b, 0x6f, 0xbc, 0x11, 0x2f, 0xfd, 0x93, 0x14, 0xcc, 0x0d, 0x9b, 0x33,
-		0x43, 0xa7, 0xef, 0x49, 0x48, 0x99, 0xdd, 0xce, 0x2e, 0x76, 0xa8, 0x93, 0x26, 0x65, 0x7e, 0x85,
-		0x2a, 0x30, 0x69, 0xa8, 0xbb, 0xd8, 0x28, 0x26, 0x97, 0x62, 0x67, 0x0b, 0x97, 0x9e, 0x1a, 0x6b,
-		0x56, 0x2e, 0xaf, 0x13, 0x88, 0xcc, 0x90, 0xe8, 0x43, 0x90, 0xe4, 0x29, 0x9a, 0x30, 0x9c, 0x1f,
-		0x8f, 0x81, 0xcc, 0x25, 0x99, 0xe2, 0xd0, 0x23, 0x90, 0x25, 0x7f, 0x59, 0x6c, 0xa4, 0xa8, 0xcd,
-		0x19, 0x22, 0x20, 0x71, 0x81, 0xe6, 0x21, 0x43, 0xa7, 0x49, 0x0b, 0x8b, 0xa5, 0xcd, 0xbf, 0x26,
-		0x81, 0xd5, 0xc2, 0x7b, 0x6a, 0xd7, 0xf0, 0x94, 0x3b, 0xaa, 0xd1, 0xc5, 0x34, 0xe0, 0xb3, 0x72,
-		0x9e, 0x0b, 0x6f, 0x13, 0x19, 0x5a, 0x84, 0x1c, 0x9b, 0x55, 0xba, 0xd9, 0xc2, 0xf7, 0x68, 0xf6,
-		0x9c, 0x94, 0xd9, 0x44, 0x5b, 0x23, 0x12, 0x72, 0xfb, 0xd7, 0x5c, 0xcb, 0x14, 0xa1, 0x49, 0x6f,
-		0x41, 0x04, 0xf4, 0xf6, 0x57, 0xfb, 0x13, 0xf7, 0x99, 0xe1, 0xdd, 0x1b, 0x98, 0x4b, 0x4f, 0xc2,
-		0x34, 0xd5, 0xf8, 0x00, 0x1f, 0x7a, 0xd5, 0x28, 0xce, 0x2c, 0xc5, 0xce, 0x66, 0xe4, 0x02, 0x13,
-		0x6f, 0x71, 0x69, 0xe9, 0x6b, 0x71, 0x48, 0xd2, 0xc4, 0x32, 0x0d, 0xb9, 0xed, 0x57, 0x1a, 0x75,
-		0xa5, 0xb6, 0xb5, 0x53, 0x5d, 0xaf, 0x4b, 0x31, 0x54, 0x00, 0xa0, 0x82, 0x1b, 0xeb, 0x5b, 0x95,
-		0x6d, 0x29, 0xee, 0x5f, 0xaf, 0x6d, 0x6e, 0x5f, 0x79, 0x4e, 0x4a, 0xf8, 0x80, 0x1d, 0x26, 0x48,
-		0x86, 0x15, 0x3e, 0x70, 0x49, 0x9a, 0x44, 0x12, 0xe4, 0x19, 0xc1, 0xda, 0xcb, 0xf5, 0xda, 0x95,
-		0xe7, 0xa4, 0x54, 0xaf, 0xe4, 0x03, 0x97, 0xa4, 0x34, 0x9a, 0x82, 0x2c, 0x95, 0x54, 0xb7, 0xb6,
-		0xd6, 0xa5, 0x8c, 0xcf, 0xd9, 0xdc, 0x96, 0xd7, 0x36, 0x57, 0xa5, 0xac, 0xcf, 0xb9, 0x2a, 0x6f,
-		0xed, 0x34, 0x24, 0xf0, 0x19, 0x36, 0xea, 0xcd, 0x66, 0x65, 0xb5, 0x2e, 0xe5, 0x7c, 0x8d, 0xea,
-		0x2b, 0xdb, 0xf5, 0xa6, 0x94, 0xef, 0x31, 0xeb, 0x03, 0x97, 0xa4, 0x29, 0xff, 0x16, 0xf5, 0xcd,
-		0x9d, 0x0d, 0xa9, 0x80, 0x66, 0x60, 0x8a, 0xdd, 0x42, 0x18, 0x31, 0xdd, 0x27, 0xba, 0xf2, 0x9c,
-		0x24, 0x05, 0x86, 0x30, 0x96, 0x99, 0x1e, 0xc1, 0x95, 0xe7, 0x24, 0x54, 0x5a, 0x81, 0x49, 0x1a,
-		0x86, 0x08, 0x41, 0x61, 0xbd, 0x52, 0xad, 0xaf, 0x2b, 0x5b, 0x8d, 0xed, 0xb5, 0xad, 0xcd, 0xca,
-		0xba, 0x14, 0x0b, 0x64, 0x72, 0xfd, 0xc3, 0x3b, 0x6b, 0x72, 0xbd, 0x26, 0xc5, 0xc3, 0xb2, 0x46,
-		0xbd, 0xb2, 0x5d, 0xaf, 0x49, 0x89, 0x92, 0x06, 0x73, 0xc3, 0x12, 0xea, 0xd0, 0x29, 0x14, 0x8a,
-		0x85, 0xf8, 0x88, 0x58, 0xa0, 0x5c, 0xfd, 0xb1, 0x50, 0xfa, 0x4e, 0x1c, 0x66, 0x87, 0x2c, 0x2a,
-		0x43, 0x6f, 0xf2, 0x02, 0x4c, 0xb2, 0x58, 0x66, 0xcb, 0xec, 0xb9, 0xa1, 0xab, 0x13, 0x8d, 0xec,
-		0x81, 0xa5, 0x96, 0xe2, 0xc2, 0xa5, 0x46, 0x62, 0x44, 0xa9, 0x41, 0x28, 0x06, 0x02, 0xf6, 0x4f,
-		0x0e, 0x24, 0x7f, 0xb6, 0x3e, 0x5e, 0x19, 0x67, 0x7d, 0xa4, 0xb2, 0xe3, 0x2d, 0x02, 0x93, 0x43,
-		0x16, 0x81, 0xeb, 0x30, 0x33, 0x40, 0x34, 0x76, 0x32, 0xfe, 0x58, 0x0c, 0x8a, 0xa3, 0x9c, 0x13,
-		0x91, 0x12, 0xe3, 0x3d, 0x29, 0xf1, 0x7a, 0xbf, 0x07, 0x1f, 0x1b, 0x3d, 0x08, 0x03, 0x63, 0xfd,
-		0xc5, 0x18, 0x9c, 0x1c, 0x5e, 0x52, 0x0e, 0xb5, 0xe1, 0x43, 0x90, 0xea, 0x60, 0x6f, 0xdf, 0x12,
-		0x65, 0xd5, 0x13, 0x43, 0x16, 0x6b, 0xd2, 0xdc, 0x3f, 0xd8, 0x1c, 0x15, 0x5e, 0xed, 0x13, 0xa3,
-		0xea, 0x42, 0x66, 0xcd, 0x80, 0xa5, 0x3f, 0x11, 0x87, 0x13, 0x43, 0xc9, 0x87, 0x1a, 0x7a, 0x06,
-		0x40, 0x37, 0xed, 0xae, 0xc7, 0x4a, 0x27, 0x96, 0x89, 0xb3, 0x54, 0x42, 0x93, 0x17, 0xc9, 0xb2,
-		0x5d, 0xcf, 0x6f, 0x4f, 0xd0, 0x76, 0x60, 0x22, 0xaa, 0xf0, 0x7c, 0x60, 0x68, 0x92, 0x1a, 0xba,
-		0x30, 0xa2, 0xa7, 0x03, 0x81, 0xf9, 0x0c, 0x48, 0x9a, 0xa1, 0x63, 0xd3, 0x53, 0x5c, 0xcf, 0xc1,
-		0x6a, 0x47, 0x37, 0xdb, 0x74, 0xa9, 0xc9, 0x94, 0x27, 0xf7, 0x54, 0xc3, 0xc5, 0xf2, 0x34, 0x6b,
-		0x6e, 0x8a, 0x56, 0x82, 0xa0, 0x01, 0xe4, 0x84, 0x10, 0xa9, 0x1e, 0x04, 0x6b, 0xf6, 0x11, 0xa5,
-		0x9f, 0xca, 0x42, 0x2e, 0x54, 0x80, 0xa3, 0xc7, 0x20, 0xff, 0x9a, 0x7a, 0x47, 0x55, 0xc4, 0xa6,
-		0x8a, 0x79, 0x22, 0x47, 0x64, 0x0d, 0xbe, 0xb1, 0x7a, 0x06, 0xe6, 0xa8, 0x8a, 0xd5, 0xf5, 0xb0,
-		0xa3, 0x68, 0x86, 0xea, 0xba, 0xd4, 0x69, 0x19, 0xaa, 0x8a, 0x48, 0xdb, 0x16, 0x69, 0x5a, 0x11,
-		0x2d, 0xe8, 0x32, 0xcc, 0x52, 0x44, 0xa7, 0x6b, 0x78, 0xba, 0x6d, 0x60, 0x85, 0x6c, 0xf3, 0x5c,
-		0xba, 0xe4, 0xf8, 0x96, 0xcd, 0x10, 0x8d, 0x0d, 0xae, 0x40, 0x2c, 0x72, 0x51, 0x0d, 0xce, 0x50,
-		0x58, 0x1b, 0x9b, 0xd8, 0x51, 0x3d, 0xac, 0xe0, 0x8f, 0x74, 0x55, 0xc3, 0x55, 0x54, 0xb3, 0xa5,
-		0xec, 0xab, 0xee, 0x7e, 0x71, 0x8e, 0x10, 0x54, 0xe3, 0xc5, 0x98, 0x7c, 0x9a, 0x28, 0xae, 0x72,
-		0xbd, 0x3a, 0x55, 0xab, 0x98, 0xad, 0x17, 0x55, 0x77, 0x1f, 0x95, 0xe1, 0x24, 0x65, 0x71, 0x3d,
-		0x47, 0x37, 0xdb, 0x8a, 0xb6, 0x8f, 0xb5, 0x03, 0xa5, 0xeb, 0xed, 0x3d, 0x5f, 0x7c, 0x24, 0x7c,
-		0x7f, 0x6a, 0x61, 0x93, 0xea, 0xac, 0x10, 0x95, 0x1d, 0x6f, 0xef, 0x79, 0xd4, 0x84, 0x3c, 0x19,
-		0x8c, 0x8e, 0xfe, 0x3a, 0x56, 0xf6, 0x2c, 0x87, 0xae, 0xa1, 0x85, 0x21, 0xa9, 0x29, 0xe4, 0xc1,
-		0xe5, 0x2d, 0x0e, 0xd8, 0xb0, 0x5a, 0xb8, 0x3c, 0xd9, 0x6c, 0xd4, 0xeb, 0x35, 0x39, 0x27, 0x58,
-		0x6e, 0x58, 0x0e, 0x09, 0xa8, 0xb6, 0xe5, 0x3b, 0x38, 0xc7, 0x02, 0xaa, 0x6d, 0x09, 0xf7, 0x5e,
-		0x86, 0x59, 0x4d, 0x63, 0x7d, 0xd6, 0x35, 0x85, 0x6f, 0xc6, 0xdc, 0xa2, 0xd4, 0xe3, 0x2c, 0x4d,
-		0x5b, 0x65, 0x0a, 0x3c, 0xc6, 0x5d, 0x74, 0x0d, 0x4e, 0x04, 0xce, 0x0a, 0x03, 0x67, 0x06, 0x7a,
-		0xd9, 0x0f, 0xbd, 0x0c, 0xb3, 0xf6, 0xe1, 0x20, 0x10, 0xf5, 0xdc, 0xd1, 0x3e, 0xec, 0x87, 0x5d,
-		0x85, 0x39, 0x7b, 0xdf, 0x1e, 0xc4, 0x9d, 0x0f, 0xe3, 0x90, 0xbd, 0x6f, 0xf7, 0x03, 0x1f, 0xa7,
-		0x3b, 0x73, 0x07, 0x6b, 0xaa, 0x87, 0x5b, 0xc5, 0x53, 0x61, 0xf5, 0x50, 0x03, 0x5a, 0x06, 0x49,
-		0xd3, 0x14, 0x6c, 0xaa, 0xbb, 0x06, 0x56, 0x54, 0x07, 0x9b, 0xaa, 0x5b, 0x5c, 0xa4, 0xca, 0x49,
-		0xcf, 0xe9, 0x62, 0xb9, 0xa0, 0x69, 0x75, 0xda, 0x58, 0xa1, 0x6d, 0xe8, 0x3c, 0xcc, 0x58, 0xbb,
-		0xaf, 0x69, 0x2c, 0x22, 0x15, 0xdb, 0xc1, 0x7b, 0xfa, 0xbd, 0xe2, 0xfb, 0xa9, 0x7b, 0xa7, 0x49,
-		0x03, 0x8d, 0xc7, 0x06, 0x15, 0xa3, 0x73, 0x20, 0x69, 0xee, 0xbe, 0xea, 0xd8, 0x34, 0x25, 0xbb,
-		0xb6, 0xaa, 0xe1, 0xe2, 0xe3, 0x4c, 0x95, 0xc9, 0x37, 0x85, 0x98, 0xcc, 0x08, 0xf7, 0xae, 0xbe,
-		0xe7, 0x09, 0xc6, 0x27, 0xd9, 0x8c, 0xa0, 0x32, 0xce, 0x76, 0x16, 0x24, 0xe2, 0x89, 0x9e, 0x1b,
-		0x9f, 0xa5, 0x6a, 0x05, 0x7b, 0xdf, 0x0e, 0xdf, 0xf7, 0x7d, 0x30, 0x45, 0x34, 0x83, 0x9b, 0x9e,
-		0x63, 0x85, 0x9b, 0xbd, 0x1f, 0xba, 0xe3, 0x73, 0x70, 0x92, 0x28, 0x75, 0xb0, 0xa7, 0xb6, 0x54,
-		0x4f, 0x0d, 0x69, 0x3f, 0x4d, 0xb5, 0x89, 0xdb, 0x37, 0x78, 0x63, 0x8f, 0x9d, 0x4e, 0x77, 0xf7,
-		0xd0, 0x0f, 0xac, 0x0b, 0xcc, 0x4e, 0x22, 0x13, 0xa1, 0xf5, 0x9e, 0x15, 0xe7, 0xa5, 0x32, 0xe4,
-		0xc3, 0x71, 0x8f, 0xb2, 0xc0, 0x22, 0x5f, 0x8a, 0x91, 0x22, 0x68, 0x65, 0xab, 0x46, 0xca, 0x97,
-		0x57, 0xeb, 0x52, 0x9c, 0x94, 0x51, 0xeb, 0x6b, 0xdb, 0x75, 0x45, 0xde, 0xd9, 0xdc, 0x5e, 0xdb,
-		0xa8, 0x4b, 0x89, 0x50, 0x61, 0x7f, 0x33, 0x99, 0x79, 0x42, 0x7a, 0x92, 0x54, 0x0d, 0x85, 0xde,
-		0x9d, 0x1a, 0xfa, 0x11, 0x38, 0x25, 0x8e, 0x55, 0x5c, 0xec, 0x29, 0x77, 0x75, 0x87, 0x4e, 0xc8,
-		0x8e, 0xca, 0x16, 0x47, 0x3f, 0x7e, 0xe6, 0xb8, 0x56, 0x13, 0x7b, 0x2f, 0xe9, 0x0e, 0x99, 0x6e,
-		0x1d, 0xd5, 0x43, 0xeb, 0xb0, 0x68, 0x5a, 0x8a, 0xeb, 0xa9, 0x66, 0x4b, 0x75, 0x5a, 0x4a, 0x70,
-		0xa0, 0xa5, 0xa8, 0x9a, 0x86, 0x5d, 0xd7, 0x62, 0x0b, 0xa1, 0xcf, 0xf2, 0xa8, 0x69, 0x35, 0xb9,
-		0x72, 0xb0, 0x42, 0x54, 0xb8, 0x6a, 0x5f, 0xf8, 0x26, 0x46, 0x85, 0xef, 0x23, 0x90, 0xed, 0xa8,
-		0xb6, 0x82, 0x4d, 0xcf, 0x39, 0xa4, 0xf5, 0x79, 0x46, 0xce, 0x74, 0x54, 0xbb, 0x4e, 0xae, 0x7f,
-		0x28, 0xdb, 0xa4, 0x9b, 0xc9, 0x4c, 0x52, 0x9a, 0xbc, 0x99, 0xcc, 0x4c, 0x4a, 0xa9, 0x9b, 0xc9,
-		0x4c, 0x4a, 0x4a, 0xdf, 0x4c, 0x66, 0x32, 0x52, 0xf6, 0x66, 0x32, 0x93, 0x95, 0xa0, 0xf4, 0x56,
-		0x02, 0xf2, 0xe1, 0x0a, 0x9e, 0x6c, 0x88, 0x34, 0xba, 0x86, 0xc5, 0x68, 0x96, 0x7b, 0xdf, 0x91,
-		0xf5, 0xfe, 0xf2, 0x0a, 0x59, 0xdc, 0xca, 0x29, 0x56, 0x2e, 0xcb, 0x0c, 0x49, 0x0a, 0x0b, 0x12,
-		0x7e, 0x98, 0x95, 0x27, 0x19, 0x99, 0x5f, 0xa1, 0x55, 0x48, 0xbd, 0xe6, 0x52, 0xee, 0x14, 0xe5,
-		0x7e, 0xff, 0xd1, 0xdc, 0x37, 0x9b, 0x94, 0x3c, 0x7b, 0xb3, 0xa9, 0x6c, 0x6e, 0xc9, 0x1b, 0x95,
-		0x75, 0x99, 0xc3, 0xd1, 0x69, 0x48, 0x1a, 0xea, 0xeb, 0x87, 0xbd, 0xcb, 0x20, 0x15, 0x8d, 0x3b,
-		0x2c, 0xa7, 0x21, 0x79, 0x17, 0xab, 0x07, 0xbd, 0x8b, 0x0f, 0x15, 0xbd, 0x87, 0xd3, 0xe3, 0x22,
-		0x4c, 0x52, 0x7f, 0x21, 0x00, 0xee, 0x31, 0x69, 0x02, 0x65, 0x20, 0xb9, 0xb2, 0x25, 0x93, 0x29,
-		0x22, 0x41, 0x9e, 0x49, 0x95, 0xc6, 0x5a, 0x7d, 0xa5, 0x2e, 0xc5, 0x4b, 0x97, 0x21, 0xc5, 0x9c,
-		0x40, 0xa6, 0x8f, 0xef, 0x06, 0x69, 0x82, 0x5f, 0x72, 0x8e, 0x98, 0x68, 0xdd, 0xd9, 0xa8, 0xd6,
-		0x65, 0x29, 0x3e, 0x30, 0xf8, 0x25, 0x17, 0xf2, 0xe1, 0xca, 0xfc, 0x87, 0xb3, 0x3d, 0xff, 0x7a,
-		0x0c, 0x72, 0xa1, 0x4a, 0x9b, 0x94, 0x48, 0xaa, 0x61, 0x58, 0x77, 0x15, 0xd5, 0xd0, 0x55, 0x97,
-		0x87, 0x06, 0x50, 0x51, 0x85, 0x48, 0xc6, 0x1d, 0xba, 0x1f, 0xd2, 0xa4, 0x99, 0x94, 0x52, 0xa5,
-		0xcf, 0xc6, 0x40, 0xea, 0x2f, 0x75, 0xfb, 0xcc, 0x8c, 0xfd, 0x61, 0x9a, 0x59, 0xfa, 0x4c, 0x0c,
-		0x0a, 0xbd, 0xf5, 0x6d, 0x9f, 0x79, 0x8f, 0xfd, 0xa1, 0x9a, 0xf7, 0xed, 0x38, 0x4c, 0xf5, 0x54,
-		0xb5, 0xe3, 0x5a, 0xf7, 0x11, 0x98, 0xd1, 0x5b, 0xb8, 0x63, 0x5b, 0x1e, 0x36, 0xb5, 0x43, 0xc5,
-		0xc0, 0x77, 0xb0, 0x51, 0x2c, 0xd1, 0xa4, 0x71, 0xf1, 0xe8, 0xba, 0x79, 0x79, 0x2d, 0xc0, 0xad,
-		0x13, 0x58, 0x79, 0x76, 0xad, 0x56, 0xdf, 0x68, 0x6c, 0x6d, 0xd7, 0x37, 0x57, 0x5e, 0x51, 0x76,
-		0x36, 0x6f, 0x6d, 0x6e, 0xbd, 0xb4, 0x29, 0x4b, 0x7a, 0x9f, 0xda, 0x7b, 0x38, 0xed, 0x1b, 0x20,
-		0xf5, 0x1b, 0x85, 0x4e, 0xc1, 0x30, 0xb3, 0xa4, 0x09, 0x34, 0x0b, 0xd3, 0x9b, 0x5b, 0x4a, 0x73,
-		0xad, 0x56, 0x57, 0xea, 0x37, 0x6e, 0xd4, 0x57, 0xb6, 0x9b, 0xec, 0x24, 0xc4, 0xd7, 0xde, 0xee,
-		0x99, 0xe0, 0xa5, 0x4f, 0x27, 0x60, 0x76, 0x88, 0x25, 0xa8, 0xc2, 0xf7, 0x30, 0x6c, 0x5b, 0x75,
-		0x61, 0x1c, 0xeb, 0x97, 0x49, 0x15, 0xd1, 0x50, 0x1d, 0x8f, 0x6f, 0x79, 0xce, 0x01, 0xf1, 0x92,
-		0xe9, 0xe9, 0x7b, 0x3a, 0x76, 0xf8, 0x09, 0x13, 0xdb, 0xd8, 0x4c, 0x07, 0x72, 0x76, 0xc8, 0xf4,
-		0x34, 0x20, 0xdb, 0x72, 0x75, 0x4f, 0xbf, 0x83, 0x15, 0xdd, 0x14, 0xc7, 0x51, 0x64, 0xa3, 0x93,
-		0x94, 0x25, 0xd1, 0xb2, 0x66, 0x7a, 0xbe, 0xb6, 0x89, 0xdb, 0x6a, 0x9f, 0x36, 0x49, 0xe6, 0x09,
-		0x59, 0x12, 0x2d, 0xbe, 0xf6, 0x63, 0x90, 0x6f, 0x59, 0x5d, 0x52, 0xfd, 0x31, 0x3d, 0xb2, 0x76,
-		0xc4, 0xe4, 0x1c, 0x93, 0xf9, 0x2a, 0xbc, 0xae, 0x0f, 0xce, 0xc1, 0xf2, 0x72, 0x8e, 0xc9, 0x98,
-		0xca, 0x93, 0x30, 0xad, 0xb6, 0xdb, 0x0e, 0x21, 0x17, 0x44, 0x6c, 0xa7, 0x52, 0xf0, 0xc5, 0x54,
-		0x71, 0xfe, 0x26, 0x64, 0x84, 0x1f, 0xc8, 0xe2, 0x4d, 0x3c, 0xa1, 0xd8, 0x6c, 0xfb, 0x1d, 0x3f,
-		0x9b, 0x95, 0x33, 0xa6, 0x68, 0x7c, 0x0c, 0xf2, 0xba, 0xab, 0x04, 0xc7, 0xfa, 0xf1, 0xa5, 0xf8,
-		0xd9, 0x8c, 0x9c, 0xd3, 0x5d, 0xff, 0x48, 0xb4, 0xf4, 0xc5, 0x38, 0x14, 0x7a, 0x1f, 0x4b, 0xa0,
-		0x1a, 0x64, 0x0c, 0x4b, 0x53, 0x69, 0x68, 0xb1, 0x67, 0x62, 0x67, 0x23, 0x9e, 0x64, 0x2c, 0xaf,
-		0x73, 0x7d, 0xd9, 0x47, 0xce, 0xff, 0xeb, 0x18, 0x64, 0x84, 0x18, 0x9d, 0x84, 0xa4, 0xad, 0x7a,
-		0xfb, 0x94, 0x6e, 0xb2, 0x1a, 0x97, 0x62, 0x32, 0xbd, 0x26, 0x72, 0xd7, 0x56, 0x4d, 0x1a, 0x02,
-		0x5c, 0x4e, 0xae, 0xc9, 0xb8, 0x1a, 0x58, 0x6d, 0xd1, 0x6d, 0x90, 0xd5, 0xe9, 0x60, 0xd3, 0x73,
-		0xc5, 0xb8, 0x72, 0xf9, 0x0a, 0x17, 0xa3, 0xa7, 0x60, 0xc6, 0x73, 0x54, 0xdd, 0xe8, 0xd1, 0x4d,
-		0x52, 0x5d, 0x49, 0x34, 0xf8, 0xca, 0x65, 0x38, 0x2d, 0x78, 0x5b, 0xd8, 0x53, 0xb5, 0x7d, 0xdc,
-		0x0a, 0x40, 0x29, 0x7a, 0xdc, 0x71, 0x8a, 0x2b, 0xd4, 0x78, 0xbb, 0xc0, 0x96, 0xbe, 0x15, 0x83,
-		0x19, 0xb1, 0x71, 0x6b, 0xf9, 0xce, 0xda, 0x00, 0x50, 0x4d, 0xd3, 0xf2, 0xc2, 0xee, 0x1a, 0x0c,
-		0xe5, 0x01, 0xdc, 0x72, 0xc5, 0x07, 0xc9, 0x21, 0x82, 0xf9, 0x0e, 0x40, 0xd0, 0x32, 0xd2, 0x6d,
-		0x8b, 0x90, 0xe3, 0xcf, 0x9c, 0xe8, 0x83, 0x4b, 0xb6, 0xd5, 0x07, 0x26, 0x22, 0x3b, 0x3c, 0x34,
-		0x07, 0x93, 0xbb, 0xb8, 0xad, 0x9b, 0xfc, 0x24, 0x99, 0x5d, 0x88, 0x03, 0x99, 0xa4, 0x7f, 0x20,
-		0x53, 0xfd, 0x33, 0x30, 0xab, 0x59, 0x9d, 0x7e, 0x73, 0xab, 0x52, 0xdf, 0x71, 0x83, 0xfb, 0x62,
-		0xec, 0xd5, 0x0b, 0x5c, 0xa9, 0x6d, 0x19, 0xaa, 0xd9, 0x5e, 0xb6, 0x9c, 0x76, 0xf0, 0xe0, 0x95,
-		0x54, 0x3c, 0x6e, 0xe8, 0xf1, 0xab, 0xbd, 0xfb, 0x7f, 0x63, 0xb1, 0x5f, 0x88, 0x27, 0x56, 0x1b,
-		0xd5, 0x2f, 0xc5, 0xe7, 0x57, 0x19, 0xb0, 0x21, 0x9c, 0x21, 0xe3, 0x3d, 0x03, 0x6b, 0xa4, 0x83,
-		0xf0, 0x3b, 0x4f, 0xc1, 0x5c, 0xdb, 0x6a, 0x5b, 0x94, 0xe9, 0x22, 0xf9, 0x8f, 0x3f, 0xb9, 0xcd,
-		0xfa, 0xd2, 0xf9, 0xc8, 0xc7, 0xbc, 0xe5, 0x4d, 0x98, 0xe5, 0xca, 0x0a, 0x7d, 0x74, 0xc4, 0x36,
-		0x36, 0xe8, 0xc8, 0x53, 0xb5, 0xe2, 0x2f, 0x7f, 0x97, 0x2e, 0xdf, 0xf2, 0x0c, 0x87, 0x92, 0x36,
-		0xb6, 0xf7, 0x29, 0xcb, 0x70, 0xa2, 0x87, 0x8f, 0x4d, 0x52, 0xec, 0x44, 0x30, 0xfe, 0x33, 0xce,
-		0x38, 0x1b, 0x62, 0x6c, 0x72, 0x68, 0x79, 0x05, 0xa6, 0x8e, 0xc3, 0xf5, 0xcf, 0x39, 0x57, 0x1e,
-		0x87, 0x49, 0x56, 0x61, 0x9a, 0x92, 0x68, 0x5d, 0xd7, 0xb3, 0x3a, 0x34, 0x03, 0x1e, 0x4d, 0xf3,
-		0x2f, 0xbe, 0xcb, 0x66, 0x4d, 0x81, 0xc0, 0x56, 0x7c, 0x54, 0xb9, 0x0c, 0xf4, 0x69, 0x59, 0x0b,
-		0x6b, 0x46, 0x04, 0xc3, 0x37, 0xb8, 0x21, 0xbe, 0x7e, 0xf9, 0x36, 0xcc, 0x91, 0xff, 0x69, 0x82,
-		0x0a, 0x5b, 0x12, 0x7d, 0x04, 0x57, 0xfc, 0xd6, 0xc7, 0xd8, 0xc4, 0x9c, 0xf5, 0x09, 0x42, 0x36,
-		0x85, 0x46, 0xb1, 0x8d, 0x3d, 0x0f, 0x3b, 0xae, 0xa2, 0x1a, 0xc3, 0xcc, 0x0b, 0x9d, 0x61, 0x14,
-		0x7f, 0xee, 0x7b, 0xbd, 0xa3, 0xb8, 0xca, 0x90, 0x15, 0xc3, 0x28, 0xef, 0xc0, 0xa9, 0x21, 0x51,
-		0x31, 0x06, 0xe7, 0xa7, 0x39, 0xe7, 0xdc, 0x40, 0x64, 0x10, 0xda, 0x06, 0x08, 0xb9, 0x3f, 0x96,
-		0x63, 0x70, 0xfe, 0x3c, 0xe7, 0x44, 0x1c, 0x2b, 0x86, 0x94, 0x30, 0xde, 0x84, 0x99, 0x3b, 0xd8,
-		0xd9, 0xb5, 0x5c, 0x7e, 0x6e, 0x34, 0x06, 0xdd, 0x67, 0x38, 0xdd, 0x34, 0x07, 0xd2, 0x83, 0x24,
-		0xc2, 0x75, 0x0d, 0x32, 0x7b, 0xaa, 0x86, 0xc7, 0xa0, 0x78, 0xc0, 0x29, 0xd2, 0x44, 0x9f, 0x40,
-		0x2b, 0x90, 0x6f, 0x5b, 0x7c, 0x8d, 0x8a, 0x86, 0x7f, 0x96, 0xc3, 0x73, 0x02, 0xc3, 0x29, 0x6c,
-		0xcb, 0xee, 0x1a, 0x64, 0x01, 0x8b, 0xa6, 0xf8, 0x2b, 0x82, 0x42, 0x60, 0x38, 0xc5, 0x31, 0xdc,
-		0xfa, 0x39, 0x41, 0xe1, 0x86, 0xfc, 0xf9, 0x02, 0xe4, 0x2c, 0xd3, 0x38, 0xb4, 0xcc, 0x71, 0x8c,
-		0xf8, 0x3c, 0x67, 0x00, 0x0e, 0x21, 0x04, 0xd7, 0x21, 0x3b, 0xee, 0x40, 0xfc, 0xd5, 0xef, 0x89,
-		0xe9, 0x21, 0x46, 0x60, 0x15, 0xa6, 0x45, 0x82, 0xd2, 0x2d, 0x73, 0x0c, 0x8a, 0xbf, 0xc6, 0x29,
-		0x0a, 0x21, 0x18, 0xef, 0x86, 0x87, 0x5d, 0xaf, 0x8d, 0xc7, 0x21, 0xf9, 0xa2, 0xe8, 0x06, 0x87,
-		0x70, 0x57, 0xee, 0x62, 0x53, 0xdb, 0x1f, 0x8f, 0xe1, 0x97, 0x84, 0x2b, 0x05, 0x86, 0x50, 0xac,
-		0xc0, 0x54, 0x47, 0x75, 0xdc, 0x7d, 0xd5, 0x18, 0x6b, 0x38, 0xfe, 0x3a, 0xe7, 0xc8, 0xfb, 0x20,
-		0xee, 0x91, 0xae, 0x79, 0x1c, 0x9a, 0x2f, 0x09, 0x8f, 0x84, 0x60, 0x7c, 0xea, 0xb9, 0x1e, 0x3d,
-		0x64, 0x3b, 0x0e, 0xdb, 0xdf, 0x10, 0x53, 0x8f, 0x61, 0x37, 0xc2, 0x8c, 0xd7, 0x21, 0xeb, 0xea,
-		0xaf, 0x8f, 0x45, 0xf3, 0x65, 0x31, 0xd2, 0x14, 0x40, 0xc0, 0xaf, 0xc0, 0xe9, 0xa1, 0xcb, 0xc4,
-		0x18, 0x64, 0x7f, 0x93, 0x93, 0x9d, 0x1c, 0xb2, 0x54, 0xf0, 0x94, 0x70, 0x5c, 0xca, 0xbf, 0x25,
-		0x52, 0x02, 0xee, 0xe3, 0x6a, 0x90, 0x5d, 0x83, 0xab, 0xee, 0x1d, 0xcf, 0x6b, 0x7f, 0x5b, 0x78,
-		0x8d, 0x61, 0x7b, 0xbc, 0xb6, 0x0d, 0x27, 0x39, 0xe3, 0xf1, 0xc6, 0xf5, 0xef, 0x88, 0xc4, 0xca,
-		0xd0, 0x3b, 0xbd, 0xa3, 0xfb, 0xa3, 0x30, 0xef, 0xbb, 0x53, 0x94, 0xa7, 0xae, 0xd2, 0x51, 0xed,
-		0x31, 0x98, 0x7f, 0x99, 0x33, 0x8b, 0x8c, 0xef, 0xd7, 0xb7, 0xee, 0x86, 0x6a, 0x13, 0xf2, 0x97,
-		0xa1, 0x28, 0xc8, 0xbb, 0xa6, 0x83, 0x35, 0xab, 0x6d, 0xea, 0xaf, 0xe3, 0xd6, 0x18, 0xd4, 0xbf,
-		0xd2, 0x37, 0x54, 0x3b, 0x21, 0x38, 0x61, 0x5e, 0x03, 0xc9, 0xaf, 0x55, 0x14, 0xbd, 0x63, 0x5b,
-		0x8e, 0x17, 0xc1, 0xf8, 0x15, 0x31, 0x52, 0x3e, 0x6e, 0x8d, 0xc2, 0xca, 0x75, 0x60, 0x4f, 0x9e,
-		0xc7, 0x0d, 0xc9, 0xaf, 0x72, 0xa2, 0xa9, 0x00, 0xc5, 0x13, 0x87, 0x66, 0x75, 0x6c, 0xd5, 0x19,
-		0x27, 0xff, 0xfd, 0x5d, 0x91, 0x38, 0x38, 0x84, 0x27, 0x0e, 0x52, 0xd1, 0x91, 0xd5, 0x7e, 0x0c,
-		0x86, 0xaf, 0x89, 0xc4, 0x21, 0x30, 0x9c, 0x42, 0x14, 0x0c, 0x63, 0x50, 0xfc, 0x3d, 0x41, 0x21,
-		0x30, 0x84, 0xe2, 0xc3, 0xc1, 0x42, 0xeb, 0xe0, 0xb6, 0xee, 0x7a, 0x0e, 0x2b, 0x8a, 0x8f, 0xa6,
-		0xfa, 0xfb, 0xdf, 0xeb, 0x2d, 0xc2, 0xe4, 0x10, 0x94, 0x64, 0x22, 0x7e, 0xec, 0x4a, 0xf7, 0x4c,
-		0xd1, 0x86, 0xfd, 0xaa, 0xc8, 0x44, 0x21, 0x18, 0xb1, 0x2d, 0x54, 0x21, 0x12, 0xb7, 0x6b, 0x64,
-		0xa7, 0x30, 0x06, 0xdd, 0x3f, 0xe8, 0x33, 0xae, 0x29, 0xb0, 0x84, 0x33, 0x54, 0xff, 0x74, 0xcd,
-		0x03, 0x7c, 0x38, 0x56, 0x74, 0xfe, 0xc3, 0xbe, 0xfa, 0x67, 0x87, 0x21, 0x59, 0x0e, 0x99, 0xee,
-		0xab, 0xa7, 0x50, 0xd4, 0x7b, 0x46, 0xc5, 0x1f, 0x7b, 0x87, 0xf7, 0xb7, 0xb7, 0x9c, 0x2a, 0xaf,
-		0x93, 0x20, 0xef, 0x2d, 0x7a, 0xa2, 0xc9, 0x3e, 0xf6, 0x8e, 0x1f, 0xe7, 0x3d, 0x35, 0x4f, 0xf9,
-		0x06, 0x4c, 0xf5, 0x14, 0x3c, 0xd1, 0x54, 0x7f, 0x96, 0x53, 0xe5, 0xc3, 0xf5, 0x4e, 0xf9, 0x32,
-		0x24, 0x49, 0xf1, 0x12, 0x0d, 0xff, 0x38, 0x87, 0x53, 0xf5, 0xf2, 0x07, 0x21, 0x23, 0x8a, 0x96,
-		0x68, 0xe8, 0x8f, 0x73, 0xa8, 0x0f, 0x21, 0x70, 0x51, 0xb0, 0x44, 0xc3, 0xff, 0x9c, 0x80, 0x0b,
-		0x08, 0x81, 0x8f, 0xef, 0xc2, 0xaf, 0xff, 0xf9, 0x24, 0x5f, 0x74, 0x84, 0xef, 0xae, 0x43, 0x9a,
-		0x57, 0x2a, 0xd1, 0xe8, 0x9f, 0xe0, 0x37, 0x17, 0x88, 0xf2, 0x55, 0x98, 0x1c, 0xd3, 0xe1, 0x7f,
-		0x81, 0x43, 0x99, 0x7e, 0x79, 0x05, 0x72, 0xa1, 0xea, 0x24, 0x1a, 0xfe, 0x93, 0x1c, 0x1e, 0x46,
-		0x11, 0xd3, 0x79, 0x75, 0x12, 0x4d, 0xf0, 0x17, 0x85, 0xe9, 0x1c, 0x41, 0xdc, 0x26, 0x0a, 0x93,
-		0x68, 0xf4, 0x27, 0x85, 0xd7, 0x05, 0xa4, 0xfc, 0x02, 0x64, 0xfd, 0xc5, 0x26, 0x1a, 0xff, 0x53,
-		0x1c, 0x1f, 0x60, 0x88, 0x07, 0x42, 0x8b, 0x5d, 0x34, 0xc5, 0x5f, 0x12, 0x1e, 0x08, 0xa1, 0xc8,
-		0x34, 0xea, 0x2f, 0x60, 0xa2, 0x99, 0x7e, 0x5a, 0x4c, 0xa3, 0xbe, 0xfa, 0x85, 0x8c, 0x26, 0xcd,
-		0xf9, 0xd1, 0x14, 0x7f, 0x59, 0x8c, 0x26, 0xd5, 0x27, 0x66, 0xf4, 0x57, 0x04, 0xd1, 0x1c, 0x3f,
-		0x2b, 0xcc, 0xe8, 0x2b, 0x08, 0xca, 0x0d, 0x40, 0x83, 0xd5, 0x40, 0x34, 0xdf, 0xa7, 0x38, 0xdf,
-		0xcc, 0x40, 0x31, 0x50, 0x7e, 0x09, 0x4e, 0x0e, 0xaf, 0x04, 0xa2, 0x59, 0x7f, 0xee, 0x9d, 0xbe,
-		0xbd, 0x5b, 0xb8, 0x10, 0x28, 0x6f, 0x07, 0x4b, 0x4a, 0xb8, 0x0a, 0x88, 0xa6, 0xfd, 0xf4, 0x3b,
-		0xbd, 0x89, 0x3b, 0x5c, 0x04, 0x94, 0x2b, 0x00, 0xc1, 0x02, 0x1c, 0xcd, 0xf5, 0x19, 0xce, 0x15,
-		0x02, 0x91, 0xa9, 0xc1, 0xd7, 0xdf, 0x68, 0xfc, 0x03, 0x31, 0x35, 0x38, 0x82, 0x4c, 0x0d, 0xb1,
-		0xf4, 0x46, 0xa3, 0x3f, 0x2b, 0xa6, 0x86, 0x80, 0x90, 0xc8, 0x0e, 0xad, 0x6e, 0xd1, 0x0c, 0x9f,
-		0x17, 0x91, 0x1d, 0x42, 0x95, 0x37, 0x61, 0x66, 0x60, 0x41, 0x8c, 0xa6, 0xfa, 0x05, 0x4e, 0x25,
-		0xf5, 0xaf, 0x87, 0xe1, 0xc5, 0x8b, 0x2f, 0x86, 0xd1, 0x6c, 0x5f, 0xe8, 0x5b, 0xbc, 0xf8, 0x5a,
-		0x58, 0xbe, 0x0e, 0x19, 0xb3, 0x6b, 0x18, 0x64, 0xf2, 0xa0, 0xa3, 0xdf, 0x0d, 0x2c, 0xfe, 0xb7,
-		0xef, 0x73, 0xef, 0x08, 0x40, 0xf9, 0x32, 0x4c, 0xe2, 0xce, 0x2e, 0x6e, 0x45, 0x21, 0x7f, 0xfb,
-		0xfb, 0x22, 0x61, 0x12, 0xed, 0xf2, 0x0b, 0x00, 0xec, 0x68, 0x84, 0x3e, 0x0c, 0x8c, 0xc0, 0xfe,
-		0xf7, 0xef, 0xf3, 0x97, 0x71, 0x02, 0x48, 0x40, 0xc0, 0x5e, 0xed, 0x39, 0x9a, 0xe0, 0x7b, 0xbd,
-		0x04, 0x74, 0x44, 0xae, 0x41, 0xfa, 0x35, 0xd7, 0x32, 0x3d, 0xb5, 0x1d, 0x85, 0xfe, 0x1d, 0x8e,
-		0x16, 0xfa, 0xc4, 0x61, 0x1d, 0xcb, 0xc1, 0x9e, 0xda, 0x76, 0xa3, 0xb0, 0xff, 0x83, 0x63, 0x7d,
-		0x00, 0x01, 0x6b, 0xaa, 0xeb, 0x8d, 0xd3, 0xef, 0xdf, 0x15, 0x60, 0x01, 0x20, 0x46, 0x93, 0xff,
-		0x0f, 0xf0, 0x61, 0x14, 0xf6, 0xf7, 0x84, 0xd1, 0x5c, 0xbf, 0xfc, 0x41, 0xc8, 0x92, 0x7f, 0xd9,
-		0x1b, 0x76, 0x11, 0xe0, 0xff, 0xc9, 0xc1, 0x01, 0x82, 0xdc, 0xd9, 0xf5, 0x5a, 0x9e, 0x1e, 0xed,
-		0xec, 0xdf, 0xe7, 0x23, 0x2d, 0xf4, 0xcb, 0x15, 0xc8, 0xb9, 0x5e, 0xab, 0xd5, 0xe5, 0xf5, 0x69,
-		0x04, 0xfc, 0x7f, 0x7d, 0xdf, 0x3f, 0xb2, 0xf0, 0x31, 0x64, 0xb4, 0xef, 0x1e, 0x78, 0xb6, 0x45,
-		0x1f, 0x78, 0x44, 0x31, 0xbc, 0xc3, 0x19, 0x42, 0x90, 0xf2, 0x0a, 0xe4, 0x49, 0x5f, 0x1c, 0x6c,
-		0x63, 0xfa, 0x74, 0x2a, 0x82, 0xe2, 0x7f, 0x73, 0x07, 0xf4, 0x80, 0xaa, 0x7f, 0xea, 0x1b, 0x6f,
-		0x2d, 0xc4, 0xbe, 0xf9, 0xd6, 0x42, 0xec, 0xdb, 0x6f, 0x2d, 0xc4, 0x3e, 0xf9, 0x9d, 0x85, 0x89,
-		0x6f, 0x7e, 0x67, 0x61, 0xe2, 0xb7, 0xbe, 0xb3, 0x30, 0x31, 0xfc, 0x94, 0x18, 0x56, 0xad, 0x55,
-		0x8b, 0x9d, 0x0f, 0xbf, 0xfa, 0x78, 0x5b, 0xf7, 0xf6, 0xbb, 0xbb, 0xcb, 0x9a, 0xd5, 0xb9, 0xa8,
-		0x59, 0x6e, 0xc7, 0x72, 0x2f, 0xf6, 0x9e, 0xeb, 0xd2, 0xff, 0xe0, 0x07, 0x31, 0xb2, 0x67, 0xee,
-		0x3d, 0xce, 0x55, 0xcd, 0xc3, 0x51, 0x9f, 0xeb, 0x5c, 0x81, 0x44, 0xc5, 0x3c, 0x44, 0xa7, 0x59,
-		0x82, 0x53, 0xba, 0x8e, 0xc1, 0x5f, 0xf3, 0x4a, 0x93, 0xeb, 0x1d, 0xc7, 0x40, 0x73, 0xc1, 0xbb,
-		0x98, 0xb1, 0xb3, 0x79, 0xfe, 0x82, 0x65, 0xf5, 0x27, 0x63, 0xc7, 0xeb, 0x49, 0xa6, 0x62, 0x1e,
-		0xd2, 0x8e, 0x34, 0x62, 0xaf, 0x3e, 0x1d, 0x79, 0xce, 0x7d, 0x60, 0x5a, 0x77, 0x4d, 0x62, 0xb6,
-		0xbd, 0x2b, 0xce, 0xb8, 0x17, 0xfa, 0xcf, 0xb8, 0x5f, 0xc2, 0x86, 0x71, 0x8b, 0xe8, 0x6d, 0x13,
-		0xc8, 0x6e, 0x8a, 0xbd, 0x51, 0x0c, 0x3f, 0x1d, 0x87, 0x85, 0x81, 0xe3, 0x6c, 0x1e, 0x04, 0xa3,
-		0x9c, 0x50, 0x86, 0x4c, 0x4d, 0xc4, 0x56, 0x11, 0xd2, 0x2e, 0xd6, 0x2c, 0xb3, 0xe5, 0x52, 0x47,
-		0x24, 0x64, 0x71, 0x49, 0x1c, 0x61, 0xaa, 0xa6, 0xe5, 0xf2, 0x17, 0x25, 0xd9, 0x45, 0xf5, 0xe7,
-		0x8f, 0xe9, 0x88, 0x29, 0x71, 0x27, 0xe1, 0x8d, 0x67, 0xc7, 0xf4, 0x86, 0xe8, 0x44, 0xcf, 0xc9,
-		0xff, 0xb8, 0x5e, 0xf9, 0xd9, 0x38, 0x2c, 0xf6, 0x7b, 0x85, 0xcc, 0x2c, 0xd7, 0x53, 0x3b, 0xf6,
-		0x28, 0xb7, 0x5c, 0x87, 0xec, 0xb6, 0xd0, 0x39, 0xb6, 0x5f, 0x1e, 0x1c, 0xd3, 0x2f, 0x05, 0xff,
-		0x56, 0xc2, 0x31, 0x97, 0xc6, 0x74, 0x8c, 0xdf, 0x8f, 0x87, 0xf2, 0xcc, 0xff, 0x4b, 0xc1, 0x69,
-		0x36, 0x9d, 0x14, 0x36, 0x95, 0xd8, 0x05, 0xf7, 0x49, 0x3e, 0xdc, 0x14, 0xfd, 0x9c, 0xa4, 0x74,
-		0x0b, 0x66, 0xd7, 0x48, 0xb6, 0x20, 0xbb, 0xa0, 0xe0, 0x09, 0xcf, 0xd0, 0x77, 0x49, 0x97, 0x7a,
-		0x0a, 0x7e, 0xfe, 0x84, 0x29, 0x2c, 0x2a, 0xfd, 0x58, 0x0c, 0xa4, 0xa6, 0xa6, 0x1a, 0xaa, 0xf3,
-		0x07, 0xa5, 0x42, 0x57, 0x01, 0xe8, 0x37, 0x48, 0xc1, 0x47, 0x43, 0x85, 0x4b, 0xc5, 0xe5, 0x70,
-		0xe7, 0x96, 0xd9, 0x9d, 0xe8, 0x17, 0x09, 0x59, 0xaa, 0x4b, 0xfe, 0x3d, 0xff, 0x32, 0x40, 0xd0,
-		0x80, 0x1e, 0x81, 0x53, 0xcd, 0x95, 0xca, 0x7a, 0x45, 0x56, 0xd8, 0xcb, 0xed, 0x9b, 0xcd, 0x46,
-		0x7d, 0x65, 0xed, 0xc6, 0x5a, 0xbd, 0x26, 0x4d, 0xa0, 0x93, 0x80, 0xc2, 0x8d, 0xfe, 0x7b, 0x29,
-		0x27, 0x60, 0x26, 0x2c, 0x67, 0x6f, 0xc8, 0xc7, 0x49, 0xa5, 0xa8, 0x77, 0x6c, 0x03, 0xd3, 0x47,
-		0x7f, 0x8a, 0x2e, 0xbc, 0x16, 0x5d, 0x84, 0xfc, 0xcb, 0x7f, 0xc3, 0xde, 0x9a, 0x9e, 0x0d, 0xe0,
-		0xbe, 0xcf, 0xcb, 0xeb, 0x30, 0xa3, 0x6a, 0x1a, 0xb6, 0x7b, 0x28, 0x23, 0x52, 0x35, 0x21, 0xa4,
-		0x0f, 0x33, 0x39, 0x32, 0x60, 0xbb, 0x0a, 0x29, 0x97, 0xf6, 0x3e, 0x8a, 0xe2, 0xd7, 0x39, 0x05,
-		0x57, 0x2f, 0x9b, 0x30, 0x43, 0x2a, 0x3f, 0xd5, 0xc1, 0x21, 0x33, 0x8e, 0x3e, 0x67, 0xf8, 0x47,
-		0x5f, 0x79, 0x86, 0x3e, 0xda, 0x7c, 0xac, 0x77, 0x58, 0x86, 0x84, 0x93, 0x2c, 0x71, 0xee, 0xc0,
-		0x50, 0x0c, 0x05, 0x71, 0x3f, 0x6e, 0xf0, 0xd1, 0x37, 0xfb, 0xc7, 0xfc, 0x66, 0x0b, 0xc3, 0x62,
-		0x20, 0x74, 0xa7, 0x29, 0xce, 0xca, 0x1a, 0xaa, 0xf5, 0x51, 0x73, 0xfa, 0xd5, 0xa7, 0x06, 0x57,
-		0x27, 0xf6, 0xe7, 0x02, 0x65, 0xbe, 0x1e, 0xbe, 0x8d, 0x3f, 0xf7, 0x7e, 0x33, 0x01, 0x0b, 0x5c,
-		0x79, 0x57, 0x75, 0xf1, 0xc5, 0x3b, 0xcf, 0xee, 0x62, 0x4f, 0x7d, 0xf6, 0xa2, 0x66, 0xe9, 0x22,
-		0x57, 0xcf, 0xf2, 0xe9, 0x48, 0xda, 0x97, 0x79, 0xfb, 0xfc, 0xd0, 0x07, 0x9a, 0xf3, 0xa3, 0xa7,
-		0x71, 0x69, 0x07, 0x92, 0x2b, 0x96, 0x6e, 0x92, 0x54, 0xd5, 0xc2, 0xa6, 0xd5, 0xe1, 0xb3, 0x87,
-		0x5d, 0xa0, 0x67, 0x21, 0xa5, 0x76, 0xac, 0xae, 0xe9, 0xb1, 0x99, 0x53, 0x3d, 0xfd, 0x8d, 0x37,
-		0x17, 0x27, 0xfe, 0xdd, 0x9b, 0x8b, 0x89, 0x35, 0xd3, 0xfb, 0x8d, 0xaf, 0x5e, 0x00, 0x4e, 0xb5,
-		0x66, 0x7a, 0x32, 0x57, 0x2c, 0x27, 0xdf, 0xfe, 0xdc, 0x62, 0xac, 0xf4, 0x32, 0xa4, 0x6b, 0x58,
-		0x7b, 0x18, 0xe6, 0x1a, 0xd6, 0x42, 0xcc, 0x35, 0xac, 0xf5, 0x31, 0x5f, 0x85, 0xcc, 0x9a, 0xe9,
-		0xb1, 0x17, 0xd1, 0x9f, 0x82, 0x84, 0x6e, 0xb2, 0x77, 0x1b, 0x8f, 0xb4, 0x8d, 0x68, 0x11, 0x60,
-		0x0d, 0x6b, 0x3e, 0xb0, 0x85, 0xb5, 0x7e, 0xe0, 0xe0, 0xad, 0x89, 0x56, 0xb5, 0xf6, 0x5b, 0xff,
-		0x79, 0x61, 0xe2, 0x8d, 0xb7, 0x16, 0x26, 0x46, 0x0e, 0x71, 0x69, 0xe4, 0x10, 0xbb, 0xad, 0x03,
-		0x96, 0x91, 0xfd, 0x91, 0xfd, 0x52, 0x12, 0xce, 0xd0, 0xef, 0x93, 0x9c, 0x8e, 0x6e, 0x7a, 0x17,
-		0x35, 0xe7, 0xd0, 0xf6, 0x2c, 0x92, 0x37, 0xad, 0x3d, 0x3e, 0xb0, 0x33, 0x41, 0xf3, 0x32, 0x6b,
-		0x1e, 0x3e, 0xac, 0xa5, 0x3d, 0x98, 0x6c, 0x10, 0x1c, 0x71, 0xb1, 0x67, 0x79, 0xaa, 0xc1, 0xd7,
-		0x1f, 0x76, 0x41, 0xa4, 0xec, 0x9b, 0xa6, 0x38, 0x93, 0xea, 0xe2, 0x73, 0x26, 0x03, 0xab, 0x7b,
-		0xec, 0xd5, 0xf0, 0x04, 0x2d, 0x5c, 0x32, 0x44, 0x40, 0xdf, 0x02, 0x9f, 0x83, 0x49, 0xb5, 0xcb,
-		0xde, 0x61, 0x48, 0x90, 0x8a, 0x86, 0x5e, 0x94, 0x6e, 0x41, 0x9a, 0x3f, 0x49, 0x45, 0x12, 0x24,
-		0x0e, 0xf0, 0x21, 0xbd, 0x4f, 0x5e, 0x26, 0xff, 0xa2, 0x65, 0x98, 0xa4, 0xc6, 0xf3, 0x6f, 0x5e,
-		0x8a, 0xcb, 0x03, 0xd6, 0x2f, 0x53, 0x23, 0x65, 0xa6, 0x56, 0xba, 0x09, 0x99, 0x9a, 0xd5, 0xd1,
-		0x4d, 0xab, 0x97, 0x2d, 0xcb, 0xd8, 0xa8, 0xcd, 0x76, 0x97, 0x47, 0x85, 0xcc, 0x2e, 0xd0, 0x49,
-		0x48, 0xb1, 0x4f, 0x05, 0xf8, 0x7b, 0x18, 0xfc, 0xaa, 0xb4, 0x02, 0x69, 0xca, 0xbd, 0x65, 0x93,
-		0xe4, 0xef, 0xbf, 0x95, 0x99, 0xe5, 0x1f, 0x8e, 0x71, 0xfa, 0x78, 0x60, 0x2c, 0x82, 0x64, 0x4b,
-		0xf5, 0x54, 0xde, 0x6f, 0xfa, 0x7f, 0xe9, 0x43, 0x90, 0xe1, 0x24, 0x2e, 0xba, 0x04, 0x09, 0xcb,
-		0x76, 0xf9, 0x9b, 0x14, 0xf3, 0xa3, 0xba, 0xb2, 0x65, 0x57, 0x93, 0x24, 0x66, 0x64, 0xa2, 0x5c,
-		0x95, 0x47, 0x86, 0xc5, 0xf3, 0xa1, 0xb0, 0x08, 0x0d, 0x79, 0xe8, 0x5f, 0x36, 0xa4, 0x03, 0xe1,
-		0xe0, 0x07, 0xcb, 0xe7, 0xe3, 0xb0, 0x10, 0x6a, 0xbd, 0x83, 0x1d, 0x57, 0xb7, 0x4c, 0x16, 0x51,
-		0x3c, 0x5a, 0x50, 0xc8, 0x48, 0xde, 0x3e, 0x22, 0x5c, 0x3e, 0x08, 0x89, 0x8a, 0x6d, 0xa3, 0x79,
-		0xc8, 0xd0, 0x6b, 0xcd, 0x62, 0xf1, 0x92, 0x94, 0xfd, 0x6b, 0xd2, 0xe6, 0x5a, 0x7b, 0xde, 0x5d,
-		0xd5, 0xf1, 0xbf, 0xa6, 0x13, 0xd7, 0xa5, 0x6b, 0x90, 0x5d, 0xb1, 0x4c, 0x17, 0x9b, 0x6e, 0x97,
-		0x56, 0x36, 0xbb, 0x86, 0xa5, 0x1d, 0x70, 0x06, 0x76, 0x41, 0x1c, 0xae, 0xda, 0x36, 0x45, 0x26,
-		0x65, 0xf2, 0x2f, 0x9b, 0xb3, 0xd5, 0xe6, 0x48, 0x17, 0x5d, 0x3b, 0xbe, 0x8b, 0x78, 0x27, 0x7d,
-		0x1f, 0xfd, 0x20, 0x06, 0x8f, 0x0e, 0x4e, 0xa8, 0x03, 0x7c, 0xe8, 0x1e, 0x77, 0x3e, 0xbd, 0x0c,
-		0xd9, 0x06, 0xfd, 0xa4, 0xfd, 0x16, 0x3e, 0x44, 0xf3, 0x90, 0xc6, 0xad, 0x4b, 0x97, 0x2f, 0x3f,
-		0x7b, 0x8d, 0x45, 0xfb, 0x8b, 0x13, 0xb2, 0x10, 0xa0, 0x05, 0xc8, 0xba, 0x58, 0xb3, 0x2f, 0x5d,
-		0xbe, 0x72, 0xf0, 0x2c, 0x0b, 0xaf, 0x17, 0x27, 0xe4, 0x40, 0x54, 0xce, 0x90, 0x5e, 0xbf, 0xfd,
-		0xf9, 0xc5, 0x58, 0x75, 0x12, 0x12, 0x6e, 0xb7, 0xf3, 0x9e, 0xc6, 0xc8, 0xa7, 0x27, 0x61, 0x29,
-		0x8c, 0xa4, 0xf5, 0xdf, 0x1d, 0xd5, 0xd0, 0x5b, 0x6a, 0xf0, 0x63, 0x04, 0x52, 0xc8, 0x07, 0x54,
-		0x63, 0xc4, 0x4a, 0x71, 0xa4, 0x27, 0x4b, 0xbf, 0x12, 0x83, 0xfc, 0x6d, 0xc1, 0xdc, 0xc4, 0x1e,
-		0xba, 0x0e, 0xe0, 0xdf, 0x49, 0x4c, 0x9b, 0x47, 0x96, 0xfb, 0xef, 0xb5, 0xec, 0x63, 0xe4, 0x90,
-		0x3a, 0xba, 0x4a, 0x03, 0xd1, 0xb6, 0x5c, 0xfe, 0x85, 0x55, 0x04, 0xd4, 0x57, 0x46, 0x4f, 0x03,
-		0xa2, 0x19, 0x4e, 0xb9, 0x63, 0x79, 0xba, 0xd9, 0x56, 0x6c, 0xeb, 0x2e, 0xff, 0x6e, 0x35, 0x21,
-		0x4b, 0xb4, 0xe5, 0x36, 0x6d, 0x68, 0x10, 0x39, 0x31, 0x3a, 0xeb, 0xb3, 0x90, 0x62, 0x5d, 0x6d,
-		0xb5, 0x1c, 0xec, 0xba, 0x3c, 0x89, 0x89, 0x4b, 0x74, 0x1d, 0xd2, 0x76, 0x77, 0x57, 0x11, 0x19,
-		0x23, 0x77, 0xe9, 0xd1, 0x61, 0xf3, 0x5f, 0xc4, 0x07, 0xcf, 0x00, 0x29, 0xbb, 0xbb, 0x4b, 0xa2,
-		0xe5, 0x31, 0xc8, 0x0f, 0x31, 0x26, 0x77, 0x27, 0xb0, 0x83, 0xfe, 0x92, 0x02, 0xef, 0x81, 0x62,
-		0x3b, 0xba, 0xe5, 0xe8, 0xde, 0x21, 0x7d, 0x1d, 0x2a, 0x21, 0x4b, 0xa2, 0xa1, 0xc1, 0xe5, 0xa5,
-		0x03, 0x98, 0x6e, 0xd2, 0x22, 0x2e, 0xb0, 0xfc, 0x72, 0x60, 0x5f, 0x2c, 0xda, 0xbe, 0x91, 0x96,
-		0xc5, 0x07, 0x2c, 0xab, 0x7e, 0x78, 0x64, 0x74, 0x5e, 0x3d, 0x7e, 0x74, 0xf6, 0xae, 0x76, 0xbf,
-		0x7b, 0xba, 0x67, 0x72, 0xb2, 0xe0, 0x0c, 0xa7, 0xaf, 0x71, 0x03, 0x33, 0x6a, 0x8f, 0x36, 0x7f,
-		0xf4, 0xa2, 0x3a, 0x1f, 0x91, 0x46, 0xe7, 0x23, 0xa7, 0x50, 0xe9, 0x1a, 0x4c, 0x35, 0x54, 0xc7,
-		0x6b, 0x62, 0xef, 0x45, 0xac, 0xb6, 0xb0, 0xd3, 0xbb, 0xea, 0x4e, 0x89, 0x55, 0x17, 0x41, 0x92,
-		0x2e, 0xad, 0x6c, 0xd5, 0xa1, 0xff, 0x97, 0xf6, 0x21, 0x49, 0x5f, 0x89, 0xf4, 0x57, 0x64, 0x8e,
-		0x60, 0x2b, 0x32, 0xc9, 0xa5, 0x87, 0x1e, 0x76, 0xc5, 0x31, 0x02, 0xbd, 0x40, 0xcf, 0x89, 0x75,
-		0x35, 0x71, 0xf4, 0xba, 0xca, 0x03, 0x91, 0xaf, 0xae, 0x06, 0xa4, 0xab, 0x24, 0x15, 0xaf, 0xd5,
-		0x7c, 0x43, 0x62, 0x81, 0x21, 0x68, 0x03, 0xa6, 0x6d, 0xd5, 0xf1, 0xe8, 0xd7, 0x21, 0xfb, 0xb4,
-		0x17, 0x3c, 0xd6, 0x17, 0x07, 0x67, 0x5e, 0x4f, 0x67, 0xf9, 0x5d, 0xa6, 0xec, 0xb0, 0xb0, 0xf4,
-		0x5f, 0x92, 0x90, 0xe2, 0xce, 0xf8, 0x20, 0xa4, 0xb9, 0x5b, 0x79, 0x74, 0x9e, 0x59, 0x1e, 0x5c,
-		0x98, 0x96, 0xfd, 0x05, 0x84, 0xf3, 0x09, 0x0c, 0x7a, 0x02, 0x32, 0xda, 0xbe, 0xaa, 0x9b, 0x8a,
-		0xde, 0xe2, 0x05, 0x61, 0xee, 0xad, 0x37, 0x17, 0xd3, 0x2b, 0x44, 0xb6, 0x56, 0x93, 0xd3, 0xb4,
-		0x71, 0xad, 0x45, 0x2a, 0x81, 0x7d, 0xac, 0xb7, 0xf7, 0x3d, 0x3e, 0xc3, 0xf8, 0x15, 0x7a, 0x1e,
-		0x92, 0x24, 0x20, 0xf8, 0xb7, 0x83, 0xf3, 0x03, 0x15, 0xbe, 0xbf, 0x85, 0xae, 0x66, 0xc8, 0x8d,
-		0x3f, 0xf9, 0x9f, 0x16, 0x63, 0x32, 0x45, 0xa0, 0x15, 0x98, 0x32, 0x54, 0xd7, 0x53, 0xe8, 0x0a,
-		0x46, 0x6e, 0x3f, 0x49, 0x29, 0x4e, 0x0f, 0x3a, 0x84, 0x3b, 0x96, 0x9b, 0x9e, 0x23, 0x28, 0x26,
-		0x6a, 0xa1, 0xb3, 0x20, 0x51, 0x12, 0xcd, 0xea, 0x74, 0x74, 0x8f, 0xd5, 0x56, 0x29, 0xea, 0xf7,
-		0x02, 0x91, 0xaf, 0x50, 0x31, 0xad, 0xb0, 0x1e, 0x81, 0x2c, 0xfd, 0x5a, 0x89, 0xaa, 0xb0, 0xf7,
-		0x70, 0x33, 0x44, 0x40, 0x1b, 0x9f, 0x84, 0xe9, 0x20, 0x3f, 0x32, 0x95, 0x0c, 0x63, 0x09, 0xc4,
-		0x54, 0xf1, 0x19, 0x98, 0x33, 0xf1, 0x3d, 0xfa, 0x66, 0x70, 0x8f, 0x76, 0x96, 0x6a, 0x23, 0xd2,
-		0x76, 0xbb, 0x17, 0xf1, 0x38, 0x14, 0x34, 0xe1, 0x7c, 0xa6, 0x0b, 0x54, 0x77, 0xca, 0x97, 0x52,
-		0xb5, 0xd3, 0x90, 0x51, 0x6d, 0x9b, 0x29, 0xe4, 0x78, 0x7e, 0xb4, 0x6d, 0xda, 0x74, 0x1e, 0x66,
-		0x68, 0x1f, 0x1d, 0xec, 0x76, 0x0d, 0x8f, 0x93, 0xe4, 0xa9, 0xce, 0x34, 0x69, 0x90, 0x99, 0x9c,
-		0xea, 0xbe, 0x0f, 0xa6, 0xf0, 0x1d, 0xbd, 0x85, 0x4d, 0x0d, 0x33, 0xbd, 0x29, 0xaa, 0x97, 0x17,
-		0x42, 0xaa, 0x74, 0x0e, 0xfc, 0xbc, 0xa7, 0x88, 0x9c, 0x5c, 0x60, 0x7c, 0x42, 0x5e, 0x61, 0xe2,
-		0x52, 0x11, 0x92, 0x35, 0xd5, 0x53, 0x49, 0x81, 0xe1, 0xdd, 0x63, 0x0b, 0x4d, 0x5e, 0x26, 0xff,
-		0x96, 0xde, 0x8e, 0x43, 0xf2, 0xb6, 0xe5, 0x61, 0xf4, 0x81, 0x50, 0x01, 0x58, 0x18, 0x16, 0xcf,
-		0x4d, 0xbd, 0x6d, 0xe2, 0xd6, 0x86, 0xdb, 0x0e, 0xfd, 0xb4, 0x40, 0x10, 0x4e, 0xf1, 0x9e, 0x70,
-		0x9a, 0x83, 0x49, 0xc7, 0xea, 0x9a, 0x2d, 0xf1, 0x0a, 0x2b, 0xbd, 0x40, 0x75, 0xc8, 0xf8, 0x51,
-		0x92, 0x8c, 0x8a, 0x92, 0x69, 0x12, 0x25, 0x24, 0x86, 0xb9, 0x40, 0x4e, 0xef, 0xf2, 0x60, 0xa9,
-		0x42, 0xd6, 0x4f, 0x5e, 0x3c, 0xda, 0xc6, 0x0b, 0xd8, 0x00, 0x46, 0x16, 0x13, 0x7f, 0xec, 0x7d,
-		0xe7, 0xb1, 0x88, 0x93, 0xfc, 0x06, 0xee, 0xbd, 0x9e, 0xb0, 0xe2, 0x3f, 0x73, 0x90, 0xa6, 0xfd,
-		0x0a, 0xc2, 0x8a, 0xfd, 0xd4, 0xc1, 0xa3, 0x90, 0x75, 0xf5, 0xb6, 0xa9, 0x7a, 0x5d, 0x07, 0xf3,
-		0xc8, 0x0b, 0x04, 0xa5, 0xaf, 0xc7, 0x20, 0xc5, 0x22, 0x39, 0xe4, 0xb7, 0xd8, 0x70, 0xbf, 0xc5,
-		0x47, 0xf9, 0x2d, 0xf1, 0xf0, 0x7e, 0xab, 0x00, 0xf8, 0xc6, 0xb8, 0xfc, 0xeb, 0xf3, 0x21, 0x15,
-		0x03, 0x33, 0xb1, 0xa9, 0xb7, 0xf9, 0x44, 0x0d, 0x81, 0x4a, 0xff, 0x31, 0x46, 0x8a, 0x58, 0xde,
-		0x8e, 0x2a, 0x30, 0x25, 0xec, 0x52, 0xf6, 0x0c, 0xb5, 0xcd, 0x63, 0xe7, 0xcc, 0x48, 0xe3, 0x6e,
-		0x18, 0x6a, 0x5b, 0xce, 0x71, 0x7b, 0xc8, 0xc5, 0xf0, 0x71, 0x88, 0x8f, 0x18, 0x87, 0x9e, 0x81,
-		0x4f, 0x3c, 0xdc, 0xc0, 0xf7, 0x0c, 0x51, 0xb2, 0x7f, 0x88, 0xbe, 0x12, 0xa7, 0x9b, 0x19, 0xdb,
-		0x72, 0x55, 0xe3, 0x87, 0x31, 0x23, 0x1e, 0x81, 0xac, 0x6d, 0x19, 0x0a, 0x6b, 0x61, 0xaf, 0x76,
-		0x67, 0x6c, 0xcb, 0x90, 0x07, 0x86, 0x7d, 0xf2, 0x5d, 0x9a, 0x2e, 0xa9, 0x77, 0xc1, 0x6b, 0xe9,
-		0x7e, 0xaf, 0x39, 0x90, 0x67, 0xae, 0xe0, 0x6b, 0xd9, 0x33, 0xc4, 0x07, 0x74, 0x71, 0x8c, 0x0d,
-		0xae, 0xbd, 0xcc, 0x6c, 0xa6, 0x29, 0x73, 0x3d, 0x82, 0x60, 0xa9, 0x7f, 0xd8, 0x2e, 0x38, 0x1c,
-		0x96, 0x32, 0xd7, 0x2b, 0xfd, 0x4c, 0x0c, 0x60, 0x9d, 0x78, 0x96, 0xf6, 0x97, 0xac, 0x42, 0x2e,
-		0x35, 0x41, 0xe9, 0xb9, 0xf3, 0xc2, 0xa8, 0x41, 0xe3, 0xf7, 0xcf, 0xbb, 0x61, 0xbb, 0x57, 0x60,
-		0x2a, 0x08, 0x46, 0x17, 0x0b, 0x63, 0x16, 0x8e, 0xa8, 0xaa, 0x9b, 0xd8, 0x93, 0xf3, 0x77, 0x42,
-		0x57, 0xa5, 0x5f, 0x8b, 0x41, 0x96, 0xda, 0xb4, 0x81, 0x3d, 0xb5, 0x67, 0x0c, 0x63, 0x0f, 0x3f,
-		0x86, 0x67, 0x00, 0x18, 0x8d, 0xab, 0xbf, 0x8e, 0x79, 0x64, 0x65, 0xa9, 0xa4, 0xa9, 0xbf, 0x8e,
-		0xd1, 0x15, 0xdf, 0xe1, 0x89, 0xa3, 0x1d, 0x2e, 0xaa, 0x6e, 0xee, 0xf6, 0x53, 0x90, 0xa6, 0xbf,
-		0xd6, 0x74, 0xcf, 0xe5, 0x85, 0x74, 0xca, 0xec, 0x76, 0xb6, 0xef, 0xb9, 0xa5, 0xd7, 0x20, 0xbd,
-		0x7d, 0x8f, 0x9d, 0x8d, 0x3c, 0x02, 0x59, 0xc7, 0xb2, 0xf8, 0x9a, 0xcc, 0x6a, 0xa1, 0x0c, 0x11,
-		0xd0, 0x25, 0x48, 0x9c, 0x07, 0xc4, 0x83, 0xf3, 0x80, 0xe0, 0x40, 0x23, 0x31, 0xd6, 0x81, 0xc6,
-		0xf9, 0xdf, 0x8c, 0x41, 0x2e, 0x94, 0x1f, 0xd0, 0xb3, 0x70, 0xa2, 0xba, 0xbe, 0xb5, 0x72, 0x4b,
-		0x59, 0xab, 0x29, 0x37, 0xd6, 0x2b, 0xab, 0xc1, 0xc7, 0x4b, 0xf3, 0x27, 0xef, 0x3f, 0x58, 0x42,
-		0x21, 0xdd, 0x1d, 0x93, 0x9e, 0xd3, 0xa3, 0x8b, 0x30, 0xd7, 0x0b, 0xa9, 0x54, 0x9b, 0xf5, 0xcd,
-		0x6d, 0x29, 0x36, 0x7f, 0xe2, 0xfe, 0x83, 0xa5, 0x99, 0x10, 0xa2, 0xb2, 0xeb, 0x62, 0xd3, 0x1b,
-		0x04, 0xac, 0x6c, 0x6d, 0x6c, 0xac, 0x6d, 0x4b, 0xf1, 0x01, 0x00, 0x4f, 0xd8, 0xe7, 0x60, 0xa6,
-		0x17, 0xb0, 0xb9, 0xb6, 0x2e, 0x25, 0xe6, 0xd1, 0xfd, 0x07, 0x4b, 0x85, 0x90, 0xf6, 0xa6, 0x6e,
-		0xcc, 0x67, 0x3e, 0xf1, 0x85, 0x85, 0x89, 0x5f, 0xfa, 0xc5, 0x85, 0x18, 0xe9, 0xd9, 0x54, 0x4f,
-		0x8e, 0x40, 0x4f, 0xc3, 0xa9, 0xe6, 0xda, 0xea, 0x66, 0xbd, 0xa6, 0x6c, 0x34, 0x57, 0xc5, 0x49,
-		0xb7, 0xe8, 0xdd, 0xf4, 0xfd, 0x07, 0x4b, 0x39, 0xde, 0xa5, 0x51, 0xda, 0x0d, 0xb9, 0x7e, 0x7b,
-		0x6b, 0xbb, 0x2e, 0xc5, 0x98, 0x76, 0xc3, 0xc1, 0x77, 0x2c, 0x8f, 0xfd, 0x9c, 0xdb, 0x33, 0x70,
-		0x7a, 0x88, 0xb6, 0xdf, 0xb1, 0x99, 0xfb, 0x0f, 0x96, 0xa6, 0x1a, 0x0e, 0x66, 0xf3, 0x87, 0x22,
-		0x96, 0xa1, 0x38, 0x88, 0xd8, 0x6a, 0x6c, 0x35, 0x2b, 0xeb, 0xd2, 0xd2, 0xbc, 0x74, 0xff, 0xc1,
-		0x52, 0x5e, 0x24, 0x43, 0xa2, 0x1f, 0xf4, 0xec, 0xbd, 0xdc, 0xf1, 0x7c, 0xfc, 0x19, 0x38, 0xe3,
-		0x7a, 0xea, 0x81, 0x6e, 0xb6, 0xfd, 0x53, 0x5b, 0x7e, 0xcd, 0xb7, 0x3c, 0x67, 0x0c, 0xfd, 0x23,
-		0x5d, 0xbd, 0x25, 0x84, 0xe2, 0x6f, 0xc4, 0x11, 0xee, 0xc8, 0x27, 0x97, 0xf3, 0x11, 0x0f, 0xf5,
-		0xa2, 0xb7, 0x4e, 0xa3, 0x8f, 0x87, 0xe7, 0x23, 0x0e, 0xa1, 0xe7, 0x8f, 0xdc, 0xdc, 0x95, 0x3e,
-		0x19, 0x83, 0xc2, 0x8b, 0xba, 0xeb, 0x59, 0x8e, 0xae, 0xa9, 0x06, 0xfd, 0x64, 0xe9, 0xca, 0xb8,
-		0xb9, 0xb5, 0x6f, 0xaa, 0xdf, 0x80, 0xd4, 0x1d, 0xd5, 0x60, 0x49, 0x8d, 0x7d, 0x15, 0x76, 0xa4,
-		0x17, 0x83, 0x0c, 0x27, 0x78, 0x18, 0xba, 0xf4, 0xe5, 0x38, 0x4c, 0xd3, 0x39, 0xe1, 0xb2, 0x1f,
-		0xe5, 0x22, 0x5b, 0xad, 0x06, 0x24, 0x1d, 0xd5, 0xe3, 0x67, 0x87, 0xd5, 0x1f, 0xe1, 0xc7, 0xc1,
-		0x4f, 0x44, 0x1f, 0xea, 0x2e, 0x0f, 0x9e, 0x18, 0x53, 0x26, 0xf4, 0x12, 0x64, 0x3a, 0xea, 0x3d,
-		0x85, 0xb2, 0xc6, 0xdf, 0x05, 0xd6, 0x74, 0x47, 0xbd, 0x47, 0x6c, 0x45, 0x2d, 0x98, 0x26, 0xc4,
-		0xda, 0xbe, 0x6a, 0xb6, 0x31, 0xe3, 0x4f, 0xbc, 0x0b, 0xfc, 0x53, 0x1d, 0xf5, 0xde, 0x0a, 0xe5,
-		0x24, 0x77, 0x29, 0x67, 0x3e, 0xf5, 0xb9, 0xc5, 0x09, 0x7a, 0xda, 0xfe, 0x6b, 0x31, 0x80, 0xc0,
-		0x5d, 0x48, 0x03, 0x49, 0xf3, 0xaf, 0xe8, 0xed, 0x5d, 0x3e, 0x8e, 0xcb, 0x11, 0xe3, 0xd1, 0xe7,
-		0x73, 0xb6, 0x4c, 0x7f, 0xf3, 0xcd, 0xc5, 0x98, 0x3c, 0xad, 0xf5, 0x0d, 0x47, 0x1d, 0x72, 0x5d,
-		0xbb, 0xa5, 0x7a, 0x58, 0xa1, 0x5b, 0xba, 0xf8, 0x31, 0x96, 0x7c, 0x60, 0x40, 0xd2, 0x14, 0xea,
-		0xc4, 0x97, 0x63, 0x90, 0xab, 0x85, 0x1e, 0xf9, 0x15, 0x21, 0xdd, 0xb1, 0x4c, 0xfd, 0x80, 0x07,
-		0x61, 0x56, 0x16, 0x97, 0x68, 0x1e, 0x32, 0xec, 0xd3, 0x4d, 0xef, 0x50, 0x9c, 0x7f, 0x8a, 0x6b,
-		0x82, 0xba, 0x8b, 0x77, 0x5d, 0x5d, 0xb8, 0x5c, 0x16, 0x97, 0x64, 0x23, 0xe3, 0x62, 0xad, 0xeb,
-		0xe8, 0xde, 0xa1, 0xa2, 0x59, 0xa6, 0xa7, 0x6a, 0x1e, 0xff, 0x08, 0x70, 0x5a, 0xc8, 0x57, 0x98,
-		0x98, 0x90, 0xb4, 0xb0, 0xa7, 0xea, 0x86, 0x5b, 0x64, 0x8f, 0xc5, 0xc4, 0x65, 0xc8, 0xdc, 0xff,
-		0x93, 0x0e, 0x1f, 0x58, 0xad, 0x80, 0x64, 0xd9, 0xd8, 0xe9, 0x29, 0x30, 0x59, 0xa0, 0x16, 0x7f,
-		0xe3, 0xab, 0x17, 0xe6, 0xf8, 0x20, 0xf2, 0x12, 0x93, 0xbd, 0xe5, 0x2a, 0x4f, 0x0b, 0x84, 0xa8,
-		0x3c, 0x5f, 0x21, 0xe3, 0x26, 0x76, 0x7f, 0x76, 0x77, 0x37, 0x38, 0xe4, 0x9a, 0x1b, 0xf0, 0x6b,
-		0xc5, 0x3c, 0xac, 0x16, 0x7f, 0x3d, 0xa0, 0x0e, 0x4e, 0x96, 0x6e, 0xe1, 0x43, 0x32, 0x5a, 0x9c,
-		0xa7, 0x41, 0x69, 0x48, 0xc1, 0xf8, 0x9a, 0xaa, 0x1b, 0xe2, 0x8b, 0x74, 0x99, 0x5f, 0xa1, 0x0a,
-		0xa4, 0x5c, 0x4f, 0xf5, 0xba, 0x2e, 0xff, 0xe5, 0xb8, 0x73, 0x11, 0x01, 0x52, 0xb5, 0xcc, 0x56,
-		0x93, 0x02, 0x64, 0x0e, 0x44, 0xdb, 0x90, 0xf2, 0xac, 0x03, 0x6c, 0x72, 0x5f, 0x1d, 0x2b, 0xc6,
-		0x87, 0x3c, 0xa0, 0x62, 0x5c, 0xa8, 0x0d, 0x52, 0x0b, 0x1b, 0xb8, 0xcd, 0xaa, 0xa4, 0x7d, 0x95,
-		0x6c, 0x26, 0x52, 0xef, 0xc2, 0x1c, 0x9a, 0xf6, 0x59, 0x9b, 0x94, 0x14, 0xc9, 0xbd, 0xcf, 0x9e,
-		0xd9, 0xaf, 0x2d, 0x9e, 0x8f, 0x70, 0x43, 0x28, 0x4e, 0xc5, 0x41, 0x43, 0xf8, 0x69, 0xf5, 0x39,
-		0x90, 0xba, 0xe6, 0xae, 0x65, 0xd2, 0xaf, 0x48, 0x79, 0xa1, 0x9e, 0xa1, 0xa5, 0xcf, 0xb4, 0x2f,
-		0x7f, 0x91, 0x55, 0xec, 0xb7, 0xa0, 0x10, 0xa8, 0xd2, 0x99, 0x94, 0x3d, 0xc6, 0x4c, 0x9a, 0xf2,
-		0xb1, 0xa4, 0x15, 0x6d, 0x01, 0x04, 0xd3, 0x94, 0x1e, 0x1d, 0xe4, 0x22, 0x47, 0x34, 0x98, 0xf2,
-		0x62, 0x27, 0x16, 0x50, 0xa0, 0x8f, 0xc2, 0x2c, 0x3b, 0xc3, 0xc5, 0xf7, 0x70, 0xc7, 0xf6, 0xc4,
-		0x40, 0xd0, 0xdf, 0xf9, 0xa9, 0xae, 0x1f, 0x6f, 0x20, 0x7e, 0xff, 0xcd, 0xc5, 0xf9, 0x43, 0xb5,
-		0x63, 0x94, 0x4b, 0x43, 0x28, 0x4b, 0xf2, 0x0c, 0x95, 0xd6, 0xa9, 0x90, 0x0f, 0xcd, 0xc7, 0x63,
-		0x70, 0x92, 0xe9, 0xd2, 0xa0, 0xa0, 0x5f, 0x63, 0x70, 0x0b, 0xe8, 0xaf, 0x9f, 0x56, 0xb7, 0x8e,
-		0x6d, 0xc1, 0x99, 0xb0, 0x05, 0xfd, 0xac, 0x25, 0x79, 0x8e, 0x36, 0x6c, 0x0b, 0x39, 0xb3, 0xa3,
-		0x9c, 0xff, 0xc4, 0xe7, 0x16, 0x27, 0xf8, 0xc4, 0x9f, 0x28, 0x35, 0xe8, 0xe9, 0x3a, 0x9f, 0xb3,
-		0xd8, 0x45, 0x57, 0x20, 0xab, 0x8a, 0x0b, 0x7a, 0xe6, 0x71, 0xd4, 0x9c, 0x0f, 0x54, 0x59, 0x2a,
-		0x79, 0xe3, 0x3f, 0x2c, 0xc5, 0x4a, 0xbf, 0x18, 0x83, 0x54, 0xed, 0x76, 0x43, 0xd5, 0x1d, 0x54,
-		0x87, 0x99, 0x20, 0xec, 0xc7, 0x4d, 0x24, 0xc1, 0x4c, 0x11, 0x99, 0xa4, 0x3e, 0x6a, 0xc3, 0x7b,
-		0x24, 0x4d, 0xff, 0x56, 0xb8, 0xaf, 0xe3, 0xeb, 0x90, 0x66, 0x56, 0xd2, 0x9f, 0x57, 0xb1, 0xc9,
-		0x3f, 0xfc, 0x61, 0xc2, 0xe3, 0x51, 0xd3, 0x85, 0xc2, 0xfc, 0x33, 0x50, 0x82, 0x2c, 0xfd, 0x20,
-		0x06, 0x50, 0xbb, 0x7d, 0x7b, 0xdb, 0xd1, 0x6d, 0x03, 0x7b, 0xef, 0x56, 0xc7, 0xd7, 0xe1, 0x44,
-		0x68, 0x73, 0xe5, 0x68, 0x63, 0x77, 0x7e, 0x36, 0xd8, 0x5e, 0x39, 0xda, 0x50, 0xb6, 0x96, 0xeb,
-		0xf9, 0x6c, 0x89, 0xb1, 0xd9, 0x6a, 0xae, 0x37, 0xdc, 0x9b, 0xaf, 0x42, 0x2e, 0xe8, 0xbe, 0x8b,
-		0x6e, 0x41, 0xc6, 0xe3, 0xff, 0x73, 0xa7, 0x9e, 0x8b, 0x74, 0xaa, 0x40, 0x73, 0xc7, 0xfa, 0x04,
-		0xa5, 0x9f, 0x89, 0x03, 0xd4, 0x98, 0x6b, 0xc8, 0x2c, 0xfe, 0x23, 0x15, 0x54, 0x64, 0xbd, 0xe0,
-		0x93, 0xf8, 0xdd, 0xa8, 0x89, 0x38, 0x17, 0x59, 0xe0, 0x58, 0x42, 0x29, 0xb2, 0xcf, 0x21, 0xf8,
-		0x55, 0x9f, 0xd3, 0xef, 0xc7, 0x61, 0x76, 0x47, 0xa4, 0xcc, 0x3f, 0xb2, 0x1e, 0x7a, 0x09, 0xd2,
-		0xd8, 0xf4, 0x1c, 0x9d, 0xba, 0x88, 0x84, 0xc2, 0xd5, 0x88, 0x50, 0x18, 0xd2, 0x25, 0xfa, 0xd3,
-		0x4c, 0xe2, 0xfc, 0x9e, 0xb3, 0xf5, 0x39, 0xe3, 0xdf, 0xc7, 0xa1, 0x38, 0x0a, 0x89, 0x9e, 0x84,
-		0x69, 0xcd, 0xc1, 0x54, 0xa0, 0xf4, 0x1c, 0x22, 0x16, 0x84, 0x98, 0x2f, 0x60, 0x1b, 0x40, 0x4a,
-		0x43, 0x12, 0x77, 0x44, 0xf5, 0xd8, 0xb5, 0x60, 0x21, 0x00, 0xd3, 0x25, 0x0c, 0xc3, 0xb4, 0x6e,
-		0xea, 0x9e, 0xae, 0x1a, 0xca, 0xae, 0x6a, 0xa8, 0xa6, 0xf6, 0x30, 0xa5, 0xf3, 0x60, 0x59, 0x51,
-		0xe0, 0xa4, 0x55, 0xc6, 0x89, 0x6e, 0x43, 0x5a, 0xd0, 0x27, 0xdf, 0x05, 0x7a, 0x41, 0x16, 0xaa,
-		0x0f, 0xff, 0x6d, 0x1c, 0x66, 0x64, 0xdc, 0xfa, 0xe3, 0xe5, 0xd6, 0x1f, 0x05, 0x60, 0xf3, 0x91,
-		0x64, 0xcb, 0x87, 0xf0, 0xec, 0xe0, 0xfc, 0xce, 0x32, 0xbe, 0x9a, 0xeb, 0x85, 0x7c, 0xfb, 0xad,
-		0x38, 0xe4, 0xc3, 0xbe, 0xfd, 0x63, 0xb0, 0x7a, 0xa0, 0x46, 0x90, 0x14, 0xd8, 0xa1, 0xfa, 0x33,
-		0x11, 0x49, 0x61, 0x20, 0xf8, 0x8e, 0xce, 0x06, 0x6f, 0x27, 0x20, 0xd5, 0x50, 0x1d, 0xb5, 0xe3,
-		0xa2, 0x9b, 0x03, 0x35, 0xa9, 0x38, 0x54, 0x1c, 0xf8, 0x05, 0x71, 0x7e, 0x86, 0xc1, 0x22, 0xef,
-		0x53, 0x43, 0x4a, 0xd2, 0xc7, 0xa1, 0x40, 0xb6, 0xc2, 0xa1, 0xf7, 0x0f, 0xe2, 0xf4, 0xa9, 0x2a,
-		0xd9, 0xcb, 0x06, 0x0f, 0xbf, 0xd0, 0x22, 0xe4, 0x88, 0x5a, 0x90, 0xf6, 0x88, 0x0e, 0x74, 0xd4,
-		0x7b, 0x75, 0x26, 0x41, 0x17, 0x00, 0xed, 0xfb, 0x67, 0x14, 0x4a, 0xe0, 0x09, 0xa2, 0x37, 0x13,
-		0xb4, 0x08, 0xf5, 0x33, 0x00, 0xc4, 0x0a, 0x85, 0xbd, 0xd3, 0xc6, 0x36, 0x71, 0x59, 0x22, 0xa9,
-		0xd1, 0xf7, 0xda, 0x3e, 0x0a, 0xb3, 0x1d, 0xdd, 0x54, 0xfa, 0x76, 0xc9, 0x7c, 0x83, 0xf1, 0xd0,
-		0x75, 0xed, 0x10, 0xca, 0x92, 0x3c, 0xd3, 0xd1, 0xcd, 0xde, 0x6d, 0x35, 0xf2, 0x40, 0x62, 0xab,
-		0x13, 0xd1, 0xda, 0x53, 0x35, 0xcf, 0x72, 0xd8, 0x8f, 0x5e, 0x57, 0xd7, 0x8e, 0x7d, 0xeb, 0x53,
-		0xec, 0xd6, 0xfd, 0x7c, 0x25, 0x79, 0xda, 0x17, 0xdd, 0xa0, 0x92, 0xd0, 0xf4, 0xf9, 0x52, 0x0c,
-		0x50, 0x90, 0xef, 0x65, 0xec, 0xda, 0x64, 0xb7, 0x48, 0x76, 0x0f, 0x41, 0xc4, 0xf0, 0x21, 0x8f,
-		0x2c, 0x42, 0x7c, 0x80, 0xd8, 0x3d, 0x84, 0x66, 0xe5, 0xb5, 0x20, 0xc9, 0xc6, 0x79, 0x00, 0x0d,
-		0x79, 0x1b, 0x72, 0x79, 0xc5, 0xd2, 0x05, 0x7a, 0x20, 0x8f, 0x4e, 0x94, 0xbe, 0x1d, 0x83, 0xd3,
-		0x03, 0xa1, 0xec, 0xdb, 0x8c, 0x01, 0x39, 0xa1, 0x46, 0xfe, 0x0b, 0x85, 0xcc, 0xf6, 0x87, 0x9d,
-		0x20, 0x33, 0xce, 0x40, 0xda, 0x7e, 0xaf, 0x96, 0x0b, 0xf6, 0xb2, 0xe4, 0xbf, 0x8a, 0xc1, 0x5c,
-		0xd8, 0x18, 0xbf, 0x77, 0x3b, 0x90, 0x0f, 0xdb, 0xc2, 0xfb, 0xf5, 0xd4, 0x31, 0xfa, 0xc5, 0xbb,
-		0xd4, 0x43, 0x83, 0x5e, 0x0e, 0x52, 0x09, 0x3b, 0xa6, 0x7b, 0xfe, 0xb8, 0x9e, 0x12, 0x16, 0xf6,
-		0xa7, 0x94, 0x24, 0x1d, 0xb2, 0x8f, 0xc5, 0x21, 0xd9, 0xb0, 0x2c, 0x03, 0xfd, 0x69, 0x98, 0x31,
-		0x2d, 0x4f, 0x21, 0xf3, 0x0e, 0xb7, 0x14, 0x7e, 0x4a, 0xc0, 0xd2, 0xf2, 0x87, 0x8f, 0xe7, 0xc0,
-		0xdf, 0x7e, 0x73, 0x71, 0x90, 0xaa, 0xcf, 0xab, 0xd3, 0xa6, 0xe5, 0x55, 0x69, 0xfb, 0x36, 0x3b,
-		0x43, 0x70, 0x60, 0xaa, 0xf7, 0xd6, 0x2c, 0x8d, 0x6f, 0x1c, 0xfb, 0xd6, 0x53, 0x47, 0xdd, 0x36,
-		0xbf, 0x1b, 0xba, 0x27, 0x7b, 0xa9, 0xec, 0xf7, 0xc8, 0xa8, 0xfe, 0x78, 0x0c, 0x66, 0xc5, 0x4e,
-		0x92, 0x6e, 0x24, 0x65, 0xac, 0x59, 0x4e, 0x0b, 0x15, 0x20, 0xce, 0x1f, 0xd3, 0x24, 0xe5, 0xb8,
-		0xde, 0x42, 0x73, 0x30, 0x69, 0xdd, 0x35, 0xf9, 0x3b, 0x1e, 0x59, 0x99, 0x5d, 0xd0, 0xbc, 0x69,
-		0xb5, 0xba, 0x06, 0x56, 0x54, 0x4d, 0xa3, 0x6f, 0xe0, 0xb2, 0xe3, 0xac, 0x29, 0x26, 0xad, 0x30,
-		0x21, 0x7a, 0x14, 0xb2, 0x7e, 0x6a, 0xe5, 0xa7, 0x59, 0x81, 0x80, 0x85, 0xd7, 0xf9, 0xaf, 0xc5,
-		0x00, 0x82, 0x73, 0x1b, 0xf4, 0x34, 0x9c, 0xaa, 0x6e, 0x6d, 0xd6, 0x94, 0xe6, 0x76, 0x65, 0x7b,
-		0xa7, 0xd9, 0xfb, 0x0e, 0xbc, 0x78, 0x3a, 0xe0, 0xda, 0x58, 0xd3, 0xf7, 0x74, 0xdc, 0x42, 0x4f,
-		0xc0, 0x5c, 0xaf, 0x36, 0xb9, 0xaa, 0xd7, 0xa4, 0xd8, 0x7c, 0xfe, 0xfe, 0x83, 0xa5, 0x0c, 0xab,
-		0x1f, 0x71, 0x0b, 0x9d, 0x85, 0x13, 0x83, 0x7a, 0x6b, 0x9b, 0xab, 0x52, 0x7c, 0x7e, 0xea, 0xfe,
-		0x83, 0xa5, 0xac, 0x5f, 0x68, 0xa2, 0x12, 0xa0, 0xb0, 0x26, 0xe7, 0x4b, 0xcc, 0xc3, 0xfd, 0x07,
-		0x4b, 0x29, 0x36, 0x7e, 0xf3, 0xc9, 0x4f, 0x7c, 0x61, 0x61, 0xa2, 0xfa, 0xca, 0xc8, 0xf3, 0xff,
-		0x17, 0x42, 0x43, 0xa7, 0x7f, 0xc4, 0xe8, 0x92, 0xd4, 0xaa, 0x9b, 0xda, 0x45, 0x16, 0xc6, 0xba,
-		0x77, 0x78, 0x81, 0x87, 0xf0, 0x05, 0xe6, 0xae, 0x8b, 0xf7, 0xc4, 0xe9, 0x7e, 0xef, 0x73, 0x80,
-		0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x30, 0x0a, 0x25, 0xfa, 0x24, 0x68, 0x00, 0x00,
+		// 9243 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7d, 0x90, 0x1c, 0xc7,
+		0x75, 0xdf, 0xcd, 0xee, 0xde, 0x7e, 0xbc, 0xdb, 0xdb, 0x9d, 0x9b, 0x3b, 0x00, 0x8b, 0x03, 0x71,
+		0x77, 0x5c, 0x8a, 0x24, 0x08, 0x8a, 0x07, 0xf2, 0x48, 0x02, 0xc4, 0x52, 0x12, 0xb3, 0x5f, 0x00,
+		0x0e, 0xbc, 0xbb, 0x5d, 0xcd, 0xee, 0x81, 0x00, 0x9d, 0x64, 0x32, 0x37, 0xdb, 0xb7, 0x37, 0xc4,
+		0xee, 0xcc, 0x68, 0x66, 0x16, 0xc0, 0xa9, 0x9c, 0x14, 0x15, 0x25, 0xb1, 0x0c, 0x45, 0x09, 0x63,
+		0x39, 0x11, 0x25, 0x1b, 0xfa, 0xb2, 0x1d, 0x39, 0x8a, 0x9c, 0xc4, 0x96, 0xa2, 0xc4, 0x49, 0x25,
+		0xa5, 0xb8, 0x2a, 0xb1, 0xa2, 0xaa, 0xa4, 0x24, 0x57, 0xc5, 0x76, 0x12, 0x9b, 0x71, 0x24, 0x95,
+		0x48, 0xc9, 0x72, 0xac, 0x28, 0x74, 0x2a, 0x29, 0xc5, 0x29, 0x57, 0x7f, 0xcd, 0xc7, 0x7e, 0xdc,
+		0xee, 0xc1, 0x90, 0x8a, 0x55, 0xfe, 0xeb, 0x76, 0x5e, 0xbf, 0xf7, 0xeb, 0xd7, 0xaf, 0x5f, 0xbf,
+		0x7e, 0xfd, 0x31, 0x73, 0xf0, 0xcd, 0x17, 0x61, 0xa5, 0x6d, 0x9a, 0xed, 0x0e, 0x3a, 0x63, 0xd9,
+		0xa6, 0x6b, 0xee, 0xf4, 0x76, 0xcf, 0xb4, 0x90, 0xa3, 0xd9, 0xba, 0xe5, 0x9a, 0xf6, 0x2a, 0xa1,
+		0x49, 0x59, 0xca, 0xb1, 0xca, 0x39, 0xf2, 0x9b, 0x30, 0x77, 0x41, 0xef, 0xa0, 0x8a, 0xc7, 0xd8,
+		0x40, 0xae, 0xf4, 0x0c, 0xc4, 0x76, 0xf5, 0x0e, 0xca, 0x09, 0x2b, 0xd1, 0x53, 0x33, 0x6b, 0x6f,
+		0x5b, 0xed, 0x13, 0x5a, 0x0d, 0x4b, 0xd4, 0x31, 0x59, 0x26, 0x12, 0xf9, 0x57, 0xa7, 0x61, 0x7e,
+		0x48, 0xa9, 0x24, 0x41, 0xcc, 0x50, 0xbb, 0x18, 0x51, 0x38, 0x95, 0x92, 0xc9, 0x6f, 0x29, 0x07,
+		0x09, 0x4b, 0xd5, 0xae, 0xab, 0x6d, 0x94, 0x8b, 0x10, 0x32, 0x7f, 0x94, 0x96, 0x00, 0x5a, 0xc8,
+		0x42, 0x46, 0x0b, 0x19, 0xda, 0x7e, 0x2e, 0xba, 0x12, 0x3d, 0x95, 0x92, 0x03, 0x14, 0xe9, 0x51,
+		0x98, 0xb3, 0x7a, 0x3b, 0x1d, 0x5d, 0x53, 0x02, 0x6c, 0xb0, 0x12, 0x3d, 0x35, 0x2d, 0x8b, 0xb4,
+		0xa0, 0xe2, 0x33, 0x3f, 0x0c, 0xd9, 0x9b, 0x48, 0xbd, 0x1e, 0x64, 0x9d, 0x21, 0xac, 0x19, 0x4c,
+		0x0e, 0x30, 0x96, 0x21, 0xdd, 0x45, 0x8e, 0xa3, 0xb6, 0x91, 0xe2, 0xee, 0x5b, 0x28, 0x17, 0x23,
+		0xad, 0x5f, 0x19, 0x68, 0x7d, 0x7f, 0xcb, 0x67, 0x98, 0x54, 0x73, 0xdf, 0x42, 0x52, 0x11, 0x52,
+		0xc8, 0xe8, 0x75, 0x29, 0xc2, 0xf4, 0x08, 0xfb, 0x55, 0x8d, 0x5e, 0xb7, 0x1f, 0x25, 0x89, 0xc5,
+		0x18, 0x44, 0xc2, 0x41, 0xf6, 0x0d, 0x5d, 0x43, 0xb9, 0x38, 0x01, 0x78, 0x78, 0x00, 0xa0, 0x41,
+		0xcb, 0xfb, 0x31, 0xb8, 0x9c, 0x54, 0x86, 0x14, 0xba, 0xe5, 0x22, 0xc3, 0xd1, 0x4d, 0x23, 0x97,
+		0x20, 0x20, 0x0f, 0x0e, 0xe9, 0x45, 0xd4, 0x69, 0xf5, 0x43, 0xf8, 0x72, 0xd2, 0x59, 0x48, 0x98,
+		0x96, 0xab, 0x9b, 0x86, 0x93, 0x4b, 0xae, 0x08, 0xa7, 0x66, 0xd6, 0xee, 0x1b, 0xea, 0x08, 0x35,
+		0xca, 0x23, 0x73, 0x66, 0x69, 0x1d, 0x44, 0xc7, 0xec, 0xd9, 0x1a, 0x52, 0x34, 0xb3, 0x85, 0x14,
+		0xdd, 0xd8, 0x35, 0x73, 0x29, 0x02, 0xb0, 0x3c, 0xd8, 0x10, 0xc2, 0x58, 0x36, 0x5b, 0x68, 0xdd,
+		0xd8, 0x35, 0xe5, 0x8c, 0x13, 0x7a, 0x96, 0x8e, 0x42, 0xdc, 0xd9, 0x37, 0x5c, 0xf5, 0x56, 0x2e,
+		0x4d, 0x3c, 0x84, 0x3d, 0x49, 0x6b, 0x90, 0x40, 0x2d, 0x1d, 0x57, 0x97, 0xcb, 0xac, 0x08, 0xa7,
+		0x32, 0x6b, 0xb9, 0x41, 0x1b, 0xd3, 0x72, 0x99, 0x33, 0xe6, 0x7f, 0x35, 0x0e, 0xd9, 0x49, 0xdc,
+		0xf2, 0x59, 0x98, 0xde, 0xc5, 0x96, 0xc9, 0x45, 0x0e, 0x63, 0x37, 0x2a, 0x13, 0x36, 0x7c, 0xfc,
+		0x2e, 0x0d, 0x5f, 0x84, 0x19, 0x03, 0x39, 0x2e, 0x6a, 0x51, 0x2f, 0x8a, 0x4e, 0xe8, 0x87, 0x40,
+		0x85, 0x06, 0xdd, 0x30, 0x76, 0x57, 0x6e, 0x78, 0x15, 0xb2, 0x9e, 0x4a, 0x8a, 0xad, 0x1a, 0x6d,
+		0xee, 0xcf, 0x67, 0xc6, 0x69, 0xb2, 0x5a, 0xe5, 0x72, 0x32, 0x16, 0x93, 0x33, 0x28, 0xf4, 0x2c,
+		0x55, 0x00, 0x4c, 0x03, 0x99, 0xbb, 0x4a, 0x0b, 0x69, 0x9d, 0x5c, 0x72, 0x84, 0x95, 0x6a, 0x98,
+		0x65, 0xc0, 0x4a, 0x26, 0xa5, 0x6a, 0x1d, 0xe9, 0xbc, 0xef, 0x9e, 0x89, 0x11, 0xde, 0xb5, 0x49,
+		0x07, 0xe6, 0x80, 0x87, 0x6e, 0x43, 0xc6, 0x46, 0x78, 0xac, 0xa0, 0x16, 0x6b, 0x59, 0x8a, 0x28,
+		0xb1, 0x3a, 0xb6, 0x65, 0x32, 0x13, 0xa3, 0x0d, 0x9b, 0xb5, 0x83, 0x8f, 0xd2, 0x03, 0xe0, 0x11,
+		0x14, 0xe2, 0x56, 0x40, 0x22, 0x57, 0x9a, 0x13, 0xb7, 0xd4, 0x2e, 0x5a, 0x7c, 0x2f, 0x64, 0xc2,
+		0xe6, 0x91, 0x16, 0x60, 0xda, 0x71, 0x55, 0xdb, 0x25, 0x5e, 0x38, 0x2d, 0xd3, 0x07, 0x49, 0x84,
+		0x28, 0x32, 0x5a, 0x24, 0x32, 0x4e, 0xcb, 0xf8, 0xa7, 0xf4, 0xe7, 0xfc, 0x06, 0x47, 0x49, 0x83,
+		0x1f, 0x1a, 0xec, 0xd1, 0x10, 0x72, 0x7f, 0xbb, 0x17, 0xcf, 0xc1, 0x6c, 0xa8, 0x01, 0x93, 0x56,
+		0x9d, 0xff, 0xf5, 0x18, 0x1c, 0x19, 0x8a, 0x2d, 0x5d, 0x85, 0x85, 0x9e, 0xa1, 0x1b, 0x2e, 0xb2,
+		0x2d, 0x1b, 0x61, 0x97, 0xa5, 0x75, 0xe5, 0x5e, 0x4f, 0x8c, 0x70, 0xba, 0xed, 0x20, 0x37, 0x45,
+		0x91, 0xe7, 0x7b, 0x83, 0x44, 0xe9, 0x1a, 0xcc, 0x60, 0xff, 0x50, 0x6d, 0x95, 0x00, 0xd2, 0xd1,
+		0xb8, 0x36, 0x59, 0x93, 0x57, 0x2b, 0xbe, 0x64, 0x29, 0xfa, 0x01, 0x21, 0x22, 0x07, 0xb1, 0xa4,
+		0x73, 0x90, 0xdc, 0x45, 0xaa, 0xdb, 0xb3, 0x91, 0x93, 0x5b, 0x23, 0xa6, 0x3c, 0x31, 0x38, 0x48,
+		0x29, 0x43, 0x03, 0xb9, 0xb2, 0xc7, 0x2c, 0xed, 0x41, 0xfa, 0x06, 0xb2, 0xf5, 0x5d, 0x5d, 0xa3,
+		0x4a, 0x45, 0x49, 0xf0, 0x79, 0x66, 0x42, 0xa5, 0xae, 0x04, 0x44, 0x1b, 0xae, 0xea, 0xa2, 0x02,
+		0x6c, 0x6f, 0x5d, 0xa9, 0xca, 0xeb, 0x17, 0xd6, 0xab, 0x15, 0x39, 0x84, 0xbc, 0xf8, 0xd3, 0x02,
+		0xcc, 0x04, 0x1a, 0x81, 0x23, 0xa1, 0xd1, 0xeb, 0xee, 0x20, 0x9b, 0x75, 0x15, 0x7b, 0x92, 0x4e,
+		0x40, 0x6a, 0xb7, 0xd7, 0xe9, 0x50, 0x7f, 0xa3, 0xd3, 0x68, 0x12, 0x13, 0xb0, 0xaf, 0xe1, 0xf0,
+		0xc6, 0x22, 0x08, 0x09, 0x6f, 0xf8, 0xb7, 0xb4, 0x08, 0x49, 0xee, 0x8f, 0xb9, 0xe9, 0x15, 0xe1,
+		0x54, 0x52, 0xf6, 0x9e, 0x69, 0x99, 0x85, 0x54, 0x17, 0xb5, 0x72, 0x71, 0x5e, 0x46, 0x9f, 0x2f,
+		0xc7, 0x92, 0x31, 0x71, 0x3a, 0xff, 0x14, 0xcc, 0x0d, 0xb4, 0x42, 0xca, 0xc2, 0x4c, 0xa5, 0x5a,
+		0xde, 0x28, 0xca, 0xc5, 0xe6, 0x7a, 0x6d, 0x4b, 0x9c, 0x92, 0x32, 0x10, 0x68, 0x98, 0x28, 0x9c,
+		0x4e, 0x25, 0xdf, 0x48, 0x88, 0x2f, 0xbf, 0xfc, 0xf2, 0xcb, 0x91, 0xfc, 0xbf, 0x89, 0xc3, 0xc2,
+		0xb0, 0xf8, 0x37, 0x34, 0x14, 0xfb, 0x8d, 0x8e, 0x86, 0x1a, 0x5d, 0x84, 0xe9, 0x8e, 0xba, 0x83,
+		0x3a, 0xb9, 0x18, 0xb1, 0xff, 0xa3, 0x13, 0x45, 0xd8, 0xd5, 0x0d, 0x2c, 0x22, 0x53, 0x49, 0xe9,
+		0x5d, 0xcc, 0x34, 0xd3, 0x04, 0xe1, 0xf4, 0x64, 0x08, 0x38, 0x2e, 0x32, 0x33, 0x9e, 0x80, 0x14,
+		0xfe, 0x4b, 0xed, 0x1e, 0xa7, 0x76, 0xc7, 0x04, 0x62, 0xf7, 0x45, 0x48, 0x92, 0x90, 0xd7, 0x42,
+		0x5e, 0x9f, 0xf0, 0x67, 0x1c, 0x24, 0x5a, 0x68, 0x57, 0xed, 0x75, 0x5c, 0xe5, 0x86, 0xda, 0xe9,
+		0x21, 0x12, 0xbc, 0x52, 0x72, 0x9a, 0x11, 0xaf, 0x60, 0x9a, 0xb4, 0x0c, 0x33, 0x34, 0x42, 0xea,
+		0x46, 0x0b, 0xdd, 0x22, 0xb3, 0xe7, 0xb4, 0x4c, 0x83, 0xe6, 0x3a, 0xa6, 0xe0, 0xea, 0x5f, 0x72,
+		0x4c, 0x83, 0x87, 0x19, 0x52, 0x05, 0x26, 0x90, 0xea, 0xcf, 0xf5, 0x4f, 0xdc, 0x27, 0x87, 0x37,
+		0x6f, 0x20, 0x2e, 0x3e, 0x0c, 0x59, 0xc2, 0xf1, 0x24, 0x1b, 0xc5, 0x6a, 0x27, 0x37, 0x47, 0xdc,
+		0x20, 0x43, 0xc9, 0x35, 0x46, 0xcd, 0x7f, 0x31, 0x02, 0x31, 0x32, 0x49, 0x64, 0x61, 0xa6, 0x79,
+		0xad, 0x5e, 0x55, 0x2a, 0xb5, 0xed, 0xd2, 0x46, 0x55, 0x14, 0x70, 0xd7, 0x13, 0xc2, 0x85, 0x8d,
+		0x5a, 0xb1, 0x29, 0x46, 0xbc, 0xe7, 0xf5, 0xad, 0xe6, 0xd9, 0xa7, 0xc4, 0xa8, 0x27, 0xb0, 0x4d,
+		0x09, 0xb1, 0x20, 0xc3, 0x93, 0x6b, 0xe2, 0xb4, 0x24, 0x42, 0x9a, 0x02, 0xac, 0x5f, 0xad, 0x56,
+		0xce, 0x3e, 0x25, 0xc6, 0xc3, 0x94, 0x27, 0xd7, 0xc4, 0x84, 0x34, 0x0b, 0x29, 0x42, 0x29, 0xd5,
+		0x6a, 0x1b, 0x62, 0xd2, 0xc3, 0x6c, 0x34, 0xe5, 0xf5, 0xad, 0x8b, 0x62, 0xca, 0xc3, 0xbc, 0x28,
+		0xd7, 0xb6, 0xeb, 0x22, 0x78, 0x08, 0x9b, 0xd5, 0x46, 0xa3, 0x78, 0xb1, 0x2a, 0xce, 0x78, 0x1c,
+		0xa5, 0x6b, 0xcd, 0x6a, 0x43, 0x4c, 0x87, 0xd4, 0x7a, 0x72, 0x4d, 0x9c, 0xf5, 0xaa, 0xa8, 0x6e,
+		0x6d, 0x6f, 0x8a, 0x19, 0x69, 0x0e, 0x66, 0x69, 0x15, 0x5c, 0x89, 0x6c, 0x1f, 0xe9, 0xec, 0x53,
+		0xa2, 0xe8, 0x2b, 0x42, 0x51, 0xe6, 0x42, 0x84, 0xb3, 0x4f, 0x89, 0x52, 0xbe, 0x0c, 0xd3, 0xc4,
+		0x0d, 0x25, 0x09, 0x32, 0x1b, 0xc5, 0x52, 0x75, 0x43, 0xa9, 0xd5, 0xf1, 0xa0, 0x29, 0x6e, 0x88,
+		0x82, 0x4f, 0x93, 0xab, 0xf5, 0x6a, 0xb1, 0x59, 0xad, 0x88, 0xd1, 0x20, 0xed, 0xdd, 0xdb, 0xeb,
+		0x72, 0xb5, 0x22, 0x46, 0xf2, 0x1a, 0x2c, 0x0c, 0x9b, 0x1c, 0x87, 0x0e, 0xa1, 0x80, 0x2f, 0x44,
+		0x46, 0xf8, 0x02, 0xc1, 0xea, 0xf7, 0x85, 0xfc, 0x37, 0x22, 0x30, 0x3f, 0x24, 0x41, 0x18, 0x5a,
+		0xc9, 0x73, 0x30, 0x4d, 0x7d, 0x99, 0x06, 0xe9, 0x47, 0x86, 0x66, 0x1a, 0xc4, 0xb3, 0x07, 0xd2,
+		0x26, 0x22, 0x17, 0x4c, 0x35, 0xa3, 0x23, 0x52, 0x4d, 0x0c, 0x31, 0xe0, 0xb0, 0x7f, 0x61, 0x60,
+		0x22, 0xa7, 0xb9, 0xce, 0xd9, 0x49, 0x72, 0x1d, 0x42, 0x3b, 0xdc, 0x84, 0x3e, 0x3d, 0x64, 0x42,
+		0x7f, 0x16, 0xe6, 0x06, 0x80, 0x26, 0x9e, 0x58, 0xdf, 0x2f, 0x40, 0x6e, 0x94, 0x71, 0xc6, 0x84,
+		0xc4, 0x48, 0x28, 0x24, 0x3e, 0xdb, 0x6f, 0xc1, 0xfb, 0x47, 0x77, 0xc2, 0x40, 0x5f, 0x7f, 0x46,
+		0x80, 0xa3, 0xc3, 0x97, 0x14, 0x43, 0x75, 0x78, 0x17, 0xc4, 0xbb, 0xc8, 0xdd, 0x33, 0x79, 0x8a,
+		0xfc, 0xd0, 0x90, 0xc4, 0x0b, 0x17, 0xf7, 0x77, 0x36, 0x93, 0x92, 0xce, 0xf7, 0xeb, 0xba, 0x3c,
+		0x6a, 0x81, 0x33, 0xa0, 0xe9, 0x4f, 0x46, 0xe0, 0xc8, 0x50, 0xf0, 0xa1, 0x8a, 0x9e, 0x04, 0xd0,
+		0x0d, 0xab, 0xe7, 0xd2, 0x34, 0x98, 0x46, 0xe2, 0x14, 0xa1, 0x90, 0xe0, 0x85, 0xa3, 0x6c, 0xcf,
+		0xf5, 0xca, 0xe9, 0x2c, 0x09, 0x94, 0x44, 0x18, 0x9e, 0xf1, 0x15, 0x8d, 0x11, 0x45, 0x97, 0x46,
+		0xb4, 0x74, 0xc0, 0x31, 0x1f, 0x07, 0x51, 0xeb, 0xe8, 0xc8, 0x70, 0x15, 0xc7, 0xb5, 0x91, 0xda,
+		0xd5, 0x8d, 0x36, 0x9d, 0x6d, 0x0b, 0xd3, 0xbb, 0x6a, 0xc7, 0x41, 0x72, 0x96, 0x16, 0x37, 0x78,
+		0x29, 0x96, 0x20, 0x0e, 0x64, 0x07, 0x24, 0xe2, 0x21, 0x09, 0x5a, 0xec, 0x49, 0xe4, 0xbf, 0x92,
+		0x82, 0x99, 0xc0, 0x02, 0x4c, 0xba, 0x1f, 0xd2, 0x2f, 0xa9, 0x37, 0x54, 0x85, 0x2f, 0xaa, 0xa9,
+		0x25, 0x66, 0x30, 0xad, 0x4e, 0x49, 0xd2, 0xe3, 0xb0, 0x40, 0x58, 0xcc, 0x9e, 0x8b, 0x6c, 0x45,
+		0xeb, 0xa8, 0x8e, 0x43, 0x8c, 0x96, 0x24, 0xac, 0x12, 0x2e, 0xab, 0xe1, 0xa2, 0x32, 0x2f, 0x91,
+		0x9e, 0x86, 0x79, 0x22, 0xd1, 0xed, 0x75, 0x5c, 0xdd, 0xea, 0x20, 0x05, 0x2f, 0xf3, 0x9d, 0x1c,
+		0x04, 0x35, 0x9b, 0xc3, 0x1c, 0x9b, 0x8c, 0x01, 0x6b, 0xe4, 0x48, 0x15, 0x38, 0x49, 0xc4, 0xda,
+		0xc8, 0x40, 0xb6, 0xea, 0x22, 0x05, 0xbd, 0xa7, 0xa7, 0x76, 0x1c, 0x45, 0x35, 0x5a, 0xca, 0x9e,
+		0xea, 0xec, 0xe5, 0x16, 0x30, 0x40, 0x29, 0x92, 0x13, 0xe4, 0xe3, 0x98, 0xf1, 0x22, 0xe3, 0xab,
+		0x12, 0xb6, 0xa2, 0xd1, 0xba, 0xa4, 0x3a, 0x7b, 0x52, 0x01, 0x8e, 0x12, 0x14, 0xc7, 0xb5, 0x75,
+		0xa3, 0xad, 0x68, 0x7b, 0x48, 0xbb, 0xae, 0xf4, 0xdc, 0xdd, 0x67, 0x72, 0x27, 0x82, 0xf5, 0x13,
+		0x0d, 0x1b, 0x84, 0xa7, 0x8c, 0x59, 0xb6, 0xdd, 0xdd, 0x67, 0xa4, 0x06, 0xa4, 0x71, 0x67, 0x74,
+		0xf5, 0xf7, 0x22, 0x65, 0xd7, 0xb4, 0xc9, 0x1c, 0x9a, 0x19, 0x12, 0x9a, 0x02, 0x16, 0x5c, 0xad,
+		0x31, 0x81, 0x4d, 0xb3, 0x85, 0x0a, 0xd3, 0x8d, 0x7a, 0xb5, 0x5a, 0x91, 0x67, 0x38, 0xca, 0x05,
+		0xd3, 0xc6, 0x0e, 0xd5, 0x36, 0x3d, 0x03, 0xcf, 0x50, 0x87, 0x6a, 0x9b, 0xdc, 0xbc, 0x4f, 0xc3,
+		0xbc, 0xa6, 0xd1, 0x36, 0xeb, 0x9a, 0xc2, 0x16, 0xe3, 0x4e, 0x4e, 0x0c, 0x19, 0x4b, 0xd3, 0x2e,
+		0x52, 0x06, 0xe6, 0xe3, 0x8e, 0x74, 0x1e, 0x8e, 0xf8, 0xc6, 0x0a, 0x0a, 0xce, 0x0d, 0xb4, 0xb2,
+		0x5f, 0xf4, 0x69, 0x98, 0xb7, 0xf6, 0x07, 0x05, 0xa5, 0x50, 0x8d, 0xd6, 0x7e, 0xbf, 0xd8, 0x39,
+		0x58, 0xb0, 0xf6, 0xac, 0x41, 0xb9, 0xd3, 0x41, 0x39, 0xc9, 0xda, 0xb3, 0xfa, 0x05, 0x1f, 0x24,
+		0x3b, 0x33, 0x36, 0xd2, 0x48, 0x8e, 0x78, 0x2c, 0xc8, 0x1e, 0x28, 0x90, 0x56, 0x41, 0xd4, 0x34,
+		0x05, 0x19, 0xea, 0x4e, 0x07, 0x29, 0xaa, 0x8d, 0x0c, 0xd5, 0xc9, 0x2d, 0x13, 0xe6, 0x98, 0x6b,
+		0xf7, 0x90, 0x9c, 0xd1, 0xb4, 0x2a, 0x29, 0x2c, 0x92, 0x32, 0xe9, 0x34, 0xcc, 0x99, 0x3b, 0x2f,
+		0x69, 0xd4, 0x23, 0x15, 0xcb, 0x46, 0xbb, 0xfa, 0xad, 0xdc, 0xdb, 0x88, 0x79, 0xb3, 0xb8, 0x80,
+		0xf8, 0x63, 0x9d, 0x90, 0xa5, 0x47, 0x40, 0xd4, 0x9c, 0x3d, 0xd5, 0xb6, 0x48, 0x48, 0x76, 0x2c,
+		0x55, 0x43, 0xb9, 0x07, 0x29, 0x2b, 0xa5, 0x6f, 0x71, 0x32, 0x1e, 0x11, 0xce, 0x4d, 0x7d, 0xd7,
+		0xe5, 0x88, 0x0f, 0xd3, 0x11, 0x41, 0x68, 0x0c, 0xed, 0x14, 0x88, 0xd8, 0x12, 0xa1, 0x8a, 0x4f,
+		0x11, 0xb6, 0x8c, 0xb5, 0x67, 0x05, 0xeb, 0x7d, 0x00, 0x66, 0xad, 0xbd, 0x60, 0xa5, 0x8f, 0xd0,
+		0xc4, 0xcd, 0xda, 0x0b, 0xd4, 0xf8, 0x14, 0x1c, 0xc5, 0x4c, 0x5d, 0xe4, 0xaa, 0x2d, 0xd5, 0x55,
+		0x03, 0xdc, 0x6f, 0x27, 0xdc, 0xd8, 0xec, 0x9b, 0xac, 0x30, 0xa4, 0xa7, 0xdd, 0xdb, 0xd9, 0xf7,
+		0x1c, 0xeb, 0x31, 0xaa, 0x27, 0xa6, 0x71, 0xd7, 0xba, 0xeb, 0x25, 0xcb, 0x0f, 0x6d, 0x81, 0x96,
+		0x2f, 0x40, 0x3a, 0x38, 0x60, 0xa4, 0x14, 0xd0, 0x21, 0x23, 0x0a, 0x38, 0x7b, 0x2a, 0xd7, 0x2a,
+		0x38, 0xef, 0x79, 0xb1, 0x2a, 0x46, 0x70, 0xfe, 0xb5, 0xb1, 0xde, 0xac, 0x2a, 0xf2, 0xf6, 0x56,
+		0x73, 0x7d, 0xb3, 0x2a, 0x46, 0x03, 0x2b, 0x82, 0xcb, 0xb1, 0xe4, 0x43, 0xe2, 0xc3, 0xf9, 0x37,
+		0xa3, 0x90, 0x09, 0x2f, 0xd7, 0xa5, 0x77, 0xc0, 0x31, 0xbe, 0x1f, 0xe7, 0x20, 0x57, 0xb9, 0xa9,
+		0xdb, 0x64, 0x24, 0x77, 0x55, 0x3a, 0xab, 0x7a, 0x8e, 0xb7, 0xc0, 0xb8, 0x1a, 0xc8, 0x7d, 0x41,
+		0xb7, 0xf1, 0x38, 0xed, 0xaa, 0xae, 0xb4, 0x01, 0xcb, 0x86, 0xa9, 0x38, 0xae, 0x6a, 0xb4, 0x54,
+		0xbb, 0xa5, 0xf8, 0x3b, 0xa1, 0x8a, 0xaa, 0x69, 0xc8, 0x71, 0x4c, 0x3a, 0x83, 0x7a, 0x28, 0xf7,
+		0x19, 0x66, 0x83, 0x31, 0xfb, 0x53, 0x4b, 0x91, 0xb1, 0xf6, 0xf9, 0x7d, 0x74, 0x94, 0xdf, 0x9f,
+		0x80, 0x54, 0x57, 0xb5, 0x14, 0x64, 0xb8, 0xf6, 0x3e, 0x49, 0xec, 0x93, 0x72, 0xb2, 0xab, 0x5a,
+		0x55, 0xfc, 0x2c, 0x5d, 0x81, 0x87, 0x7c, 0x56, 0xa5, 0x83, 0xda, 0xaa, 0xb6, 0xaf, 0x90, 0x2c,
+		0x9e, 0xec, 0x1d, 0x29, 0x9a, 0x69, 0xec, 0x76, 0x74, 0xcd, 0x75, 0x72, 0x33, 0x5e, 0x70, 0xcc,
+		0xfb, 0x12, 0x1b, 0x44, 0xe0, 0xb2, 0x63, 0x1a, 0x24, 0x79, 0x2f, 0x73, 0xee, 0x90, 0x6b, 0xa4,
+		0xdf, 0x12, 0xae, 0x11, 0xee, 0xde, 0x98, 0x38, 0x7d, 0x39, 0x96, 0x9c, 0x16, 0xe3, 0x97, 0x63,
+		0xc9, 0xb8, 0x98, 0xb8, 0x1c, 0x4b, 0x26, 0xc5, 0xd4, 0xe5, 0x58, 0x32, 0x25, 0x42, 0xfe, 0x97,
+		0x00, 0xd2, 0xc1, 0xb5, 0x08, 0x5e, 0xda, 0x69, 0x64, 0x36, 0x16, 0x48, 0xbc, 0x7e, 0xe0, 0xc0,
+		0x95, 0xcb, 0x6a, 0x19, 0x4f, 0xd3, 0x85, 0x38, 0x4d, 0xfc, 0x65, 0x2a, 0x89, 0x53, 0x24, 0x3c,
+		0x90, 0x10, 0x4d, 0xb4, 0x92, 0x32, 0x7b, 0x92, 0x2e, 0x42, 0xfc, 0x25, 0x87, 0x60, 0xc7, 0x09,
+		0xf6, 0xdb, 0x0e, 0xc6, 0xbe, 0xdc, 0x20, 0xe0, 0xa9, 0xcb, 0x0d, 0x65, 0xab, 0x26, 0x6f, 0x16,
+		0x37, 0x64, 0x26, 0x2e, 0x1d, 0x87, 0x58, 0x47, 0x7d, 0xef, 0x7e, 0x78, 0x42, 0x27, 0x24, 0x69,
+		0x15, 0xb2, 0x3d, 0x83, 0x2e, 0xe4, 0x71, 0x1f, 0x63, 0xae, 0x6c, 0x90, 0x2b, 0xe3, 0x97, 0x6e,
+		0x60, 0xfe, 0x09, 0xfd, 0xea, 0x38, 0xc4, 0xf0, 0x66, 0x75, 0x78, 0xda, 0x25, 0x24, 0xe9, 0x14,
+		0xa4, 0x5b, 0x68, 0xa7, 0xd7, 0x56, 0x6c, 0xd4, 0x52, 0x35, 0x37, 0x3c, 0xd9, 0xcc, 0x90, 0x22,
+		0x99, 0x94, 0x48, 0xcf, 0x43, 0x0a, 0xf7, 0x91, 0x41, 0xfa, 0x78, 0x8e, 0x98, 0xe0, 0xb1, 0x83,
+		0x4d, 0xc0, 0xba, 0x98, 0x0b, 0xc9, 0xbe, 0xbc, 0x74, 0x09, 0x12, 0xae, 0x6a, 0xb7, 0x91, 0xeb,
+		0xe4, 0xe6, 0x57, 0xa2, 0xa7, 0x32, 0x6b, 0xab, 0x93, 0x40, 0x35, 0x89, 0x08, 0x59, 0x46, 0x73,
+		0x71, 0xe9, 0x05, 0x10, 0xd9, 0x16, 0xad, 0xc2, 0xd6, 0xc0, 0x4e, 0x6e, 0x81, 0x38, 0xe0, 0xdb,
+		0x0f, 0x86, 0x64, 0x3b, 0xbc, 0x15, 0x2a, 0x24, 0x67, 0x51, 0xe8, 0x39, 0x3c, 0x2e, 0x8e, 0xbc,
+		0x25, 0xc6, 0xc5, 0xe2, 0x8b, 0x90, 0x09, 0x6b, 0x1d, 0xdc, 0xc9, 0x8e, 0x4e, 0xb8, 0x93, 0x8d,
+		0x17, 0x17, 0x7c, 0xb9, 0x85, 0xe7, 0x09, 0xfa, 0x90, 0x3f, 0x03, 0xd3, 0x64, 0x38, 0x48, 0x00,
+		0x6c, 0x40, 0x88, 0x53, 0x52, 0x12, 0x62, 0xe5, 0x9a, 0x8c, 0x43, 0xb2, 0x08, 0x69, 0x4a, 0x55,
+		0xea, 0xeb, 0xd5, 0x72, 0x55, 0x8c, 0xe4, 0x9f, 0x86, 0x38, 0xf5, 0x71, 0x1c, 0xae, 0x3d, 0x2f,
+		0x17, 0xa7, 0xd8, 0x23, 0xc3, 0x10, 0x78, 0xe9, 0xf6, 0x66, 0xa9, 0x2a, 0x8b, 0x91, 0xfc, 0x36,
+		0x64, 0xfb, 0xfc, 0x42, 0x3a, 0x02, 0x73, 0x72, 0xb5, 0x59, 0xdd, 0xc2, 0x2b, 0x59, 0x65, 0x7b,
+		0xeb, 0xf9, 0xad, 0xda, 0x0b, 0x78, 0x1b, 0x28, 0x44, 0xe6, 0xb1, 0x5f, 0x90, 0x16, 0x40, 0xf4,
+		0xc9, 0x8d, 0xda, 0xb6, 0x4c, 0xb4, 0xf9, 0x9b, 0x11, 0x10, 0xfb, 0x9d, 0x44, 0x3a, 0x06, 0xf3,
+		0xcd, 0xa2, 0x7c, 0xb1, 0xda, 0x54, 0xe8, 0xea, 0xdc, 0x83, 0x5e, 0x00, 0x31, 0x58, 0x70, 0x61,
+		0x9d, 0x6c, 0x3e, 0x2c, 0xc3, 0x89, 0x20, 0xb5, 0x7a, 0xb5, 0x59, 0xdd, 0x6a, 0x90, 0xca, 0x8b,
+		0x5b, 0x17, 0xf1, 0x44, 0xd4, 0x87, 0xc7, 0xf7, 0x03, 0xa2, 0x58, 0xd5, 0x30, 0x5e, 0x75, 0xa3,
+		0x22, 0xc6, 0xfa, 0xc9, 0xb5, 0xad, 0x6a, 0xed, 0x82, 0x38, 0xdd, 0x5f, 0x3b, 0xd9, 0x23, 0x88,
+		0x4b, 0x8b, 0x70, 0xb4, 0x9f, 0xaa, 0x54, 0xb7, 0x9a, 0xf2, 0x35, 0x31, 0xd1, 0x5f, 0x71, 0xa3,
+		0x2a, 0x5f, 0x59, 0x2f, 0x57, 0xc5, 0xa4, 0x74, 0x14, 0xa4, 0xb0, 0x46, 0xcd, 0x4b, 0xb5, 0x8a,
+		0x98, 0x1a, 0x16, 0x41, 0x25, 0x71, 0x3e, 0xff, 0x39, 0x01, 0xd2, 0xc1, 0xf5, 0x7a, 0xc8, 0xc9,
+		0x85, 0xb7, 0x5a, 0xf0, 0xcf, 0x7f, 0x2d, 0x02, 0x33, 0x81, 0x85, 0x3b, 0x5e, 0x71, 0xa9, 0x9d,
+		0x8e, 0x79, 0x53, 0x51, 0x3b, 0xba, 0xea, 0xb0, 0xf8, 0x0c, 0x84, 0x54, 0xc4, 0x94, 0x49, 0xe3,
+		0xe1, 0xe4, 0x53, 0x69, 0xfc, 0xae, 0xa7, 0xd2, 0xc4, 0x5b, 0x70, 0x2a, 0x9d, 0x16, 0xe3, 0xf9,
+		0xf7, 0x45, 0x40, 0xec, 0x5f, 0xca, 0xf7, 0xd9, 0x4d, 0x18, 0x65, 0xb7, 0x60, 0xfb, 0x22, 0x87,
+		0x69, 0x5f, 0xff, 0x2c, 0x13, 0x1d, 0x39, 0xcb, 0xfc, 0x48, 0xfc, 0xea, 0x3f, 0x09, 0x90, 0x09,
+		0x6f, 0x11, 0x84, 0x9a, 0x96, 0x3f, 0x4c, 0xd3, 0xc2, 0xa6, 0xbb, 0x7f, 0x94, 0xe9, 0x7e, 0x24,
+		0xed, 0xfa, 0x68, 0x14, 0x66, 0x43, 0x3b, 0x0a, 0x93, 0x6a, 0xf7, 0x1e, 0x98, 0xd3, 0x5b, 0xa8,
+		0x6b, 0x99, 0x2e, 0x3e, 0xca, 0x56, 0x3a, 0xe8, 0x06, 0xea, 0x10, 0x33, 0x64, 0x86, 0x1c, 0xd7,
+		0x85, 0x6a, 0x58, 0x5d, 0xf7, 0xe5, 0x36, 0xb0, 0x58, 0x61, 0x7e, 0xbd, 0x52, 0xdd, 0xac, 0xd7,
+		0x9a, 0xd5, 0xad, 0xf2, 0x35, 0x1e, 0x72, 0x65, 0x51, 0xef, 0x63, 0x0b, 0x19, 0xfc, 0x81, 0xb7,
+		0xc6, 0x8a, 0xa4, 0x0e, 0x62, 0x7f, 0x6b, 0x70, 0xe4, 0x1d, 0xd2, 0x1e, 0x71, 0x4a, 0x9a, 0x87,
+		0xec, 0x56, 0x4d, 0x69, 0xac, 0x57, 0xaa, 0x4a, 0xf5, 0xc2, 0x85, 0x6a, 0xb9, 0xd9, 0xa0, 0xdb,
+		0xd7, 0x1e, 0x77, 0x53, 0x8c, 0x04, 0xfb, 0xe6, 0x63, 0x51, 0x98, 0x1f, 0xa2, 0x89, 0x54, 0x64,
+		0x1b, 0x4f, 0x74, 0x2f, 0xec, 0xb1, 0x49, 0xb4, 0x5f, 0xc5, 0x4b, 0xbf, 0xba, 0x6a, 0xbb, 0x6c,
+		0x9f, 0xea, 0x11, 0xc0, 0xe6, 0x35, 0x5c, 0x9c, 0x17, 0xda, 0xec, 0x58, 0x80, 0xee, 0x46, 0x65,
+		0x7d, 0x3a, 0x3d, 0x19, 0x78, 0x3b, 0x48, 0x96, 0xe9, 0xe8, 0xae, 0x7e, 0x03, 0x9f, 0xac, 0xf3,
+		0x33, 0x04, 0xbc, 0x3b, 0x15, 0x93, 0x45, 0x5e, 0xb2, 0x6e, 0xb8, 0x1e, 0xb7, 0x81, 0xda, 0x6a,
+		0x1f, 0x37, 0xce, 0x5b, 0xa3, 0xb2, 0xc8, 0x4b, 0x3c, 0xee, 0xfb, 0x21, 0xdd, 0x32, 0x7b, 0x78,
+		0xc9, 0x4e, 0xf9, 0x70, 0xec, 0x14, 0xe4, 0x19, 0x4a, 0xf3, 0x58, 0xd8, 0x66, 0x8c, 0x7f, 0x78,
+		0x91, 0x96, 0x67, 0x28, 0x8d, 0xb2, 0x3c, 0x0c, 0x59, 0xb5, 0xdd, 0xb6, 0x31, 0x38, 0x07, 0xa2,
+		0xdb, 0x4b, 0x19, 0x8f, 0x4c, 0x18, 0x17, 0x2f, 0x43, 0x92, 0xdb, 0x01, 0x2f, 0x9c, 0xb0, 0x25,
+		0x14, 0x8b, 0xee, 0x99, 0x46, 0xf0, 0x79, 0x86, 0xc1, 0x0b, 0xef, 0x87, 0xb4, 0xee, 0x28, 0xfe,
+		0xb9, 0x7a, 0x64, 0x25, 0x72, 0x2a, 0x29, 0xcf, 0xe8, 0x8e, 0x77, 0xcc, 0x96, 0xff, 0x7f, 0x29,
+		0x00, 0xdf, 0xd9, 0xa4, 0x0f, 0x0a, 0x90, 0xa1, 0x33, 0x81, 0x65, 0x23, 0x07, 0x19, 0x1a, 0x5f,
+		0x4f, 0x3c, 0x72, 0x80, 0x8b, 0xd2, 0xec, 0xb2, 0xce, 0x04, 0x4a, 0xe7, 0x3f, 0x20, 0x08, 0xaf,
+		0x0a, 0xb1, 0x57, 0x05, 0xe1, 0xd3, 0xc2, 0xac, 0x94, 0xac, 0x5e, 0xad, 0x6f, 0xac, 0x97, 0xd7,
+		0x9b, 0xb9, 0x6f, 0x25, 0xc8, 0xf3, 0xfa, 0x26, 0x7b, 0x7e, 0x3d, 0x11, 0x2e, 0x7f, 0x23, 0x21,
+		0xcf, 0xee, 0x06, 0x91, 0xa4, 0xdd, 0xe0, 0x61, 0x7c, 0x64, 0xd4, 0xda, 0xc3, 0xd7, 0xa3, 0xca,
+		0x8e, 0xe0, 0x4b, 0x0f, 0x10, 0x15, 0xe2, 0x44, 0x85, 0x19, 0x29, 0x5e, 0xde, 0xa8, 0x35, 0xaa,
+		0x15, 0xa2, 0x40, 0x4a, 0x8a, 0xd5, 0xea, 0xd5, 0xad, 0xdc, 0xeb, 0x89, 0xc0, 0x89, 0xfd, 0x4f,
+		0x09, 0x70, 0x8c, 0x9f, 0xd7, 0xb1, 0x89, 0x10, 0x19, 0x9a, 0xd9, 0xc2, 0x5b, 0x89, 0x34, 0xb9,
+		0x7c, 0xe2, 0xa0, 0x6a, 0x65, 0x26, 0x4a, 0xcc, 0x50, 0x65, 0x82, 0xa5, 0x87, 0x07, 0xcc, 0x50,
+		0xdc, 0xaa, 0x30, 0x2d, 0x66, 0xa4, 0x78, 0xbd, 0x58, 0x7e, 0xbe, 0x5a, 0xc1, 0x7a, 0x1c, 0xb1,
+		0x87, 0xc9, 0x4b, 0xb7, 0x20, 0x8b, 0x77, 0xec, 0xb0, 0x27, 0xe8, 0x2d, 0x7a, 0x6a, 0x1a, 0x1b,
+		0x75, 0xe6, 0xe6, 0xeb, 0x82, 0xb7, 0xf0, 0xae, 0x78, 0x12, 0xa5, 0x07, 0x02, 0x4a, 0xa4, 0xa4,
+		0xd8, 0x56, 0x6d, 0xab, 0xca, 0x15, 0x20, 0xc7, 0x8c, 0xd7, 0xb0, 0x02, 0x99, 0x5e, 0x48, 0x48,
+		0xba, 0x05, 0x22, 0xdf, 0x3f, 0xf0, 0xcc, 0x30, 0x3d, 0xea, 0xc0, 0xd0, 0xaf, 0x9a, 0xed, 0x42,
+		0x78, 0x06, 0x58, 0x09, 0xd4, 0xbd, 0x20, 0x65, 0x37, 0xaa, 0x5b, 0x17, 0x9b, 0x97, 0x94, 0xba,
+		0x5c, 0x25, 0xe7, 0x3e, 0xb9, 0x6f, 0x25, 0xe4, 0x6c, 0x37, 0x2c, 0x22, 0xfd, 0x38, 0xcc, 0xd0,
+		0x64, 0x84, 0xee, 0x56, 0xd0, 0xe5, 0xe6, 0x43, 0x07, 0x55, 0x4a, 0x72, 0x11, 0xc2, 0x5d, 0x7a,
+		0x92, 0xd4, 0x17, 0xe5, 0xfd, 0x7e, 0x4c, 0x92, 0x36, 0xaa, 0x17, 0x8b, 0xe5, 0x6b, 0x4a, 0xa9,
+		0xda, 0x68, 0xe2, 0x50, 0x55, 0x93, 0xa9, 0x13, 0x82, 0x34, 0x5d, 0xdc, 0xd8, 0xa8, 0xbd, 0x80,
+		0xdb, 0x0e, 0x2f, 0x79, 0x00, 0xf9, 0x3f, 0x0f, 0xb3, 0x21, 0x4f, 0xc6, 0x89, 0x29, 0x49, 0x68,
+		0xb1, 0xd2, 0x8d, 0xea, 0x56, 0x39, 0x98, 0x48, 0xa7, 0xc1, 0xf3, 0x5c, 0x51, 0xc0, 0x4f, 0xdc,
+		0xaf, 0xc5, 0x08, 0x8e, 0x90, 0xac, 0x6a, 0xef, 0xf0, 0x29, 0x9a, 0x3f, 0x07, 0x49, 0xee, 0x9f,
+		0x38, 0x3d, 0x26, 0x59, 0x6e, 0x5f, 0x72, 0x9e, 0x04, 0xe2, 0x9c, 0xa2, 0x80, 0x97, 0x22, 0xd4,
+		0x69, 0xc5, 0x48, 0xfe, 0x0a, 0x1c, 0x19, 0xea, 0x61, 0xd2, 0x03, 0xb0, 0xcc, 0x0f, 0xbc, 0x68,
+		0xe2, 0xad, 0x54, 0xb7, 0xca, 0xb5, 0x0a, 0x5e, 0xaa, 0xf8, 0x98, 0x00, 0xcc, 0xd5, 0xa8, 0x96,
+		0xdc, 0x0d, 0xc5, 0x48, 0xbe, 0x0c, 0x99, 0xb0, 0xb7, 0x48, 0x27, 0xe0, 0xd8, 0x76, 0xf3, 0xc2,
+		0x33, 0xca, 0x95, 0xe2, 0xc6, 0x7a, 0xa5, 0xd8, 0xb7, 0x28, 0x49, 0x02, 0x71, 0x1f, 0xaa, 0x1c,
+		0x75, 0x1e, 0x31, 0x92, 0x6f, 0x40, 0xb6, 0xaf, 0xdf, 0xa5, 0xfb, 0x20, 0xc7, 0xd6, 0x07, 0xc3,
+		0xf4, 0x99, 0x87, 0x7e, 0x4f, 0xa0, 0x2b, 0xa5, 0x4a, 0x75, 0x63, 0x7d, 0x73, 0xbd, 0x49, 0x34,
+		0xbb, 0x04, 0xe0, 0xf7, 0x2b, 0x9e, 0x88, 0x2e, 0x37, 0x6a, 0x5b, 0xca, 0x05, 0xbc, 0xcc, 0x6a,
+		0x06, 0xa0, 0x52, 0x40, 0xfb, 0x51, 0x14, 0xf0, 0x6a, 0x60, 0xb0, 0xb3, 0xc5, 0xc8, 0xe9, 0x38,
+		0x9e, 0x86, 0xbe, 0x9d, 0x38, 0x1d, 0x4f, 0x7e, 0x3b, 0x21, 0x7e, 0x07, 0xff, 0xfd, 0xe0, 0x96,
+		0xf8, 0xca, 0xd6, 0xe5, 0x78, 0xf2, 0xf5, 0x84, 0xf8, 0x46, 0x22, 0xff, 0xff, 0x23, 0x20, 0xf9,
+		0xde, 0xe4, 0xad, 0x80, 0xaf, 0x42, 0xd2, 0x5b, 0x52, 0xd3, 0xbb, 0x7c, 0xef, 0x38, 0xc0, 0x09,
+		0xb9, 0x58, 0x80, 0xd4, 0xb7, 0xc4, 0xf6, 0xd0, 0xa4, 0x22, 0x64, 0xbb, 0xba, 0xa1, 0x77, 0x7b,
+		0x5d, 0x85, 0x2f, 0x5f, 0x63, 0x63, 0x96, 0xaf, 0x19, 0x26, 0xc0, 0x9e, 0x09, 0x84, 0x7a, 0x2b,
+		0x04, 0x31, 0x3d, 0x16, 0x82, 0x0a, 0xb0, 0xe7, 0xc5, 0x9f, 0x10, 0x20, 0x37, 0x4a, 0xd9, 0xbb,
+		0x5a, 0x59, 0xdf, 0x6d, 0x7e, 0x9c, 0xff, 0x4c, 0x04, 0x32, 0xe1, 0xbb, 0x6c, 0x52, 0x05, 0x92,
+		0x1d, 0x93, 0xdd, 0x13, 0xa1, 0xc6, 0x3f, 0x35, 0xe6, 0xfa, 0xdb, 0xea, 0x06, 0xe3, 0x97, 0x3d,
+		0xc9, 0xc5, 0xff, 0x28, 0x40, 0x92, 0x93, 0xa5, 0xa3, 0x10, 0xb3, 0x54, 0x77, 0x8f, 0xc0, 0x4d,
+		0x97, 0x22, 0xa2, 0x20, 0x93, 0x67, 0x4c, 0x77, 0x2c, 0x95, 0xde, 0x91, 0x61, 0x74, 0xfc, 0x8c,
+		0xf3, 0x8a, 0x0e, 0x52, 0x5b, 0xe4, 0xec, 0xc4, 0xec, 0x76, 0x91, 0xe1, 0x3a, 0x3c, 0xaf, 0x60,
+		0xf4, 0x32, 0x23, 0xe3, 0x2b, 0x95, 0xae, 0xad, 0xea, 0x9d, 0x10, 0x6f, 0x8c, 0xf0, 0x8a, 0xbc,
+		0xc0, 0x63, 0x2e, 0xc0, 0x71, 0x8e, 0xdb, 0x42, 0xae, 0xaa, 0xed, 0xa1, 0x96, 0x2f, 0x14, 0x27,
+		0x67, 0xa4, 0xc7, 0x18, 0x43, 0x85, 0x95, 0x73, 0xd9, 0xfc, 0x57, 0x23, 0x30, 0xc7, 0x4f, 0x7b,
+		0x5a, 0x9e, 0xb1, 0x36, 0x01, 0x54, 0xc3, 0x30, 0xdd, 0xa0, 0xb9, 0x06, 0x53, 0xa9, 0x01, 0xb9,
+		0xd5, 0xa2, 0x27, 0x24, 0x07, 0x00, 0x16, 0x7f, 0x5f, 0x00, 0xf0, 0x8b, 0x46, 0xda, 0x6d, 0x19,
+		0x66, 0xd8, 0x4d, 0x45, 0x72, 0xdd, 0x95, 0x6e, 0xa7, 0x00, 0x25, 0xe1, 0x73, 0x21, 0xbc, 0xd3,
+		0xb2, 0x83, 0xda, 0xba, 0xc1, 0xee, 0x9f, 0xd0, 0x07, 0x7e, 0x8c, 0x1b, 0xf3, 0xaf, 0x66, 0xc9,
+		0x90, 0x74, 0x50, 0x57, 0x35, 0x5c, 0x5d, 0x63, 0x4e, 0x7c, 0xf6, 0x50, 0xca, 0xaf, 0x36, 0x98,
+		0xb4, 0xec, 0xe1, 0xe4, 0x4f, 0x41, 0x92, 0x53, 0xbd, 0xa0, 0x35, 0x25, 0x25, 0x20, 0xda, 0xa8,
+		0xe2, 0x50, 0x4d, 0xa2, 0xc6, 0x7a, 0xb1, 0x21, 0x46, 0x4e, 0x7f, 0x47, 0x80, 0x04, 0x1f, 0x55,
+		0xf3, 0x90, 0xad, 0x56, 0xd6, 0xfb, 0x62, 0xde, 0x3c, 0x64, 0x38, 0xb1, 0x2e, 0xd7, 0x9a, 0xb5,
+		0x35, 0xf1, 0x5b, 0x89, 0x01, 0xe2, 0x93, 0xe2, 0xeb, 0x09, 0x69, 0x0e, 0xd2, 0x9c, 0xb8, 0xf6,
+		0xf8, 0xda, 0x93, 0xe2, 0x1b, 0x64, 0xeb, 0x82, 0x93, 0x9e, 0x50, 0x9a, 0x38, 0x2c, 0xd5, 0xb6,
+		0x36, 0xae, 0x89, 0x42, 0xb0, 0x60, 0x2d, 0x50, 0x10, 0x91, 0x4e, 0xc2, 0x31, 0x5e, 0x70, 0xfe,
+		0xfc, 0xf9, 0xf3, 0xe7, 0x02, 0x85, 0x77, 0x3e, 0x14, 0xef, 0x2f, 0x7e, 0x26, 0x50, 0xfc, 0xf1,
+		0xc1, 0xe2, 0xf3, 0x81, 0xe2, 0x4f, 0x7c, 0x28, 0x5e, 0xfa, 0x2b, 0x30, 0xaf, 0x99, 0xdd, 0x7e,
+		0xeb, 0x96, 0xc4, 0xbe, 0xf3, 0x60, 0xe7, 0x92, 0xf0, 0xe2, 0x63, 0x8c, 0xa9, 0x6d, 0x76, 0x54,
+		0xa3, 0xbd, 0x6a, 0xda, 0x6d, 0xff, 0x66, 0x34, 0xce, 0xc5, 0x9c, 0xc0, 0xfd, 0x68, 0x6b, 0xe7,
+		0xff, 0x08, 0xc2, 0xa7, 0x23, 0xd1, 0x8b, 0xf5, 0xd2, 0x67, 0x23, 0x8b, 0x17, 0xa9, 0x60, 0x9d,
+		0xf7, 0x9d, 0x8c, 0x76, 0x3b, 0x48, 0xc3, 0x06, 0x86, 0x3f, 0x78, 0x14, 0x16, 0xda, 0x66, 0xdb,
+		0x24, 0x48, 0x67, 0xf0, 0x2f, 0xaa, 0x84, 0x94, 0xf2, 0xa8, 0x8b, 0x63, 0xef, 0x61, 0x17, 0xb6,
+		0x60, 0x9e, 0x31, 0x2b, 0x24, 0x35, 0xa4, 0x27, 0x4f, 0xd2, 0x81, 0xd7, 0x1e, 0x72, 0xbf, 0xfc,
+		0x4d, 0xb2, 0xec, 0x96, 0xe7, 0x98, 0x28, 0x2e, 0xa3, 0x87, 0x53, 0x05, 0x19, 0x8e, 0x84, 0xf0,
+		0x68, 0x42, 0x8e, 0xec, 0x31, 0x88, 0xff, 0x96, 0x21, 0xce, 0x07, 0x10, 0x1b, 0x4c, 0xb4, 0x50,
+		0x86, 0xd9, 0xc3, 0x60, 0xfd, 0x3b, 0x86, 0x95, 0x46, 0x41, 0x90, 0x8b, 0x90, 0x25, 0x20, 0x5a,
+		0xcf, 0x71, 0xcd, 0x2e, 0x59, 0xed, 0x1c, 0x0c, 0xf3, 0xeb, 0xdf, 0xa4, 0x11, 0x2a, 0x83, 0xc5,
+		0xca, 0x9e, 0x54, 0xa1, 0x00, 0x24, 0xd1, 0xc5, 0xd7, 0xf8, 0xc6, 0x20, 0x7c, 0x99, 0x29, 0xe2,
+		0xf1, 0x17, 0xae, 0xc0, 0x02, 0xfe, 0x4d, 0x16, 0x23, 0x41, 0x4d, 0xc6, 0xdf, 0x91, 0xc8, 0x7d,
+		0xed, 0xfd, 0x34, 0x08, 0xce, 0x7b, 0x00, 0x01, 0x9d, 0x02, 0xbd, 0xd8, 0x46, 0xae, 0x8b, 0x6c,
+		0x47, 0x51, 0x3b, 0xc3, 0xd4, 0x0b, 0x1c, 0x32, 0xe7, 0x3e, 0xfa, 0xdd, 0x70, 0x2f, 0x5e, 0xa4,
+		0x92, 0xc5, 0x4e, 0xa7, 0xb0, 0x0d, 0xc7, 0x86, 0x78, 0xc5, 0x04, 0x98, 0x1f, 0x63, 0x98, 0x0b,
+		0x03, 0x9e, 0x81, 0x61, 0xeb, 0xc0, 0xe9, 0x5e, 0x5f, 0x4e, 0x80, 0xf9, 0x33, 0x0c, 0x53, 0x62,
+		0xb2, 0xbc, 0x4b, 0x31, 0xe2, 0x65, 0x98, 0xbb, 0x81, 0xec, 0x1d, 0xd3, 0x61, 0x07, 0xfb, 0x13,
+		0xc0, 0xfd, 0x2c, 0x83, 0xcb, 0x32, 0x41, 0x72, 0xd2, 0x8f, 0xb1, 0xce, 0x43, 0x72, 0x57, 0xd5,
+		0xd0, 0x04, 0x10, 0x77, 0x18, 0x44, 0x02, 0xf3, 0x63, 0xd1, 0x22, 0xa4, 0xdb, 0x26, 0x5b, 0x8f,
+		0x8e, 0x17, 0xff, 0x38, 0x13, 0x9f, 0xe1, 0x32, 0x0c, 0xc2, 0x32, 0xad, 0x5e, 0x07, 0x2f, 0x56,
+		0xc7, 0x43, 0x7c, 0x82, 0x43, 0x70, 0x19, 0x06, 0x71, 0x08, 0xb3, 0x7e, 0x92, 0x43, 0x38, 0x01,
+		0x7b, 0x3e, 0x87, 0xef, 0xfb, 0x75, 0xf6, 0x4d, 0x63, 0x12, 0x25, 0x3e, 0xc5, 0x10, 0x80, 0x89,
+		0x60, 0x80, 0x67, 0x21, 0x35, 0x69, 0x47, 0xfc, 0xc2, 0x77, 0xf9, 0xf0, 0xe0, 0x3d, 0x70, 0x11,
+		0xb2, 0x3c, 0x40, 0xe1, 0x53, 0x98, 0xf1, 0x10, 0x7f, 0x9f, 0x41, 0x64, 0x02, 0x62, 0xac, 0x19,
+		0x2e, 0x72, 0xdc, 0x36, 0x9a, 0x04, 0xe4, 0x33, 0xbc, 0x19, 0x4c, 0x84, 0x99, 0x72, 0x07, 0x19,
+		0xda, 0xde, 0x64, 0x08, 0xbf, 0xc8, 0x4d, 0xc9, 0x65, 0x30, 0x44, 0x19, 0x66, 0xbb, 0xaa, 0xed,
+		0xec, 0xa9, 0x9d, 0x89, 0xba, 0xe3, 0x1f, 0x30, 0x8c, 0xb4, 0x27, 0xc4, 0x2c, 0xd2, 0x33, 0x0e,
+		0x03, 0xf3, 0x59, 0x6e, 0x91, 0x9e, 0x11, 0x02, 0xaa, 0xc3, 0x82, 0xe3, 0x92, 0x5b, 0x10, 0x87,
+		0x41, 0xfb, 0x87, 0x7c, 0xe8, 0x51, 0xd9, 0xcd, 0x20, 0xe2, 0xb3, 0x90, 0x72, 0xf4, 0xf7, 0x4e,
+		0x04, 0xf3, 0x39, 0xde, 0xd3, 0x44, 0x00, 0x0b, 0x5f, 0x83, 0xe3, 0x43, 0xa7, 0x89, 0x09, 0xc0,
+		0x7e, 0x89, 0x81, 0x1d, 0x1d, 0x32, 0x55, 0xb0, 0x90, 0x70, 0x58, 0xc8, 0x7f, 0xc4, 0x43, 0x02,
+		0xea, 0xc3, 0xaa, 0xe3, 0x1d, 0x42, 0x47, 0xdd, 0x3d, 0x9c, 0xd5, 0xfe, 0x31, 0xb7, 0x1a, 0x95,
+		0x0d, 0x59, 0xad, 0x09, 0x47, 0x19, 0xe2, 0xe1, 0xfa, 0xf5, 0x9f, 0xf0, 0xc0, 0x4a, 0xa5, 0xb7,
+		0xc3, 0xbd, 0xfb, 0x63, 0xb0, 0xe8, 0x99, 0x93, 0x6f, 0x45, 0x39, 0x0a, 0xbe, 0x01, 0x30, 0x1e,
+		0xf9, 0x97, 0x19, 0x32, 0x8f, 0xf8, 0xde, 0x5e, 0x96, 0xb3, 0xa9, 0x5a, 0x18, 0xfc, 0x2a, 0xe4,
+		0x38, 0x78, 0xcf, 0xb0, 0x91, 0x66, 0xb6, 0x0d, 0xfd, 0xbd, 0xa8, 0x35, 0x01, 0xf4, 0xaf, 0xf4,
+		0x75, 0xd5, 0x76, 0x40, 0x1c, 0x23, 0xaf, 0x83, 0xe8, 0xe5, 0x2a, 0x8a, 0xde, 0xb5, 0x4c, 0xdb,
+		0x1d, 0x83, 0xf8, 0x79, 0xde, 0x53, 0x9e, 0xdc, 0x3a, 0x11, 0x2b, 0x54, 0x81, 0x5e, 0x0d, 0x9e,
+		0xd4, 0x25, 0xbf, 0xc0, 0x80, 0x66, 0x7d, 0x29, 0x16, 0x38, 0x34, 0xb3, 0x6b, 0xa9, 0xf6, 0x24,
+		0xf1, 0xef, 0x9f, 0xf2, 0xc0, 0xc1, 0x44, 0x58, 0xe0, 0xc0, 0x19, 0x1d, 0x9e, 0xed, 0x27, 0x40,
+		0xf8, 0x22, 0x0f, 0x1c, 0x5c, 0x86, 0x41, 0xf0, 0x84, 0x61, 0x02, 0x88, 0x7f, 0xc6, 0x21, 0xb8,
+		0x0c, 0x86, 0x78, 0xb7, 0x3f, 0xd1, 0xda, 0xa8, 0xad, 0x3b, 0x2e, 0xbb, 0xbc, 0x7f, 0x30, 0xd4,
+		0x3f, 0xff, 0x6e, 0x38, 0x09, 0x93, 0x03, 0xa2, 0x38, 0x12, 0xb1, 0xdd, 0x25, 0xb2, 0x3f, 0x3a,
+		0x5e, 0xb1, 0x5f, 0xe5, 0x91, 0x28, 0x20, 0x86, 0x75, 0x0b, 0x64, 0x88, 0xd8, 0xec, 0x1a, 0x5e,
+		0x95, 0x4d, 0x00, 0xf7, 0x2f, 0xfa, 0x94, 0x6b, 0x70, 0x59, 0x8c, 0x19, 0xc8, 0x7f, 0x7a, 0xc6,
+		0x75, 0xb4, 0x3f, 0x91, 0x77, 0xfe, 0xcb, 0xbe, 0xfc, 0x67, 0x9b, 0x4a, 0xd2, 0x18, 0x92, 0xed,
+		0xcb, 0xa7, 0xa4, 0x71, 0x2f, 0xf5, 0xe4, 0xde, 0xf7, 0x26, 0x6b, 0x6f, 0x38, 0x9d, 0x2a, 0x6c,
+		0x80, 0xc8, 0x28, 0x7e, 0x02, 0x3b, 0x16, 0xec, 0xfd, 0x6f, 0x7a, 0x7e, 0x1e, 0xca, 0x79, 0x0a,
+		0x17, 0x60, 0x36, 0x94, 0xf0, 0x8c, 0x87, 0xfa, 0x6b, 0x0c, 0x2a, 0x1d, 0xcc, 0x77, 0x0a, 0x4f,
+		0x43, 0x0c, 0x27, 0x2f, 0xe3, 0xc5, 0xff, 0x3a, 0x13, 0x27, 0xec, 0x85, 0x77, 0x42, 0x92, 0x27,
+		0x2d, 0xe3, 0x45, 0xff, 0x06, 0x13, 0xf5, 0x44, 0xb0, 0x38, 0x4f, 0x58, 0xc6, 0x8b, 0xff, 0x04,
+		0x17, 0xe7, 0x22, 0x58, 0x7c, 0x72, 0x13, 0x7e, 0xe9, 0x83, 0x31, 0x2a, 0xce, 0x45, 0x0a, 0xf8,
+		0x6a, 0x32, 0xcd, 0x54, 0xc6, 0x4b, 0xff, 0x24, 0xab, 0x9c, 0x4b, 0x14, 0xce, 0xc1, 0xf4, 0x84,
+		0x06, 0xff, 0x10, 0x13, 0xa5, 0xfc, 0x85, 0x32, 0xcc, 0x04, 0xb2, 0x93, 0xf1, 0xe2, 0x7f, 0x8b,
+		0x89, 0x07, 0xa5, 0xb0, 0xea, 0x2c, 0x3b, 0x19, 0x0f, 0xf0, 0xb7, 0xb9, 0xea, 0x4c, 0x02, 0x9b,
+		0x8d, 0x27, 0x26, 0xe3, 0xa5, 0x5f, 0xe1, 0x56, 0xe7, 0x22, 0x85, 0xe7, 0x20, 0xe5, 0x4d, 0x36,
+		0xe3, 0xe5, 0xff, 0x0e, 0x93, 0xf7, 0x65, 0xb0, 0x05, 0x7a, 0xc6, 0x21, 0x20, 0x7e, 0x8a, 0x5b,
+		0x20, 0x20, 0x85, 0x87, 0x51, 0x7f, 0x02, 0x33, 0x1e, 0xe9, 0xc3, 0x7c, 0x18, 0xf5, 0xe5, 0x2f,
+		0xb8, 0x37, 0x49, 0xcc, 0x1f, 0x0f, 0xf1, 0xd3, 0xbc, 0x37, 0x09, 0x3f, 0x56, 0xa3, 0x3f, 0x23,
+		0x18, 0x8f, 0xf1, 0x11, 0xae, 0x46, 0x5f, 0x42, 0x50, 0xa8, 0x83, 0x34, 0x98, 0x0d, 0x8c, 0xc7,
+		0x7b, 0x95, 0xe1, 0xcd, 0x0d, 0x24, 0x03, 0x85, 0x17, 0xe0, 0xe8, 0xf0, 0x4c, 0x60, 0x3c, 0xea,
+		0x47, 0xdf, 0xec, 0x5b, 0xbb, 0x05, 0x13, 0x81, 0x42, 0x13, 0x16, 0x86, 0x65, 0x01, 0xe3, 0x61,
+		0x3f, 0xf6, 0x66, 0x38, 0x70, 0x07, 0x93, 0x80, 0x42, 0x11, 0xc0, 0x9f, 0x80, 0xc7, 0x63, 0xfd,
+		0x2c, 0xc3, 0x0a, 0x08, 0xe1, 0xa1, 0xc1, 0xe6, 0xdf, 0xf1, 0xf2, 0x77, 0xf8, 0xd0, 0x60, 0x12,
+		0x78, 0x68, 0xf0, 0xa9, 0x77, 0xbc, 0xf4, 0xc7, 0xf9, 0xd0, 0xe0, 0x22, 0xd8, 0xb3, 0x03, 0xb3,
+		0xdb, 0x78, 0x84, 0x4f, 0x71, 0xcf, 0x0e, 0x48, 0x15, 0xb6, 0x60, 0x6e, 0x60, 0x42, 0x1c, 0x0f,
+		0xf5, 0x69, 0x06, 0x25, 0xf6, 0xcf, 0x87, 0xc1, 0xc9, 0x8b, 0x4d, 0x86, 0xe3, 0xd1, 0x7e, 0xae,
+		0x6f, 0xf2, 0x62, 0x73, 0x61, 0xe1, 0x59, 0x48, 0x1a, 0xbd, 0x4e, 0x07, 0x0f, 0x1e, 0xe9, 0xe0,
+		0x97, 0xb7, 0x72, 0xdf, 0xfe, 0x01, 0xb3, 0x0e, 0x17, 0x28, 0x3c, 0x0d, 0xd3, 0xa8, 0xbb, 0x83,
+		0x5a, 0xe3, 0x24, 0xbf, 0xf3, 0x03, 0x1e, 0x30, 0x31, 0x77, 0xe1, 0x39, 0x00, 0xba, 0x35, 0x42,
+		0xee, 0x38, 0x8e, 0x91, 0xfd, 0xfd, 0x1f, 0xb0, 0xb7, 0x25, 0x7c, 0x11, 0x1f, 0x80, 0xbe, 0x7b,
+		0x71, 0x30, 0xc0, 0x77, 0xc3, 0x00, 0xa4, 0x47, 0xce, 0x43, 0x02, 0x1f, 0x63, 0xb9, 0x6a, 0x7b,
+		0x9c, 0xf4, 0x1f, 0x30, 0x69, 0xce, 0x8f, 0x0d, 0xd6, 0x35, 0x6d, 0xe4, 0xaa, 0x6d, 0x67, 0x9c,
+		0xec, 0xff, 0x60, 0xb2, 0x9e, 0x00, 0x16, 0xd6, 0x54, 0xc7, 0x9d, 0xa4, 0xdd, 0x7f, 0xc8, 0x85,
+		0xb9, 0x00, 0x56, 0x1a, 0xff, 0xbe, 0x8e, 0xf6, 0xc7, 0xc9, 0x7e, 0x8f, 0x2b, 0xcd, 0xf8, 0x0b,
+		0xef, 0x84, 0x14, 0xfe, 0x49, 0x5f, 0x81, 0x1a, 0x23, 0xfc, 0x3f, 0x99, 0xb0, 0x2f, 0x81, 0x6b,
+		0x76, 0xdc, 0x96, 0xab, 0x8f, 0x37, 0xf6, 0xf7, 0x59, 0x4f, 0x73, 0xfe, 0x42, 0x11, 0x66, 0x1c,
+		0xb7, 0xd5, 0xea, 0xb1, 0xfc, 0x74, 0x8c, 0xf8, 0xff, 0xfa, 0x81, 0xb7, 0x65, 0xe1, 0xc9, 0xe0,
+		0xde, 0xbe, 0x79, 0xdd, 0xb5, 0x4c, 0x72, 0xb9, 0x61, 0x1c, 0xc2, 0x9b, 0x0c, 0x21, 0x20, 0x52,
+		0x28, 0x43, 0x1a, 0xb7, 0x85, 0x9f, 0x1a, 0x8f, 0x83, 0xf8, 0x23, 0x66, 0x80, 0x90, 0x50, 0xe9,
+		0x2f, 0x7d, 0xf9, 0xeb, 0x4b, 0xc2, 0x57, 0xbf, 0xbe, 0x24, 0xfc, 0xde, 0xd7, 0x97, 0x84, 0x57,
+		0xbe, 0xb1, 0x34, 0xf5, 0xd5, 0x6f, 0x2c, 0x4d, 0xfd, 0xf6, 0x37, 0x96, 0xa6, 0x86, 0xef, 0x12,
+		0xc3, 0x45, 0xf3, 0xa2, 0x49, 0xf7, 0x87, 0x5f, 0x7c, 0xb0, 0xad, 0xbb, 0x7b, 0xbd, 0x9d, 0x55,
+		0xcd, 0xec, 0x9e, 0xd1, 0x4c, 0xa7, 0x6b, 0x3a, 0x67, 0xc2, 0xfb, 0xba, 0xe4, 0x17, 0xfc, 0x91,
+		0x00, 0xc7, 0x29, 0x8c, 0xbf, 0x9d, 0xab, 0x1a, 0xfb, 0x23, 0xbe, 0xa7, 0xb1, 0x38, 0x74, 0x6f,
+		0x38, 0xff, 0x0e, 0x88, 0x16, 0x8d, 0x7d, 0xe9, 0x38, 0x0d, 0x7b, 0x4a, 0xcf, 0xee, 0xb0, 0xb7,
+		0x73, 0x12, 0xf8, 0x79, 0xdb, 0xee, 0x84, 0xef, 0x74, 0xa6, 0xd9, 0x9d, 0xce, 0x42, 0xec, 0x7b,
+		0x9f, 0x5a, 0x9e, 0x2a, 0x5d, 0xef, 0x6f, 0xe4, 0x97, 0xc6, 0x36, 0x34, 0x59, 0x34, 0xf6, 0x49,
+		0x3b, 0xeb, 0xc2, 0x8b, 0xd3, 0xb8, 0x0e, 0x87, 0xef, 0x6d, 0x2f, 0xf5, 0xef, 0x6d, 0xbf, 0x80,
+		0x3a, 0x9d, 0xe7, 0x0d, 0xf3, 0xa6, 0x81, 0x8f, 0x74, 0x9d, 0x9d, 0x38, 0x7d, 0xd5, 0x13, 0x3e,
+		0x1c, 0x81, 0xa5, 0xfe, 0x76, 0xf3, 0xce, 0x1f, 0xf5, 0x31, 0x91, 0x02, 0x24, 0x2b, 0xdc, 0xa7,
+		0x72, 0xf8, 0x2b, 0x16, 0x9a, 0x69, 0xb4, 0xe8, 0xbd, 0xc4, 0xa8, 0xcc, 0x1f, 0x71, 0x53, 0x0d,
+		0xd5, 0x30, 0x1d, 0xf6, 0x06, 0x1b, 0x7d, 0x28, 0xfd, 0x8c, 0x70, 0xb8, 0xae, 0x9c, 0xe5, 0x35,
+		0xf1, 0x66, 0x3e, 0x31, 0x76, 0xb7, 0xff, 0x3a, 0x6e, 0xa5, 0xd7, 0x88, 0xd0, 0x8e, 0xff, 0xa4,
+		0x56, 0xf9, 0x48, 0x04, 0x96, 0xfb, 0xad, 0x82, 0x47, 0x94, 0xe3, 0xaa, 0x5d, 0x6b, 0x94, 0x59,
+		0x9e, 0x85, 0x54, 0x93, 0xf3, 0x1c, 0xda, 0x2e, 0x77, 0x0e, 0x69, 0x97, 0x8c, 0x57, 0x15, 0x37,
+		0xcc, 0xda, 0x84, 0x86, 0xf1, 0xda, 0x71, 0x57, 0x96, 0xf9, 0xbf, 0x71, 0x38, 0x4e, 0x87, 0x91,
+		0x42, 0xdd, 0x9f, 0x3e, 0x30, 0x9b, 0xa4, 0x83, 0x45, 0xe3, 0xcf, 0x47, 0xf2, 0xcf, 0xc3, 0xfc,
+		0x3a, 0x8e, 0x12, 0x78, 0xf5, 0xe3, 0x9f, 0xec, 0x0c, 0x7d, 0xc9, 0x6f, 0x25, 0x94, 0xe8, 0xb3,
+		0x43, 0xbc, 0x20, 0x29, 0xff, 0x3e, 0x01, 0xc4, 0x86, 0xa6, 0x76, 0x54, 0xfb, 0x4f, 0x0b, 0x25,
+		0x9d, 0x03, 0xa0, 0x17, 0x6b, 0xbc, 0x2f, 0x73, 0xe0, 0x73, 0xe5, 0x60, 0xe3, 0x56, 0x69, 0x4d,
+		0xe4, 0x8e, 0x7b, 0x8a, 0xf0, 0xe2, 0x9f, 0xa7, 0xaf, 0x02, 0xf8, 0x05, 0xf8, 0x86, 0x42, 0xa3,
+		0x5c, 0xdc, 0x28, 0xca, 0xfc, 0xea, 0x44, 0xa3, 0x5e, 0x2d, 0xd3, 0xb7, 0xe5, 0xa7, 0xf0, 0x25,
+		0x80, 0x60, 0xa1, 0x77, 0x0f, 0xfb, 0x08, 0xcc, 0x05, 0xe9, 0xf4, 0xd5, 0xe5, 0x08, 0xce, 0x10,
+		0xf5, 0xae, 0xd5, 0x41, 0xe4, 0x78, 0x55, 0xd1, 0xb9, 0xd5, 0xc6, 0x27, 0x1f, 0xff, 0xfe, 0x37,
+		0xe9, 0xeb, 0xac, 0xf3, 0xbe, 0xb8, 0x67, 0xf3, 0xc2, 0x06, 0xcc, 0xe1, 0xf7, 0x64, 0xac, 0x10,
+		0xe4, 0x98, 0x10, 0x8d, 0x01, 0xc9, 0x81, 0x31, 0x93, 0xf4, 0xd1, 0xce, 0x41, 0xdc, 0x21, 0xad,
+		0x1f, 0x07, 0xf1, 0x15, 0x06, 0xc1, 0xd8, 0x0b, 0x06, 0xcc, 0xd1, 0x0f, 0x37, 0xa0, 0x80, 0x1a,
+		0x07, 0xef, 0x2f, 0xfc, 0xab, 0xcf, 0x3f, 0x4e, 0x8e, 0x8f, 0xef, 0x0f, 0x77, 0xcb, 0x10, 0x77,
+		0x92, 0x45, 0x86, 0xed, 0x2b, 0x8a, 0x20, 0xc3, 0xeb, 0x63, 0x0a, 0x1f, 0x5c, 0xd9, 0xbf, 0x66,
+		0x95, 0x2d, 0x0d, 0xf3, 0x81, 0x40, 0x4d, 0xb3, 0x0c, 0x95, 0x16, 0x94, 0xaa, 0xa3, 0xc6, 0xf4,
+		0x8b, 0x8f, 0x0e, 0xce, 0x4a, 0xf4, 0xcf, 0x63, 0x04, 0xf9, 0xd9, 0x60, 0x35, 0xde, 0xd8, 0xfb,
+		0xad, 0x28, 0x2c, 0x31, 0xe6, 0x1d, 0xd5, 0x41, 0x67, 0x6e, 0x3c, 0xb1, 0x83, 0x5c, 0xf5, 0x89,
+		0x33, 0x9a, 0xa9, 0xf3, 0x58, 0x3d, 0xcf, 0x86, 0x23, 0x2e, 0x5f, 0x65, 0xe5, 0xc3, 0x27, 0xab,
+		0xc5, 0xd1, 0xc3, 0x38, 0xbf, 0x0d, 0xb1, 0xb2, 0xa9, 0x93, 0x37, 0x10, 0x5a, 0xc8, 0x30, 0xbb,
+		0x6c, 0xf4, 0xd0, 0x07, 0xe9, 0x09, 0x88, 0xab, 0x5d, 0xb3, 0x67, 0xb8, 0x74, 0xe4, 0x94, 0x8e,
+		0x7f, 0xf9, 0xb5, 0xe5, 0xa9, 0xff, 0xf2, 0xda, 0x72, 0x74, 0xdd, 0x70, 0x7f, 0xe3, 0x0b, 0x8f,
+		0x01, 0x83, 0x5a, 0x37, 0x5c, 0x99, 0x31, 0x16, 0x62, 0x6f, 0x7c, 0x72, 0x59, 0xc8, 0x5f, 0x85,
+		0x44, 0x05, 0x69, 0x77, 0x83, 0x5c, 0x41, 0x5a, 0x00, 0xb9, 0x82, 0xb4, 0x3e, 0xe4, 0x73, 0x90,
+		0x5c, 0x37, 0x5c, 0xfa, 0x86, 0xf0, 0xa3, 0x10, 0xd5, 0x0d, 0xfa, 0xee, 0xd8, 0x81, 0xba, 0x61,
+		0x2e, 0x2c, 0x58, 0x41, 0x9a, 0x27, 0xd8, 0x42, 0x5a, 0x4e, 0x18, 0x57, 0x35, 0xe6, 0x2a, 0x55,
+		0x7e, 0xfb, 0xbf, 0x2f, 0x4d, 0xbd, 0xfc, 0xf5, 0xa5, 0xa9, 0x91, 0x5d, 0x9c, 0x1f, 0xd9, 0xc5,
+		0x4e, 0xeb, 0x3a, 0x8d, 0xc8, 0x5e, 0xcf, 0x7e, 0x36, 0x06, 0x27, 0xc9, 0x87, 0x23, 0xec, 0xae,
+		0x6e, 0xb8, 0x67, 0x34, 0x7b, 0xdf, 0x72, 0x4d, 0x1c, 0x37, 0xcd, 0x5d, 0xd6, 0xb1, 0x73, 0x7e,
+		0xf1, 0x2a, 0x2d, 0x1e, 0x91, 0x83, 0xec, 0xc2, 0x74, 0x1d, 0xcb, 0x61, 0x13, 0xbb, 0xa6, 0xab,
+		0x76, 0xd8, 0xfc, 0x43, 0x1f, 0x30, 0x95, 0x7e, 0x6c, 0x22, 0x42, 0xa9, 0x3a, 0xff, 0xce, 0x44,
+		0x07, 0xa9, 0xbb, 0xf4, 0x9d, 0xdd, 0x28, 0x49, 0x4d, 0x92, 0x98, 0x40, 0x5e, 0xcf, 0x5d, 0x80,
+		0x69, 0xb5, 0x47, 0xef, 0x89, 0x44, 0x71, 0xce, 0x42, 0x1e, 0xf2, 0xcf, 0x43, 0x82, 0x9d, 0xa0,
+		0xe2, 0x8b, 0x12, 0xd7, 0xd1, 0x3e, 0xa9, 0x27, 0x2d, 0xe3, 0x9f, 0xd2, 0x2a, 0x4c, 0x13, 0xe5,
+		0xd9, 0xed, 0x9a, 0xdc, 0xea, 0x80, 0xf6, 0xab, 0x44, 0x49, 0x99, 0xb2, 0xe5, 0x2f, 0x43, 0xb2,
+		0x62, 0x76, 0x75, 0xc3, 0x0c, 0xa3, 0xa5, 0x28, 0x1a, 0xd1, 0xd9, 0xea, 0xb9, 0xfc, 0x45, 0x18,
+		0xf2, 0x80, 0xdf, 0xff, 0xa2, 0xef, 0x70, 0xb3, 0xbb, 0x2e, 0xec, 0x29, 0x5f, 0x86, 0x04, 0xc1,
+		0xae, 0x59, 0xde, 0x87, 0x51, 0x84, 0xc0, 0x87, 0x51, 0x18, 0x7c, 0xc4, 0x57, 0x56, 0x82, 0x58,
+		0x4b, 0x75, 0x55, 0xd6, 0x6e, 0xf2, 0x3b, 0xff, 0x2e, 0x48, 0x32, 0x10, 0x47, 0x5a, 0x83, 0xa8,
+		0x69, 0xf1, 0x9b, 0x55, 0x8b, 0xa3, 0x9a, 0x52, 0xb3, 0x4a, 0x31, 0xec, 0x33, 0x32, 0x66, 0x2e,
+		0xc9, 0x23, 0xdd, 0xe2, 0x99, 0x80, 0x5b, 0x04, 0xba, 0x3c, 0xf0, 0x93, 0x76, 0xe9, 0x80, 0x3b,
+		0x78, 0xce, 0xf2, 0xa9, 0x08, 0x2c, 0x05, 0x4a, 0x6f, 0x20, 0x1b, 0x6f, 0x23, 0x50, 0x8f, 0x62,
+		0xde, 0x22, 0x05, 0x94, 0x64, 0xe5, 0x23, 0xdc, 0xe5, 0x9d, 0x10, 0x2d, 0x5a, 0x16, 0xfe, 0x94,
+		0x09, 0x79, 0xd6, 0x4c, 0xea, 0x2f, 0x31, 0xd9, 0x7b, 0xc6, 0x65, 0x8e, 0xb9, 0xeb, 0xde, 0x54,
+		0x6d, 0xef, 0x33, 0x27, 0xfc, 0x39, 0x7f, 0x1e, 0x52, 0x65, 0xd3, 0x70, 0x90, 0xe1, 0xf4, 0x48,
+		0x66, 0xb3, 0xd3, 0x31, 0xb5, 0xeb, 0x0c, 0x81, 0x3e, 0x60, 0x83, 0xab, 0x96, 0x45, 0x24, 0x63,
+		0x32, 0xfe, 0x49, 0xc7, 0x6c, 0xa9, 0x31, 0xd2, 0x44, 0xe7, 0x0f, 0x6f, 0x22, 0xd6, 0x48, 0xcf,
+		0x46, 0x7f, 0x2c, 0xc0, 0x7d, 0x83, 0x03, 0xea, 0x3a, 0xda, 0x77, 0x0e, 0x3b, 0x9e, 0xae, 0x42,
+		0xaa, 0x4e, 0xbe, 0x35, 0xf7, 0x3c, 0xda, 0x97, 0x16, 0xf1, 0x65, 0xb3, 0xb5, 0xa7, 0x9f, 0x7e,
+		0xe2, 0x3c, 0xf5, 0xf6, 0x4b, 0x53, 0x32, 0x27, 0x48, 0x4b, 0x90, 0x72, 0x90, 0x66, 0xad, 0x3d,
+		0x7d, 0xf6, 0xfa, 0x13, 0xd4, 0xbd, 0x2e, 0x4d, 0xc9, 0x3e, 0xa9, 0x90, 0xc4, 0xad, 0x7e, 0xe3,
+		0x53, 0xcb, 0x42, 0x69, 0x1a, 0xa2, 0x4e, 0xaf, 0xfb, 0x43, 0xf5, 0x91, 0x8f, 0x4d, 0xc3, 0x4a,
+		0x50, 0x92, 0xe4, 0x7f, 0xec, 0x6e, 0xae, 0xf7, 0x95, 0x40, 0x31, 0x60, 0x03, 0xc2, 0x31, 0x62,
+		0xa6, 0x38, 0xd0, 0x92, 0xf9, 0x5f, 0x11, 0x20, 0x7d, 0x85, 0x23, 0xe3, 0x5b, 0xd9, 0xcf, 0x02,
+		0x78, 0x35, 0xf1, 0x61, 0x73, 0x62, 0xb5, 0xbf, 0xae, 0x55, 0x4f, 0x46, 0x0e, 0xb0, 0xe3, 0xab,
+		0x79, 0x96, 0x6d, 0x5a, 0xa6, 0xc3, 0x3e, 0x7d, 0x31, 0x46, 0xd4, 0x63, 0xc6, 0x77, 0xe0, 0x49,
+		0x84, 0x53, 0x6e, 0x98, 0x2e, 0xbe, 0x28, 0x60, 0x99, 0x37, 0xd9, 0x07, 0x85, 0xa2, 0xb2, 0x48,
+		0x4a, 0xae, 0x90, 0x82, 0x3a, 0xa6, 0x63, 0xa5, 0x53, 0x1e, 0x0a, 0x4e, 0xd6, 0xd5, 0x56, 0xcb,
+		0x46, 0x8e, 0xc3, 0x82, 0x18, 0x7f, 0xc4, 0xdf, 0xdb, 0xb0, 0x7a, 0x3b, 0x0a, 0x8f, 0x18, 0xf8,
+		0x8b, 0x25, 0x43, 0xc6, 0x3f, 0xf7, 0x0f, 0x16, 0x01, 0xe2, 0x56, 0x6f, 0x07, 0x7b, 0xcb, 0xfd,
+		0x90, 0x1e, 0xa2, 0xcc, 0xcc, 0x0d, 0x5f, 0x0f, 0xf2, 0x89, 0x43, 0xd6, 0x02, 0xc5, 0xb2, 0x75,
+		0xd3, 0xd6, 0xdd, 0x7d, 0x72, 0xe3, 0x2c, 0x2a, 0x8b, 0xbc, 0xa0, 0xce, 0xe8, 0xf9, 0xeb, 0x90,
+		0x6d, 0x90, 0x24, 0xce, 0xd7, 0xfc, 0x69, 0x5f, 0x3f, 0x61, 0xbc, 0x7e, 0x23, 0x35, 0x8b, 0x0c,
+		0x68, 0x56, 0x7a, 0xf7, 0x48, 0xef, 0x3c, 0x77, 0x78, 0xef, 0x0c, 0xcf, 0x76, 0x7f, 0x78, 0x1c,
+		0xee, 0xeb, 0x2f, 0x0c, 0x85, 0xaf, 0x49, 0x1d, 0x73, 0xdc, 0x1a, 0x6d, 0xf1, 0xe0, 0x49, 0x75,
+		0x71, 0x4c, 0x18, 0x5d, 0x1c, 0x3b, 0x84, 0xf2, 0xe7, 0x61, 0x16, 0xbf, 0xbb, 0xd0, 0x40, 0xee,
+		0x25, 0xa4, 0xb6, 0x90, 0x1d, 0x9e, 0x75, 0x67, 0xf9, 0xac, 0x2b, 0x41, 0x8c, 0x4c, 0xad, 0x74,
+		0xd6, 0x21, 0xbf, 0xf3, 0x7b, 0x10, 0xc3, 0xa2, 0xfe, 0x8c, 0xcc, 0x24, 0xc8, 0x03, 0xa6, 0xee,
+		0xec, 0xbb, 0xec, 0x7e, 0x6a, 0x5a, 0xa6, 0x0f, 0xd2, 0x53, 0x7c, 0x5e, 0x8d, 0x1e, 0x3c, 0xaf,
+		0x32, 0x47, 0x64, 0xb3, 0x6b, 0x07, 0x12, 0x25, 0x1c, 0x8a, 0xd7, 0x2b, 0x9e, 0x22, 0x82, 0xaf,
+		0x88, 0xb4, 0x09, 0x59, 0x4b, 0xb5, 0x5d, 0xf2, 0xf6, 0xfd, 0x1e, 0x69, 0x05, 0xf3, 0xf5, 0xe5,
+		0xc1, 0x91, 0x17, 0x6a, 0x2c, 0xab, 0x65, 0xd6, 0x0a, 0x12, 0xf3, 0xdf, 0x8a, 0x41, 0x9c, 0x19,
+		0xe3, 0x9d, 0x90, 0x60, 0x66, 0x65, 0xde, 0x79, 0x72, 0x75, 0x70, 0x62, 0x5a, 0xf5, 0x26, 0x10,
+		0x86, 0xc7, 0x65, 0xa4, 0x87, 0x20, 0xa9, 0xed, 0xa9, 0xba, 0xa1, 0xe8, 0x2d, 0x96, 0x10, 0xce,
+		0x7c, 0xfd, 0xb5, 0xe5, 0x44, 0x19, 0xd3, 0xd6, 0x2b, 0x72, 0x82, 0x14, 0xae, 0xb7, 0x70, 0x26,
+		0xb0, 0x87, 0xf4, 0xf6, 0x9e, 0xcb, 0x46, 0x18, 0x7b, 0xc2, 0xdf, 0x37, 0xc5, 0x0e, 0xc1, 0x3e,
+		0xea, 0xb2, 0x38, 0x90, 0xe1, 0x7b, 0x4b, 0xe8, 0x52, 0x12, 0x57, 0xfc, 0xca, 0x7f, 0x5b, 0x16,
+		0x64, 0x22, 0x21, 0x95, 0x61, 0xb6, 0xa3, 0x3a, 0xae, 0x42, 0x66, 0x30, 0x5c, 0xfd, 0x34, 0x81,
+		0x38, 0x3e, 0x68, 0x10, 0x66, 0x58, 0xa6, 0xfa, 0x0c, 0x96, 0xa2, 0xa4, 0x16, 0xfe, 0xe6, 0x04,
+		0x01, 0xc1, 0x57, 0x66, 0x75, 0x97, 0xe6, 0x56, 0x71, 0x62, 0xf7, 0x0c, 0xa6, 0x97, 0x09, 0x99,
+		0x64, 0x58, 0x27, 0x20, 0x45, 0x3e, 0x23, 0x41, 0x58, 0xe8, 0xbb, 0x36, 0x49, 0x4c, 0x20, 0x85,
+		0x0f, 0x43, 0xd6, 0x8f, 0x8f, 0x94, 0x25, 0x49, 0x51, 0x7c, 0x32, 0x61, 0x7c, 0x1c, 0x16, 0x0c,
+		0x74, 0xcb, 0x55, 0xfa, 0xb9, 0x53, 0x84, 0x5b, 0xc2, 0x65, 0x57, 0xc2, 0x12, 0x0f, 0x42, 0x46,
+		0xe3, 0xc6, 0xa7, 0xbc, 0x40, 0x78, 0x67, 0x3d, 0x2a, 0x61, 0x3b, 0x0e, 0x49, 0xd5, 0xb2, 0x28,
+		0xc3, 0x0c, 0x8b, 0x8f, 0x96, 0x45, 0x8a, 0x4e, 0xc3, 0x1c, 0x69, 0xa3, 0x8d, 0x1c, 0x7c, 0x61,
+		0x9c, 0xf2, 0xa4, 0x09, 0x4f, 0x16, 0x17, 0xc8, 0x94, 0x4e, 0x78, 0x1f, 0x80, 0x59, 0x74, 0x43,
+		0x6f, 0x21, 0x43, 0x43, 0x94, 0x6f, 0x96, 0xf0, 0xa5, 0x39, 0x91, 0x30, 0x3d, 0x02, 0x5e, 0xdc,
+		0x53, 0x78, 0x4c, 0xce, 0x50, 0x3c, 0x4e, 0x2f, 0x52, 0x72, 0x3e, 0x07, 0xb1, 0x8a, 0xea, 0xaa,
+		0x38, 0xc1, 0x70, 0x6f, 0xd1, 0x89, 0x26, 0x2d, 0xe3, 0x9f, 0xf9, 0x37, 0x22, 0x10, 0xbb, 0x62,
+		0xba, 0x48, 0x7a, 0x32, 0x90, 0x00, 0x66, 0x86, 0xf9, 0x73, 0x43, 0x6f, 0x1b, 0xa8, 0xb5, 0xe9,
+		0xb4, 0x03, 0xdf, 0x7c, 0xf3, 0xdd, 0x29, 0x12, 0x72, 0xa7, 0x05, 0x98, 0xb6, 0xcd, 0x9e, 0xd1,
+		0xe2, 0xb7, 0x84, 0xc9, 0x83, 0x54, 0x85, 0xa4, 0xe7, 0x25, 0xb1, 0x71, 0x5e, 0x92, 0xc5, 0x5e,
+		0x82, 0x7d, 0x98, 0x11, 0xe4, 0xc4, 0x0e, 0x73, 0x96, 0x12, 0xa4, 0xbc, 0xe0, 0x95, 0x9b, 0x3e,
+		0x84, 0xc3, 0xfa, 0x62, 0x78, 0x32, 0xf1, 0xfa, 0xde, 0x33, 0x1e, 0xf5, 0x38, 0xd1, 0x2b, 0x60,
+		0xd6, 0x0b, 0xb9, 0x15, 0xfb, 0xfe, 0x5c, 0x82, 0xb4, 0xcb, 0x77, 0x2b, 0xfa, 0x0d, 0xba, 0xfb,
+		0xf0, 0x4d, 0xa4, 0xb6, 0x41, 0x6e, 0xc0, 0x33, 0xcf, 0xf3, 0x09, 0xf9, 0x2f, 0x09, 0x10, 0xa7,
+		0x9e, 0x1c, 0xb0, 0x9b, 0x30, 0xdc, 0x6e, 0x91, 0x51, 0x76, 0x8b, 0xde, 0xbd, 0xdd, 0x8a, 0x00,
+		0x9e, 0x32, 0x0e, 0xfb, 0x2c, 0xd8, 0x90, 0x8c, 0x81, 0xaa, 0xd8, 0xd0, 0xdb, 0x6c, 0xa0, 0x06,
+		0x84, 0xf2, 0xbf, 0x2b, 0x40, 0xca, 0x2b, 0x97, 0x8a, 0x30, 0xcb, 0xf5, 0x52, 0x76, 0x3b, 0x6a,
+		0x9b, 0xf9, 0xce, 0xc9, 0x91, 0xca, 0x5d, 0xe8, 0xa8, 0x6d, 0x79, 0x86, 0xe9, 0x83, 0x1f, 0x86,
+		0xf7, 0x43, 0x64, 0x44, 0x3f, 0x84, 0x3a, 0x3e, 0x7a, 0x77, 0x1d, 0x1f, 0xea, 0xa2, 0x58, 0x7f,
+		0x17, 0x7d, 0x3e, 0x42, 0x16, 0x33, 0x96, 0xe9, 0xa8, 0x9d, 0x1f, 0xc5, 0x88, 0x38, 0x01, 0x29,
+		0xcb, 0xec, 0x28, 0xb4, 0x84, 0xde, 0x9e, 0x4f, 0x5a, 0x66, 0x47, 0x1e, 0xe8, 0xf6, 0xe9, 0x7b,
+		0x34, 0x5c, 0xe2, 0xf7, 0xc0, 0x6a, 0x89, 0x7e, 0xab, 0xd9, 0x90, 0xa6, 0xa6, 0x60, 0x73, 0xd9,
+		0xe3, 0xd8, 0x06, 0xf8, 0x57, 0x4e, 0x18, 0x9c, 0x7b, 0xa9, 0xda, 0x94, 0x53, 0x8e, 0xef, 0x79,
+		0x12, 0x34, 0xf4, 0xe7, 0x22, 0xa3, 0x24, 0xa8, 0xdb, 0xc9, 0x8c, 0x2f, 0xff, 0xf7, 0x04, 0x80,
+		0x0d, 0x6c, 0x59, 0xd2, 0x5e, 0x3c, 0x0b, 0x39, 0x44, 0x05, 0x25, 0x54, 0xf3, 0xd2, 0xa8, 0x4e,
+		0x63, 0xf5, 0xa7, 0x9d, 0xa0, 0xde, 0x65, 0x98, 0xf5, 0x9d, 0xd1, 0x41, 0x5c, 0x99, 0xa5, 0x03,
+		0xb2, 0x6a, 0xfc, 0xce, 0x4b, 0xfa, 0x46, 0xe0, 0x29, 0xff, 0x6b, 0x02, 0xa4, 0x88, 0x4e, 0xf8,
+		0xa3, 0x46, 0xa1, 0x3e, 0x14, 0xee, 0xbe, 0x0f, 0x4f, 0x02, 0x50, 0x18, 0x7c, 0x2e, 0xcb, 0x3c,
+		0x2b, 0x45, 0x28, 0xf8, 0xb4, 0x55, 0x3a, 0xeb, 0x19, 0x3c, 0x7a, 0xb0, 0xc1, 0x79, 0xd6, 0xcd,
+		0xcc, 0x7e, 0x0c, 0x12, 0xe4, 0x2d, 0xcc, 0x5b, 0x0e, 0x4b, 0xa4, 0xf1, 0xb7, 0xf3, 0x9a, 0xb7,
+		0x9c, 0xfc, 0x4b, 0x90, 0x68, 0xde, 0xa2, 0x7b, 0x23, 0x27, 0x20, 0x65, 0x9b, 0x26, 0x9b, 0x93,
+		0x69, 0x2e, 0x94, 0xc4, 0x04, 0x32, 0x05, 0xf1, 0xfd, 0x80, 0x88, 0xbf, 0x1f, 0xe0, 0x6f, 0x68,
+		0x44, 0x27, 0xda, 0xd0, 0x38, 0xfd, 0x5b, 0x02, 0xcc, 0x04, 0xe2, 0x83, 0xf4, 0x04, 0x1c, 0x29,
+		0x6d, 0xd4, 0xca, 0xcf, 0x2b, 0xeb, 0x15, 0xe5, 0xc2, 0x46, 0x31, 0xf0, 0x8a, 0xd9, 0xe2, 0xd1,
+		0xdb, 0x77, 0x56, 0xa4, 0x00, 0xef, 0xb6, 0x41, 0xf6, 0xe9, 0xa5, 0x33, 0xb0, 0x10, 0x16, 0x29,
+		0x96, 0x1a, 0xf8, 0x6d, 0x65, 0x61, 0xf1, 0xc8, 0xed, 0x3b, 0x2b, 0x73, 0x01, 0x89, 0xe2, 0x8e,
+		0x83, 0x0c, 0x77, 0x50, 0xa0, 0x5c, 0xdb, 0xdc, 0xc4, 0x6f, 0xf8, 0x0d, 0x08, 0xb0, 0x80, 0xfd,
+		0x08, 0xcc, 0x85, 0x05, 0xb6, 0xd6, 0x37, 0xc4, 0xe8, 0xa2, 0x74, 0xfb, 0xce, 0x4a, 0x26, 0xc0,
+		0xbd, 0xa5, 0x77, 0x16, 0x93, 0x1f, 0xf8, 0xb9, 0xa5, 0xa9, 0x5f, 0xfc, 0xf9, 0x25, 0x01, 0xb7,
+		0x6c, 0x36, 0x14, 0x23, 0xa4, 0xb7, 0xc3, 0xb1, 0xc6, 0xfa, 0xc5, 0xad, 0x6a, 0x45, 0xd9, 0x6c,
+		0x5c, 0xec, 0x7b, 0x49, 0x70, 0x31, 0x7b, 0xfb, 0xce, 0xca, 0x0c, 0x6b, 0xd2, 0x28, 0xee, 0xba,
+		0x5c, 0xbd, 0x52, 0x6b, 0x56, 0x45, 0x81, 0x72, 0xd7, 0x6d, 0x74, 0xc3, 0x74, 0xe9, 0x77, 0xd6,
+		0x1f, 0x87, 0xe3, 0x43, 0xb8, 0xbd, 0x86, 0xcd, 0xdd, 0xbe, 0xb3, 0x32, 0x5b, 0xb7, 0x11, 0x1d,
+		0x3f, 0x44, 0x62, 0x15, 0x72, 0x83, 0x12, 0xb5, 0x7a, 0xad, 0x51, 0xdc, 0x10, 0x57, 0x16, 0xc5,
+		0xdb, 0x77, 0x56, 0xd2, 0x3c, 0x18, 0x62, 0x7e, 0xbf, 0x65, 0x3f, 0xcc, 0x15, 0xcf, 0xff, 0x7e,
+		0x06, 0x4e, 0x3a, 0xae, 0x7a, 0x5d, 0x37, 0xda, 0xde, 0xae, 0x2d, 0x7b, 0x66, 0x4b, 0x9e, 0x93,
+		0x1d, 0xfd, 0x3d, 0x3d, 0xbd, 0xc5, 0x89, 0xfc, 0xef, 0x98, 0x2d, 0xdc, 0x91, 0x27, 0x96, 0x8b,
+		0x63, 0x0e, 0xf5, 0xc6, 0x2f, 0x9d, 0x46, 0x6f, 0x0f, 0x2f, 0x8e, 0xd9, 0x84, 0x5e, 0x3c, 0x70,
+		0x71, 0x97, 0x7f, 0x45, 0x80, 0xcc, 0x25, 0xdd, 0x71, 0x4d, 0x5b, 0xd7, 0xd4, 0x0e, 0x79, 0x2d,
+		0xec, 0xec, 0xa4, 0xb1, 0xb5, 0x6f, 0xa8, 0x5f, 0x80, 0xf8, 0x0d, 0xb5, 0x43, 0x83, 0x1a, 0x7d,
+		0xf3, 0xee, 0x40, 0x2b, 0xfa, 0x11, 0x8e, 0xe3, 0x50, 0xe9, 0xfc, 0xe7, 0x22, 0x90, 0x25, 0x63,
+		0xc2, 0xa1, 0x1f, 0x73, 0xc6, 0x4b, 0xad, 0x3a, 0xc4, 0x6c, 0xd5, 0x65, 0x7b, 0x87, 0xa5, 0x77,
+		0xb0, 0xed, 0xe0, 0x87, 0xc6, 0x6f, 0xea, 0xae, 0x0e, 0xee, 0x18, 0x13, 0x24, 0xe9, 0x05, 0x48,
+		0x76, 0xd5, 0x5b, 0x0a, 0x41, 0x8d, 0xdc, 0x03, 0xd4, 0x44, 0x57, 0xbd, 0x85, 0x75, 0x95, 0x5a,
+		0xe4, 0x15, 0x4b, 0x45, 0xdb, 0x53, 0x8d, 0x36, 0xa2, 0xf8, 0xd1, 0x7b, 0x80, 0x3f, 0xdb, 0x55,
+		0x6f, 0x95, 0x09, 0x26, 0xae, 0xa5, 0x90, 0x7c, 0xf5, 0x93, 0xcb, 0x53, 0x64, 0xb7, 0xfd, 0xd7,
+		0x04, 0x00, 0xdf, 0x5c, 0x92, 0x06, 0xa2, 0xe6, 0x3d, 0x91, 0xea, 0xf9, 0x37, 0x6a, 0x56, 0xc7,
+		0xf4, 0x47, 0x9f, 0xcd, 0xe9, 0x34, 0xfd, 0xd5, 0xd7, 0x96, 0x05, 0x39, 0xab, 0xf5, 0x75, 0x47,
+		0x15, 0x66, 0x7a, 0x56, 0x4b, 0x75, 0x91, 0x42, 0x96, 0x74, 0x91, 0x43, 0x4c, 0xf9, 0x40, 0x05,
+		0x71, 0x51, 0xa0, 0x11, 0x9f, 0x23, 0x5f, 0xde, 0xf6, 0x8f, 0xfc, 0x72, 0x90, 0xe8, 0x9a, 0x86,
+		0x7e, 0x9d, 0x39, 0x61, 0x4a, 0xe6, 0x8f, 0x78, 0xff, 0x93, 0x7e, 0x9e, 0xc1, 0xdd, 0xe7, 0xfb,
+		0x9f, 0xfc, 0x19, 0x4b, 0xdd, 0x44, 0x3b, 0x8e, 0xce, 0x4d, 0x2e, 0xf3, 0x47, 0xbc, 0x90, 0x71,
+		0x90, 0xd6, 0xc3, 0x1b, 0x37, 0xf8, 0x3b, 0x35, 0x2e, 0xfe, 0x9c, 0x0a, 0x7d, 0xc7, 0x28, 0xcb,
+		0xe9, 0x65, 0x4a, 0xc6, 0x20, 0x2d, 0xe4, 0xaa, 0x7a, 0xc7, 0xc9, 0xd1, 0x63, 0x31, 0xfe, 0x18,
+		0x50, 0xf7, 0x23, 0xa9, 0xe0, 0x86, 0x55, 0x19, 0x44, 0xd3, 0x42, 0x76, 0x28, 0xc1, 0xa4, 0x8e,
+		0x9a, 0xfb, 0x8d, 0x2f, 0x3c, 0xb6, 0xc0, 0x3a, 0x91, 0xa5, 0x98, 0xf4, 0x76, 0xab, 0x9c, 0xe5,
+		0x12, 0x8c, 0x2c, 0x5d, 0x03, 0xd1, 0x5b, 0xe7, 0x29, 0x56, 0x6f, 0xc7, 0xdf, 0xe4, 0x5a, 0x18,
+		0xb0, 0x6b, 0xd1, 0xd8, 0x2f, 0xe5, 0xbe, 0xe2, 0x43, 0xfb, 0x3b, 0x4b, 0x78, 0x5b, 0x29, 0xeb,
+		0xe1, 0xd4, 0x09, 0x0c, 0x4e, 0x18, 0x5f, 0x52, 0xf5, 0x0e, 0xff, 0xb6, 0x8f, 0xcc, 0x9e, 0xa4,
+		0x22, 0xc4, 0x1d, 0x57, 0x75, 0x7b, 0x0e, 0x7b, 0x8d, 0xf8, 0x91, 0x31, 0x0e, 0x52, 0x32, 0x8d,
+		0x56, 0x83, 0x08, 0xc8, 0x4c, 0x50, 0x6a, 0x42, 0xdc, 0x35, 0xaf, 0x23, 0x83, 0xd9, 0xea, 0x50,
+		0x3e, 0x3e, 0xe4, 0x80, 0x8a, 0x62, 0x49, 0x6d, 0x10, 0x5b, 0xa8, 0x83, 0xda, 0x34, 0x4b, 0xda,
+		0x53, 0xf1, 0x62, 0x22, 0x7e, 0x0f, 0xc6, 0x50, 0xd6, 0x43, 0x6d, 0x10, 0x50, 0x49, 0x0e, 0x9f,
+		0x3d, 0xd3, 0xaf, 0x0f, 0x9d, 0x1e, 0x63, 0x86, 0x80, 0x9f, 0xf2, 0x8d, 0x86, 0x00, 0x08, 0x76,
+		0xb5, 0x9e, 0xb1, 0x63, 0x1a, 0xe4, 0x4d, 0x5d, 0x96, 0xa8, 0x27, 0x49, 0xea, 0x93, 0xf5, 0xe8,
+		0x97, 0x08, 0x59, 0x7a, 0x1e, 0x32, 0x3e, 0x2b, 0x19, 0x49, 0xa9, 0x43, 0x8c, 0xa4, 0x59, 0x4f,
+		0x16, 0x97, 0x4a, 0x35, 0x00, 0x7f, 0x98, 0x92, 0xad, 0x83, 0x99, 0xb5, 0x47, 0x26, 0x1e, 0xf2,
+		0x7c, 0x25, 0xe6, 0x43, 0x48, 0x1f, 0x16, 0xe0, 0x04, 0xdb, 0xc4, 0xf5, 0x52, 0x56, 0x5c, 0x21,
+		0xef, 0x11, 0xf2, 0x25, 0xd6, 0x52, 0xf3, 0x70, 0x3d, 0xf2, 0xfd, 0xd7, 0x96, 0xf3, 0xfb, 0x6a,
+		0xb7, 0x53, 0xc8, 0x1f, 0x00, 0x9d, 0x97, 0x73, 0x74, 0x8f, 0xd8, 0x9b, 0x21, 0xb0, 0xe7, 0xd1,
+		0x2e, 0xfb, 0x71, 0x98, 0xa7, 0x92, 0xb4, 0x65, 0x5c, 0x19, 0xf2, 0xaf, 0x4a, 0x4a, 0x1b, 0x87,
+		0x56, 0x66, 0x31, 0xa8, 0x4c, 0x08, 0x32, 0x2f, 0xcf, 0x11, 0xea, 0x06, 0x21, 0xb2, 0xda, 0x5f,
+		0x11, 0xe0, 0x28, 0x71, 0x52, 0xfc, 0x85, 0x5b, 0xca, 0xa7, 0x58, 0x66, 0x47, 0xd7, 0xf6, 0xc9,
+		0xfe, 0xc9, 0xcc, 0xda, 0x93, 0x63, 0x2c, 0xde, 0x64, 0xc2, 0x14, 0xaf, 0x4e, 0x44, 0x4b, 0x0f,
+		0x62, 0xb5, 0xbf, 0xff, 0xda, 0xf2, 0x49, 0xae, 0xcc, 0xb0, 0x0a, 0xf2, 0xf2, 0x82, 0x3b, 0x44,
+		0xb8, 0x90, 0xfe, 0xc0, 0x27, 0x97, 0xa7, 0x58, 0x64, 0x9a, 0xca, 0xef, 0xc3, 0xc2, 0xb0, 0x2a,
+		0x70, 0xd8, 0x6c, 0xe9, 0x0e, 0xbe, 0xaa, 0xc8, 0xbe, 0x54, 0x25, 0x7b, 0xcf, 0xd2, 0x73, 0x90,
+		0x21, 0x5f, 0x03, 0x43, 0x2d, 0xc5, 0xbc, 0x69, 0x20, 0xdb, 0x21, 0x13, 0xf8, 0x41, 0xd1, 0x6b,
+		0x96, 0xf1, 0xd7, 0x08, 0x3b, 0x3b, 0xf6, 0xad, 0x93, 0x93, 0x07, 0xc6, 0x88, 0x1c, 0xe9, 0x2c,
+		0xa4, 0x54, 0xfe, 0x90, 0x13, 0xc6, 0x20, 0xfa, 0xac, 0x34, 0xcc, 0xbe, 0xfc, 0x3b, 0x2b, 0x42,
+		0xfe, 0xe7, 0x05, 0x88, 0x57, 0xae, 0xd4, 0x55, 0xdd, 0x96, 0xaa, 0x30, 0xe7, 0x0d, 0xde, 0x89,
+		0x83, 0xac, 0x1f, 0x45, 0x18, 0x1d, 0xc3, 0x0c, 0xdf, 0x0c, 0x38, 0x10, 0xa6, 0x7f, 0x9b, 0xa0,
+		0xcf, 0xe6, 0x1b, 0x90, 0xa0, 0x5a, 0x92, 0x2f, 0x69, 0x5a, 0xf8, 0x07, 0x3b, 0x68, 0x79, 0x70,
+		0x5c, 0x28, 0x21, 0x62, 0xde, 0xfe, 0x30, 0x96, 0xcc, 0xff, 0xb1, 0x00, 0x50, 0xb9, 0x72, 0xa5,
+		0x69, 0xeb, 0x56, 0x07, 0xb9, 0xf7, 0xaa, 0xe1, 0x1b, 0x70, 0xc4, 0x6f, 0xb8, 0x63, 0x6b, 0x13,
+		0x37, 0x7e, 0xde, 0x5f, 0x7a, 0xda, 0xda, 0x50, 0xb4, 0x96, 0xe3, 0x7a, 0x68, 0xd1, 0x89, 0xd1,
+		0x2a, 0x8e, 0x3b, 0xdc, 0x9a, 0x2f, 0xc2, 0x8c, 0xdf, 0x7c, 0x47, 0x7a, 0x1e, 0x92, 0x2e, 0xfb,
+		0xcd, 0x8c, 0xfa, 0xc8, 0x58, 0xa3, 0x72, 0x69, 0x66, 0x58, 0x0f, 0x20, 0xff, 0x0b, 0x11, 0x80,
+		0x0a, 0x35, 0x0d, 0x8e, 0x70, 0x6f, 0x29, 0xa7, 0xc2, 0x73, 0x29, 0x0b, 0x66, 0xf7, 0x22, 0x5f,
+		0x64, 0x58, 0x78, 0x57, 0x39, 0x1c, 0x63, 0x73, 0xf4, 0x55, 0x91, 0xd9, 0x1b, 0xc1, 0xe0, 0xda,
+		0xd7, 0x07, 0xb7, 0x23, 0xf8, 0xb3, 0x5b, 0x6c, 0x76, 0x79, 0xcb, 0x1a, 0xec, 0x05, 0x48, 0x20,
+		0xc3, 0xb5, 0x75, 0x62, 0x31, 0xec, 0x19, 0xe7, 0xc6, 0x78, 0xc6, 0x90, 0x26, 0x91, 0xaf, 0x04,
+		0xf3, 0xa3, 0x0e, 0x86, 0xd6, 0x67, 0x8c, 0xff, 0x1a, 0x81, 0xdc, 0x28, 0x49, 0xbc, 0x71, 0xab,
+		0xd9, 0x88, 0x10, 0x94, 0xd0, 0x7e, 0x6b, 0x86, 0x93, 0xd9, 0x5c, 0xbf, 0x09, 0x38, 0x8b, 0xc6,
+		0x6e, 0x88, 0x59, 0x0f, 0x9d, 0x36, 0x67, 0x7c, 0x61, 0x5c, 0x2c, 0x21, 0xc8, 0xea, 0x86, 0xee,
+		0xea, 0x6a, 0x47, 0xd9, 0x51, 0x3b, 0x2a, 0xfe, 0xd8, 0x56, 0xf4, 0x1e, 0x64, 0x60, 0x19, 0x06,
+		0x5a, 0xa2, 0x98, 0xd2, 0x15, 0x48, 0x70, 0xf8, 0xd8, 0x3d, 0x80, 0xe7, 0x60, 0x81, 0x54, 0xfa,
+		0x3f, 0x47, 0x60, 0x4e, 0x46, 0xad, 0x3f, 0x5b, 0x66, 0xfd, 0x31, 0x00, 0x36, 0xb7, 0xb7, 0x1c,
+		0x37, 0x17, 0xbb, 0x07, 0xc3, 0x3d, 0x45, 0xf1, 0x2a, 0x8e, 0x1b, 0xb0, 0xed, 0xd7, 0x22, 0x90,
+		0x0e, 0xda, 0xf6, 0xcf, 0xc0, 0x64, 0x22, 0xd5, 0xfd, 0xa0, 0x40, 0xcf, 0x1f, 0x1e, 0x1f, 0x13,
+		0x14, 0x06, 0x9c, 0xef, 0xe0, 0x68, 0xf0, 0x89, 0x38, 0xc4, 0xeb, 0xaa, 0xad, 0x76, 0x1d, 0xe9,
+		0xf2, 0x40, 0xfa, 0xce, 0xf7, 0x5f, 0x07, 0xfe, 0xa3, 0x19, 0xdb, 0xee, 0xa1, 0x9e, 0xf7, 0xea,
+		0x90, 0xec, 0xfd, 0x41, 0xc0, 0xdf, 0x59, 0x0a, 0x9c, 0x16, 0x12, 0x5b, 0xce, 0x92, 0x65, 0xbf,
+		0x7f, 0x4e, 0x88, 0x3f, 0x9e, 0x83, 0xd9, 0xfc, 0xb0, 0x87, 0x79, 0xa0, 0xab, 0xde, 0xaa, 0x52,
+		0x8a, 0xf4, 0x18, 0x48, 0x7b, 0xde, 0x76, 0x8e, 0xe2, 0x5b, 0x02, 0xf3, 0xcd, 0xf9, 0x25, 0x9c,
+		0x1d, 0xef, 0xfa, 0xe2, 0xbc, 0x9b, 0x5e, 0xff, 0xa3, 0xeb, 0xdd, 0x14, 0xa6, 0x54, 0x30, 0x01,
+		0x27, 0xdb, 0x5d, 0xdd, 0x50, 0xfa, 0x36, 0x14, 0x72, 0xf1, 0x3f, 0x5d, 0xb2, 0x3d, 0x04, 0x32,
+		0x2f, 0xcf, 0x75, 0x75, 0x23, 0xbc, 0x03, 0x21, 0xfd, 0x55, 0x21, 0xe8, 0x19, 0x44, 0xcf, 0x5d,
+		0x55, 0x73, 0x4d, 0x9b, 0xfe, 0xfb, 0xa6, 0xd2, 0xd6, 0xa1, 0x15, 0xb8, 0x8f, 0x2a, 0x30, 0x14,
+		0x34, 0x2f, 0xcf, 0x87, 0xa6, 0xc4, 0x0b, 0x84, 0x2a, 0x7d, 0x08, 0xbf, 0x8a, 0xd0, 0x31, 0x77,
+		0x02, 0xcb, 0x03, 0xea, 0x40, 0x8a, 0xa6, 0x5a, 0xf4, 0x23, 0x8b, 0x25, 0xf9, 0xd0, 0x8a, 0xac,
+		0x50, 0x45, 0x46, 0x02, 0xe7, 0xe5, 0xa3, 0xb4, 0x8c, 0xad, 0x3e, 0x68, 0x49, 0x59, 0xb5, 0xa4,
+		0xbf, 0x2b, 0xc0, 0x31, 0x6c, 0xc0, 0xe0, 0x31, 0x42, 0x67, 0x97, 0xce, 0xec, 0x29, 0xa2, 0xcd,
+		0x5f, 0x3c, 0x5c, 0xa8, 0xfa, 0xfe, 0x6b, 0xcb, 0x4b, 0x7e, 0xbf, 0x0c, 0x81, 0xcd, 0xf7, 0x05,
+		0xb3, 0x85, 0xae, 0x6e, 0x04, 0x4e, 0x27, 0x3a, 0xbb, 0x24, 0x81, 0xf0, 0xa3, 0xce, 0x67, 0x05,
+		0x90, 0xfc, 0x69, 0x52, 0x46, 0x8e, 0x65, 0x1a, 0x0e, 0x59, 0x9f, 0xfa, 0x03, 0x8d, 0x8d, 0x94,
+		0xb1, 0xa9, 0x9c, 0x27, 0xc0, 0xd7, 0xa7, 0x81, 0x60, 0x76, 0xde, 0x9f, 0x9b, 0x22, 0x6c, 0xdc,
+		0x0d, 0xb9, 0x6f, 0xbb, 0x8a, 0x6f, 0xb8, 0xf2, 0x21, 0xdd, 0x3f, 0xfd, 0x4c, 0xe5, 0x7f, 0x4f,
+		0x80, 0xe3, 0x03, 0x11, 0xc0, 0xd3, 0x19, 0x81, 0x64, 0x07, 0x0a, 0xd9, 0xff, 0x18, 0xa0, 0xba,
+		0xdf, 0x6d, 0x5c, 0x99, 0xb3, 0xfb, 0x0b, 0x7e, 0x68, 0xb3, 0x2c, 0x5d, 0x97, 0xfd, 0x07, 0x01,
+		0x16, 0x82, 0xca, 0x78, 0xad, 0xdb, 0x86, 0x74, 0x50, 0x17, 0xd6, 0xae, 0x47, 0x0f, 0xd1, 0x2e,
+		0xd6, 0xa4, 0x10, 0x8c, 0x74, 0xd5, 0x8f, 0xc0, 0x74, 0x23, 0xf8, 0x99, 0xc3, 0x5a, 0x8a, 0x6b,
+		0xd8, 0x1f, 0x89, 0x63, 0xa4, 0xcb, 0xde, 0x1f, 0x81, 0x58, 0xdd, 0x34, 0x3b, 0xd2, 0x5f, 0x86,
+		0x39, 0xc3, 0x74, 0x89, 0x8f, 0xa2, 0x96, 0xc2, 0xf6, 0xa1, 0xe8, 0x6c, 0xf6, 0xee, 0xc3, 0x19,
+		0xf0, 0x3b, 0xaf, 0x2d, 0x0f, 0x42, 0xf5, 0x59, 0x35, 0x6b, 0x98, 0x6e, 0x89, 0x94, 0x93, 0x05,
+		0xb6, 0x23, 0xd9, 0x30, 0x1b, 0xae, 0x9a, 0xce, 0x7e, 0x9b, 0x87, 0xae, 0x7a, 0xf6, 0xa0, 0x6a,
+		0xd3, 0x3b, 0x81, 0x3a, 0xe9, 0xb5, 0xc5, 0xef, 0xe1, 0x5e, 0xfd, 0x4d, 0x01, 0xe6, 0x43, 0x2b,
+		0x7d, 0x19, 0x69, 0xa6, 0xdd, 0x92, 0x32, 0x10, 0x61, 0x07, 0x81, 0x31, 0x39, 0xa2, 0xb7, 0xf0,
+		0xa9, 0x30, 0x59, 0xd4, 0xf3, 0xeb, 0xba, 0xe4, 0x81, 0x4c, 0x37, 0x66, 0xab, 0xd7, 0x41, 0xf8,
+		0x1f, 0x73, 0x90, 0x3b, 0xde, 0x74, 0xc3, 0x74, 0x96, 0x52, 0x8b, 0x94, 0x88, 0x0f, 0x65, 0xbd,
+		0x80, 0xc0, 0xf6, 0x4b, 0x7d, 0x02, 0xbe, 0x42, 0xe2, 0x58, 0x1d, 0xdd, 0x55, 0x6c, 0x74, 0x53,
+		0xb5, 0x5b, 0x0e, 0xfb, 0xd7, 0x86, 0x69, 0x42, 0x94, 0x29, 0x8d, 0xfc, 0xe7, 0x18, 0xb3, 0x6b,
+		0xe1, 0xe3, 0x66, 0x8f, 0x8f, 0xfe, 0x9b, 0xc3, 0x2c, 0xa7, 0x33, 0x56, 0xe6, 0xae, 0xbf, 0x23,
+		0xc0, 0x89, 0x2b, 0x83, 0x81, 0xb8, 0x76, 0x03, 0xd9, 0xb6, 0xde, 0x42, 0xc3, 0x17, 0x0f, 0xc2,
+		0xa1, 0x17, 0x0f, 0xd6, 0xa8, 0xb9, 0xe5, 0x5e, 0x1c, 0x06, 0x0c, 0x9b, 0x49, 0x58, 0xf3, 0x7e,
+		0x57, 0x80, 0xfb, 0xbc, 0xe6, 0xd1, 0xe8, 0x4e, 0xfb, 0xb6, 0xd1, 0x51, 0x9d, 0x3d, 0xd4, 0xba,
+		0x87, 0xed, 0x63, 0xd3, 0x0a, 0x75, 0x2b, 0xc5, 0xa1, 0xf8, 0xf7, 0xa6, 0x7d, 0x9d, 0x41, 0xc5,
+		0x69, 0xfb, 0x4e, 0x7f, 0x51, 0x00, 0xf0, 0x37, 0x8a, 0xf1, 0x01, 0x63, 0xa9, 0xb6, 0x55, 0x51,
+		0x1a, 0xcd, 0x62, 0x73, 0xbb, 0x11, 0x7e, 0xe9, 0x86, 0x1f, 0x47, 0x3a, 0x16, 0xd2, 0xc8, 0x7f,
+		0xe7, 0x90, 0x1e, 0x82, 0x85, 0x30, 0x37, 0x7e, 0xc2, 0x5f, 0xf9, 0x5c, 0x4c, 0xdf, 0xbe, 0xb3,
+		0x92, 0xa4, 0xab, 0x30, 0x84, 0x2f, 0x73, 0x1d, 0x19, 0xe4, 0xc3, 0x2f, 0xec, 0x44, 0x16, 0x67,
+		0x6f, 0xdf, 0x59, 0x49, 0x79, 0xcb, 0x35, 0x29, 0x0f, 0x52, 0x90, 0x93, 0xe1, 0x45, 0x17, 0xe1,
+		0xf6, 0x9d, 0x95, 0x38, 0x1d, 0xce, 0x8b, 0x31, 0x7c, 0xe8, 0x58, 0xba, 0x36, 0xf2, 0xc0, 0xf1,
+		0xb9, 0x80, 0x8d, 0xf4, 0xf7, 0x74, 0x7a, 0x38, 0x41, 0xd1, 0x0d, 0xed, 0x0c, 0x35, 0x82, 0xee,
+		0xee, 0x3f, 0xc6, 0x22, 0xda, 0x63, 0x74, 0xf4, 0x9c, 0xb9, 0xc5, 0x8f, 0x13, 0xc3, 0x07, 0x8f,
+		0x7f, 0x32, 0x00, 0x18, 0x95, 0x9c, 0x4e, 0x2e, 0x7c, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1927,6 +2227,38 @@ func (this *Description) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeSharesPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeSharesPolicy)
+	if !ok {
+		that2, ok := that.(TokenizeSharesPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	if len(this.AllowedOwners) != len(that1.AllowedOwners) {
+		return false
+	}
+	for i := range this.AllowedOwners {
+		if this.AllowedOwners[i] != that1.AllowedOwners[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2030,7 +2362,13 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
-	if !this.ExemptionFactor.Equal(that1.ExemptionFactor) {
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.MinValidatorSelfBond.Equal(that1.MinValidatorSelfBond) {
 		return false
 	}
 	return true
@@ -2120,6 +2458,66 @@ func (this *TokenizeShareRecord) Equal(that interface{}) bool {
 	if this.Validator != that1.Validator {
 		return false
 	}
+	if this.SplitRewards != that1.SplitRewards {
+		return false
+	}
+	if this.CompoundRewards != that1.CompoundRewards {
+		return false
+	}
+	return true
+}
+func (this *ValidatorBondFactorOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorBondFactorOverride)
+	if !ok {
+		that2, ok := that.(ValidatorBondFactorOverride)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorLiquidTokensSlashed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorLiquidTokensSlashed)
+	if !ok {
+		that2, ok := that.(ValidatorLiquidTokensSlashed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.LiquidTokensSlashed.Equal(that1.LiquidTokensSlashed) {
+		return false
+	}
 	return true
 }
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
@@ -2342,9 +2740,19 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.TotalTokenizedShares.Size()
+		size, err := m.TokenizeSharesPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.TotalLiquidShares.Size()
 		i -= size
-		if _, err := m.TotalTokenizedShares.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TotalLiquidShares.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
@@ -2352,9 +2760,9 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x62
 	{
-		size := m.TotalExemptShares.Size()
+		size := m.TotalValidatorBondShares.Size()
 		i -= size
-		if _, err := m.TotalExemptShares.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TotalValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
@@ -2371,12 +2779,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintStaking(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintStaking(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2451,6 +2859,48 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeSharesPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeSharesPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeSharesPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedOwners) > 0 {
+		for iNdEx := len(m.AllowedOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedOwners[iNdEx])
+			copy(dAtA[i:], m.AllowedOwners[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.AllowedOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2658,9 +3108,9 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Exempt {
+	if m.ValidatorBond {
 		i--
-		if m.Exempt {
+		if m.ValidatorBond {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -2786,12 +3236,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintStaking(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2842,12 +3292,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err9 != nil {
-		return 0, err9
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2937,15 +3387,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.ExemptionFactor.Size()
+		size := m.MinValidatorSelfBond.Size()
 		i -= size
-		if _, err := m.ExemptionFactor.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinValidatorSelfBond.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -2978,12 +3448,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3185,6 +3655,26 @@ func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CompoundRewards {
+		i--
+		if m.CompoundRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SplitRewards {
+		i--
+		if m.SplitRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
@@ -3214,6 +3704,86 @@ func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorBondFactorOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBondFactorOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBondFactorOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLiquidTokensSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiquidTokensSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiquidTokensSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidTokensSlashed.Size()
+		i -= size
+		if _, err := m.LiquidTokensSlashed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3332,10 +3902,30 @@ func (m *Validator) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.Commission.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.TotalExemptShares.Size()
+	l = m.TotalValidatorBondShares.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.TotalTokenizedShares.Size()
+	l = m.TotalLiquidShares.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.TokenizeSharesPolicy.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeSharesPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Disabled {
+		n += 2
+	}
+	if len(m.AllowedOwners) > 0 {
+		for _, s := range m.AllowedOwners {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3438,7 +4028,7 @@ func (m *Delegation) Size() (n int) {
 	}
 	l = m.Shares.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	if m.Exempt {
+	if m.ValidatorBond {
 		n += 2
 	}
 	return n
@@ -3553,7 +4143,11 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.ExemptionFactor.Size()
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinValidatorSelfBond.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
@@ -3635,6 +4229,42 @@ func (m *TokenizeShareRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	if m.SplitRewards {
+		n += 2
+	}
+	if m.CompoundRewards {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorBondFactorOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorLiquidTokensSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.LiquidTokensSlashed.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4581,7 +5211,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalExemptShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorBondShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4609,13 +5239,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalExemptShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTokenizedShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4643,7 +5273,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalTokenizedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalLiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeSharesPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenizeSharesPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4668,7 +5331,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *TokenizeSharesPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4691,15 +5354,35 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeSharesPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeSharesPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedOwners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4727,7 +5410,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.AllowedOwners = append(m.AllowedOwners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4750,7 +5433,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPair) Unmarshal(dAtA []byte) error {
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4773,47 +5456,15 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4841,7 +5492,7 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4864,7 +5515,7 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
+func (m *DVPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4887,17 +5538,131 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5307,7 +6072,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5324,7 +6089,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Exempt = bool(v != 0)
+			m.ValidatorBond = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6201,7 +6966,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6229,7 +6994,75 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExemptionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorSelfBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidatorSelfBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6866,6 +7699,278 @@ func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SplitRewards = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompoundRewards = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBondFactorOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBondFactorOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBondFactorOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLiquidTokensSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiquidTokensSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiquidTokensSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokensSlashed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidTokensSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
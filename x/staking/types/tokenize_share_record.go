@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
@@ -16,3 +17,36 @@ func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
 func (r TokenizeShareRecord) GetShareTokenDenom() string {
 	return fmt.Sprintf("%s/%s", strings.ToLower(r.Validator), strconv.Itoa(int(r.Id)))
 }
+
+// GetShareTokenMetadata returns the bank denom metadata of the share tokens of the record, given the
+// moniker of its validator and the metadata of the bond denom, which is empty if it is not registered.
+// Since share tokens are minted one for one with the tokenized bond denom, the display unit of the
+// share tokens has the exponent of the display unit of the bond denom
+func (r TokenizeShareRecord) GetShareTokenMetadata(moniker string, bondDenom string, bondMetadata banktypes.Metadata) banktypes.Metadata {
+	bondDisplay, bondSymbol := bondMetadata.Display, bondMetadata.Symbol
+	if bondDisplay == "" {
+		bondDisplay = bondDenom
+	}
+	if bondSymbol == "" {
+		bondSymbol = strings.ToUpper(bondDisplay)
+	}
+
+	base := r.GetShareTokenDenom()
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Share token of tokenize share record %d, delegated to validator %s (%s)", r.Id, moniker, r.Validator),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: base, Exponent: 0}},
+		Base:        base,
+		Display:     base,
+		Name:        fmt.Sprintf("%s share %d of %s", bondDisplay, r.Id, moniker),
+		Symbol:      fmt.Sprintf("%s-%d", bondSymbol, r.Id),
+	}
+
+	for _, unit := range bondMetadata.DenomUnits {
+		if unit.Denom == bondDisplay && unit.Exponent > 0 {
+			metadata.Display = fmt.Sprintf("%s/%s", base, bondDisplay)
+			metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: metadata.Display, Exponent: unit.Exponent})
+		}
+	}
+
+	return metadata
+}
@@ -30,6 +30,10 @@ const (
 	MaxWebsiteLength         = 140
 	MaxSecurityContactLength = 140
 	MaxDetailsLength         = 280
+
+	// MaxTokenizeSharesAllowedOwners is the maximum number of share owners in the
+	// allowlist of a tokenize shares policy, the policy is stored with the validator
+	MaxTokenizeSharesAllowedOwners = 100
 )
 
 var (
@@ -51,18 +55,18 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 	}
 
 	return Validator{
-		OperatorAddress:      operator.String(),
-		ConsensusPubkey:      pkAny,
-		Jailed:               false,
-		Status:               sdkstaking.Unbonded,
-		Tokens:               sdk.ZeroInt(),
-		DelegatorShares:      sdk.ZeroDec(),
-		Description:          description,
-		UnbondingHeight:      int64(0),
-		UnbondingTime:        time.Unix(0, 0).UTC(),
-		Commission:           NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
-		TotalExemptShares:    sdk.ZeroDec(),
-		TotalTokenizedShares: sdk.ZeroDec(),
+		OperatorAddress:          operator.String(),
+		ConsensusPubkey:          pkAny,
+		Jailed:                   false,
+		Status:                   sdkstaking.Unbonded,
+		Tokens:                   sdk.ZeroInt(),
+		DelegatorShares:          sdk.ZeroDec(),
+		Description:              description,
+		UnbondingHeight:          int64(0),
+		UnbondingTime:            time.Unix(0, 0).UTC(),
+		Commission:               NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		TotalValidatorBondShares: sdk.ZeroDec(),
+		TotalLiquidShares:        sdk.ZeroDec(),
 	}, nil
 }
 
@@ -259,6 +263,57 @@ func (d Description) EnsureLength() (Description, error) {
 	return d, nil
 }
 
+// Validate checks that the allowed owners are valid and unique addresses, and that
+// they are not set on a disabled policy
+func (p TokenizeSharesPolicy) Validate() error {
+	if p.Disabled && len(p.AllowedOwners) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed owners cannot be set when tokenize shares are disabled")
+	}
+
+	if len(p.AllowedOwners) > MaxTokenizeSharesAllowedOwners {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "too many allowed owners; got: %d, max: %d", len(p.AllowedOwners), MaxTokenizeSharesAllowedOwners)
+	}
+
+	seen := make(map[string]bool, len(p.AllowedOwners))
+	for _, owner := range p.AllowedOwners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed owner address: %s", err)
+		}
+		if seen[owner] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed owner %s", owner)
+		}
+		seen[owner] = true
+	}
+
+	return nil
+}
+
+// ValidateShareOwner returns an error if the policy does not allow a new tokenization
+// of a delegation to the validator with the given share owner
+func (p TokenizeSharesPolicy) ValidateShareOwner(owner string) error {
+	if p.Disabled {
+		return ErrTokenizeSharesDisabledForValidator
+	}
+
+	return p.ValidateNewShareOwner(owner)
+}
+
+// ValidateNewShareOwner returns an error if the policy has an allowlist of share owners that
+// does not contain the given new owner of an existing tokenize share record of the validator
+func (p TokenizeSharesPolicy) ValidateNewShareOwner(owner string) error {
+	if len(p.AllowedOwners) == 0 {
+		return nil
+	}
+
+	for _, allowedOwner := range p.AllowedOwners {
+		if allowedOwner == owner {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(ErrTokenizeShareOwnerNotAllowed, "owner %s", owner)
+}
+
 // ABCIValidatorUpdate returns an abci.ValidatorUpdate from a staking validator type
 // with the full validator power
 func (v Validator) ABCIValidatorUpdate(r math.Int) abci.ValidatorUpdate {
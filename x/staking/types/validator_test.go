@@ -351,3 +351,37 @@ func newValidator(t *testing.T, operator sdk.ValAddress, pubKey cryptotypes.PubK
 	require.NoError(t, err)
 	return v
 }
+
+func TestTokenizeSharesPolicy(t *testing.T) {
+	tooManyOwners := make([]string, types.MaxTokenizeSharesAllowedOwners+1)
+	for i := range tooManyOwners {
+		tooManyOwners[i] = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	}
+
+	tests := []struct {
+		name       string
+		policy     types.TokenizeSharesPolicy
+		expectPass bool
+	}{
+		{"empty policy", types.TokenizeSharesPolicy{}, true},
+		{"disabled", types.TokenizeSharesPolicy{Disabled: true}, true},
+		{"allowlist", types.TokenizeSharesPolicy{AllowedOwners: []string{addr1, addr2}}, true},
+		{"disabled with allowlist", types.TokenizeSharesPolicy{Disabled: true, AllowedOwners: []string{addr1}}, false},
+		{"invalid owner", types.TokenizeSharesPolicy{AllowedOwners: []string{"invalid"}}, false},
+		{"duplicate owner", types.TokenizeSharesPolicy{AllowedOwners: []string{addr1, addr1}}, false},
+		{"too many owners", types.TokenizeSharesPolicy{AllowedOwners: tooManyOwners}, false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.policy.Validate(), "test: %v", tc.name)
+		} else {
+			require.Error(t, tc.policy.Validate(), "test: %v", tc.name)
+		}
+	}
+
+	require.NoError(t, types.TokenizeSharesPolicy{}.ValidateShareOwner(addr1))
+	require.ErrorIs(t, types.TokenizeSharesPolicy{Disabled: true}.ValidateShareOwner(addr1), types.ErrTokenizeSharesDisabledForValidator)
+	require.NoError(t, types.TokenizeSharesPolicy{AllowedOwners: []string{addr1}}.ValidateShareOwner(addr1))
+	require.ErrorIs(t, types.TokenizeSharesPolicy{AllowedOwners: []string{addr1}}.ValidateShareOwner(addr2), types.ErrTokenizeShareOwnerNotAllowed)
+}